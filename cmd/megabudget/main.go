@@ -7,15 +7,29 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
 
+	"zankowitch.com/go-db-app/internal/accounts"
 	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/budgets"
+	"zankowitch.com/go-db-app/internal/cashflow"
 	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/categorization"
 	"zankowitch.com/go-db-app/internal/config"
 	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/duplicates"
+	"zankowitch.com/go-db-app/internal/envelopes"
 	"zankowitch.com/go-db-app/internal/handlers"
 	"zankowitch.com/go-db-app/internal/httpapi"
 	"zankowitch.com/go-db-app/internal/httpserver"
+	"zankowitch.com/go-db-app/internal/imports"
 	"zankowitch.com/go-db-app/internal/logging"
+	"zankowitch.com/go-db-app/internal/payees"
+	"zankowitch.com/go-db-app/internal/rates"
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/suggestions"
+	"zankowitch.com/go-db-app/internal/tags"
 	"zankowitch.com/go-db-app/internal/transactions"
+	"zankowitch.com/go-db-app/internal/transfers"
+	"zankowitch.com/go-db-app/internal/trash"
 )
 
 func main() {
@@ -29,16 +43,50 @@ func main() {
 			logging.New,
 			handlers.NewHealthHandler,
 			categories.NewRepository,
+			categories.NewService,
 			httpapi.NewCategoriesHandler,
 			transactions.NewRepository,
+			transfers.NewService,
 			httpapi.NewTransactionsHandler,
 			httpapi.NewAnalyticsHandler,
+			imports.NewImporter,
+			httpapi.NewImportsHandler,
+			accounts.NewRepository,
+			httpapi.NewAccountsHandler,
+			httpapi.NewTransfersHandler,
+			budgets.NewRepository,
+			httpapi.NewBudgetsHandler,
+			envelopes.NewRepository,
+			envelopes.NewService,
+			httpapi.NewEnvelopesHandler,
+			recurring.NewRepository,
+			recurring.NewService,
+			recurring.NewScheduler,
+			httpapi.NewRecurringHandler,
+			cashflow.NewService,
+			categorization.NewRepository,
+			categorization.NewService,
+			httpapi.NewCategorizationHandler,
+			suggestions.NewService,
+			duplicates.NewRepository,
+			duplicates.NewService,
+			httpapi.NewDuplicatesHandler,
+			tags.NewRepository,
+			httpapi.NewTagsHandler,
+			payees.NewRepository,
+			payees.NewService,
+			httpapi.NewPayeesHandler,
+			rates.NewRepository,
+			httpapi.NewExchangeRatesHandler,
+			trash.NewService,
+			trash.NewScheduler,
+			httpapi.NewTrashHandler,
 			httpapi.NewHandler,
 			func(h *httpapi.Handler) api.StrictServerInterface { return h },
 			httpserver.NewMux,
 			httpserver.NewServer,
 		),
-		fx.Invoke(func(*http.Server) {}),
+		fx.Invoke(func(*http.Server, *recurring.Scheduler, *trash.Scheduler) {}),
 	)
 
 	app.Run()
@@ -0,0 +1,36 @@
+package accounts
+
+import "time"
+
+type Type string
+
+const (
+	TypeChecking   Type = "checking"
+	TypeSavings    Type = "savings"
+	TypeCreditCard Type = "credit_card"
+	TypeCash       Type = "cash"
+)
+
+// BalanceCents is computed: the opening balance plus every transaction
+// booked on the account, converted into the base currency. It is nil when a
+// transaction cannot be converted for lack of an exchange rate.
+type Account struct {
+	ID                  int64
+	Name                string
+	Type                Type
+	OpeningBalanceCents int64
+	BalanceCents        *int64
+	CreatedAt           time.Time
+}
+
+type CreateInput struct {
+	Name                string
+	Type                Type
+	OpeningBalanceCents int64
+}
+
+type UpdateInput struct {
+	Name                string
+	Type                Type
+	OpeningBalanceCents int64
+}
@@ -0,0 +1,201 @@
+package accounts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// ErrInUse is returned when deleting an account that something still refers
+// to. It is wrapped with the blocking dependency.
+var ErrInUse = errors.New("account is still in use")
+
+// Repository stores accounts. Opening balances and balances are in the base
+// currency.
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// accountSelect reads accounts together with their running balance, with
+// every transaction converted into the base currency ($1) at the rate of its
+// date. The balance is NULL when a rate is missing.
+var accountSelect = `
+	SELECT
+		a.id,
+		a.name,
+		a.account_type,
+		(a.opening_balance * ` + transactions.ScaleOfParam(1) + `)::bigint,
+		CASE WHEN NOT COALESCE(b.missing_rate, false) THEN
+			((a.opening_balance + COALESCE(b.total, 0)) * ` + transactions.ScaleOfParam(1) + `)::bigint
+		END,
+		a.created_at
+	FROM accounts a
+	LEFT JOIN LATERAL (
+		SELECT
+			SUM(t.amount * exchange_factor(t.currency, $1::text, t.transaction_date)) AS total,
+			bool_or(exchange_factor(t.currency, $1::text, t.transaction_date) IS NULL) AS missing_rate
+		FROM transactions t
+		WHERE t.account_id = a.id AND t.deleted_at IS NULL
+	) b ON true
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccount(row rowScanner) (Account, error) {
+	var a Account
+	var balance sql.NullInt64
+	err := row.Scan(
+		&a.ID,
+		&a.Name,
+		&a.Type,
+		&a.OpeningBalanceCents,
+		&balance,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		return Account{}, err
+	}
+
+	if balance.Valid {
+		a.BalanceCents = &balance.Int64
+	}
+
+	return a, nil
+}
+
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Account, error) {
+	query := `
+		INSERT INTO accounts (name, account_type, opening_balance)
+		VALUES ($1, $2, $3::numeric / ` + transactions.ScaleOfParam(4) + `)
+		RETURNING id
+	`
+
+	var id int64
+	if err := r.db.QueryRowContext(ctx, query, in.Name, in.Type, in.OpeningBalanceCents, r.baseCurrency).Scan(&id); err != nil {
+		return Account{}, err
+	}
+
+	return r.Get(ctx, id)
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Account, error) {
+	query := accountSelect + ` WHERE a.id = $2`
+
+	return scanAccount(r.db.QueryRowContext(ctx, query, r.baseCurrency, id))
+}
+
+func (r *Repository) List(ctx context.Context) ([]Account, error) {
+	query := accountSelect + ` ORDER BY a.name ASC, a.id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := make([]Account, 0)
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Account, error) {
+	query := `
+		UPDATE accounts
+		SET name = $1,
+			account_type = $2,
+			opening_balance = $3::numeric / ` + transactions.ScaleOfParam(5) + `
+		WHERE id = $4
+		RETURNING id
+	`
+
+	if err := r.db.QueryRowContext(ctx, query, in.Name, in.Type, in.OpeningBalanceCents, id, r.baseCurrency).Scan(&id); err != nil {
+		return Account{}, err
+	}
+
+	return r.Get(ctx, id)
+}
+
+// Delete removes the account together with its transactions in the trash,
+// which are purged early. It returns ErrInUse, naming the dependency, while
+// transactions outside the trash or recurring rules refer to it.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const inUseQuery = `
+		SELECT
+			EXISTS (SELECT 1 FROM transactions WHERE account_id = $1 AND deleted_at IS NULL),
+			EXISTS (SELECT 1 FROM recurring_rules WHERE account_id = $1)
+	`
+	const purgeTransfers = `
+		DELETE FROM transfers
+		WHERE id IN (
+			SELECT transfer_id FROM transactions WHERE account_id = $1 AND deleted_at IS NOT NULL
+		)
+	`
+	const purgeTransactions = `DELETE FROM transactions WHERE account_id = $1 AND deleted_at IS NOT NULL`
+	const query = `DELETE FROM accounts WHERE id = $1`
+
+	return db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		var hasTransactions, hasRules bool
+		if err := tx.QueryRowContext(ctx, inUseQuery, id).Scan(&hasTransactions, &hasRules); err != nil {
+			return err
+		}
+		if hasTransactions {
+			return fmt.Errorf("%w: it has transactions", ErrInUse)
+		}
+		if hasRules {
+			return fmt.Errorf("%w: recurring rules book into it", ErrInUse)
+		}
+
+		if _, err := tx.ExecContext(ctx, purgeTransfers, id); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, purgeTransactions, id); err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, query, id)
+		if err != nil {
+			if db.IsForeignKeyViolation(err) {
+				return ErrInUse
+			}
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -24,1285 +25,8959 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AccountType.
+const (
+	Cash       AccountType = "cash"
+	Checking   AccountType = "checking"
+	CreditCard AccountType = "credit_card"
+	Savings    AccountType = "savings"
+)
+
+// Defines values for CashFlowSource.
+const (
+	CashFlowSourcePattern       CashFlowSource = "pattern"
+	CashFlowSourceRecurringRule CashFlowSource = "recurring_rule"
+)
+
+// Defines values for DuplicateResolveRequestAction.
+const (
+	Dismiss DuplicateResolveRequestAction = "dismiss"
+	Merge   DuplicateResolveRequestAction = "merge"
+)
+
+// Defines values for ImportRowResultStatus.
+const (
+	Created ImportRowResultStatus = "created"
+	Failed  ImportRowResultStatus = "failed"
+	Skipped ImportRowResultStatus = "skipped"
+)
+
+// Defines values for RecurringFrequency.
+const (
+	Daily   RecurringFrequency = "daily"
+	Monthly RecurringFrequency = "monthly"
+	Weekly  RecurringFrequency = "weekly"
+	Yearly  RecurringFrequency = "yearly"
+)
+
+// Defines values for TransactionChangeAction.
+const (
+	Create  TransactionChangeAction = "create"
+	Delete  TransactionChangeAction = "delete"
+	Purge   TransactionChangeAction = "purge"
+	Restore TransactionChangeAction = "restore"
+	Update  TransactionChangeAction = "update"
+)
+
 // Defines values for ListTransactionsParamsType.
 const (
-	Income   ListTransactionsParamsType = "income"
-	Spending ListTransactionsParamsType = "spending"
+	ListTransactionsParamsTypeIncome   ListTransactionsParamsType = "income"
+	ListTransactionsParamsTypeSpending ListTransactionsParamsType = "spending"
 )
 
-// Category defines model for Category.
-type Category struct {
-	CreatedAt time.Time `json:"created_at"`
-	Id        int64     `json:"id"`
-	Name      string    `json:"name"`
-}
+// Defines values for ListTransactionsParamsTagMode.
+const (
+	All ListTransactionsParamsTagMode = "all"
+	Any ListTransactionsParamsTagMode = "any"
+)
 
-// CategoryCreate defines model for CategoryCreate.
-type CategoryCreate struct {
-	Name string `json:"name"`
-}
+// Defines values for ExportTransactionsParamsFormat.
+const (
+	Csv    ExportTransactionsParamsFormat = "csv"
+	Ndjson ExportTransactionsParamsFormat = "ndjson"
+	Ofx    ExportTransactionsParamsFormat = "ofx"
+)
 
-// CategoryList defines model for CategoryList.
-type CategoryList struct {
-	Items []Category `json:"items"`
-}
+// Defines values for ExportTransactionsParamsType.
+const (
+	ExportTransactionsParamsTypeIncome   ExportTransactionsParamsType = "income"
+	ExportTransactionsParamsTypeSpending ExportTransactionsParamsType = "spending"
+)
 
-// CategoryUpdate defines model for CategoryUpdate.
-type CategoryUpdate struct {
-	Name string `json:"name"`
+// Defines values for ImportTransactionsParamsDateFormat.
+const (
+	DDMMYYYY  ImportTransactionsParamsDateFormat = "DD/MM/YYYY"
+	DDMMYYYY1 ImportTransactionsParamsDateFormat = "DD.MM.YYYY"
+	DDMMYYYY2 ImportTransactionsParamsDateFormat = "DD-MM-YYYY"
+	MMDDYYYY  ImportTransactionsParamsDateFormat = "MM/DD/YYYY"
+	YYYYMMDD  ImportTransactionsParamsDateFormat = "YYYY-MM-DD"
+)
+
+// Defines values for ImportTransactionsParamsDelimiter.
+const (
+	ImportTransactionsParamsDelimiterComma     ImportTransactionsParamsDelimiter = "comma"
+	ImportTransactionsParamsDelimiterPipe      ImportTransactionsParamsDelimiter = "pipe"
+	ImportTransactionsParamsDelimiterSemicolon ImportTransactionsParamsDelimiter = "semicolon"
+	ImportTransactionsParamsDelimiterTab       ImportTransactionsParamsDelimiter = "tab"
+)
+
+// Defines values for ImportTransactionsParamsDecimalSeparator.
+const (
+	ImportTransactionsParamsDecimalSeparatorComma ImportTransactionsParamsDecimalSeparator = "comma"
+	ImportTransactionsParamsDecimalSeparatorDot   ImportTransactionsParamsDecimalSeparator = "dot"
+)
+
+// Account defines model for Account.
+type Account struct {
+	// BalanceCents Opening balance plus every transaction booked on the account, in minor units of the base currency. Transactions in other currencies are converted at the rate of their date; null when a rate is missing.
+	BalanceCents *int64    `json:"balance_cents"`
+	CreatedAt    time.Time `json:"created_at"`
+	Id           int64     `json:"id"`
+	Name         string    `json:"name"`
+
+	// OpeningBalanceCents In minor units of the base currency.
+	OpeningBalanceCents int64       `json:"opening_balance_cents"`
+	Type                AccountType `json:"type"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	Message string `json:"message"`
+// AccountCreate defines model for AccountCreate.
+type AccountCreate struct {
+	Name                string      `json:"name"`
+	OpeningBalanceCents *int64      `json:"opening_balance_cents,omitempty"`
+	Type                AccountType `json:"type"`
 }
 
-// MonthlySavings defines model for MonthlySavings.
-type MonthlySavings struct {
-	Average int64   `json:"average"`
-	Months  []int32 `json:"months"`
-	Total   int64   `json:"total"`
-	Values  []int64 `json:"values"`
-	Year    int32   `json:"year"`
+// AccountList defines model for AccountList.
+type AccountList struct {
+	Items []Account `json:"items"`
 }
 
-// Transaction defines model for Transaction.
-type Transaction struct {
-	AmountCents     int64              `json:"amount_cents"`
-	CategoryId      *int64             `json:"category_id"`
-	CreatedAt       time.Time          `json:"created_at"`
-	Description     *string            `json:"description"`
-	Id              int64              `json:"id"`
-	TransactionDate openapi_types.Date `json:"transaction_date"`
+// AccountType defines model for AccountType.
+type AccountType string
+
+// AccountUpdate defines model for AccountUpdate.
+type AccountUpdate struct {
+	Name                string      `json:"name"`
+	OpeningBalanceCents int64       `json:"opening_balance_cents"`
+	Type                AccountType `json:"type"`
 }
 
-// TransactionCreate defines model for TransactionCreate.
-type TransactionCreate struct {
-	AmountCents     int64              `json:"amount_cents"`
-	CategoryId      *int64             `json:"category_id"`
-	Description     *string            `json:"description"`
-	TransactionDate openapi_types.Date `json:"transaction_date"`
+// Budget defines model for Budget.
+type Budget struct {
+	// AmountCents Monthly spending limit, in minor units of the base currency.
+	AmountCents int64     `json:"amount_cents"`
+	CategoryId  int64     `json:"category_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Id          int64     `json:"id"`
 }
 
-// TransactionList defines model for TransactionList.
-type TransactionList struct {
-	Items []Transaction `json:"items"`
+// BudgetCreate defines model for BudgetCreate.
+type BudgetCreate struct {
+	// AmountCents Monthly spending limit, in minor units of the base currency.
+	AmountCents int64 `json:"amount_cents"`
+	CategoryId  int64 `json:"category_id"`
 }
 
-// TransactionUpdate defines model for TransactionUpdate.
-type TransactionUpdate struct {
-	AmountCents     int64              `json:"amount_cents"`
-	CategoryId      *int64             `json:"category_id"`
-	Description     *string            `json:"description"`
-	TransactionDate openapi_types.Date `json:"transaction_date"`
+// BudgetList defines model for BudgetList.
+type BudgetList struct {
+	Items []Budget `json:"items"`
 }
 
-// TransactionsSummary defines model for TransactionsSummary.
-type TransactionsSummary struct {
-	Income   TransactionsSummarySection `json:"income"`
-	Months   []int32                    `json:"months"`
-	Spending TransactionsSummarySection `json:"spending"`
-	Year     int32                      `json:"year"`
+// BudgetUpdate defines model for BudgetUpdate.
+type BudgetUpdate struct {
+	// AmountCents Monthly spending limit, in minor units of the base currency.
+	AmountCents int64 `json:"amount_cents"`
 }
 
-// TransactionsSummaryRow defines model for TransactionsSummaryRow.
-type TransactionsSummaryRow struct {
-	Average    int64   `json:"average"`
-	CategoryId int64   `json:"category_id"`
-	Total      int64   `json:"total"`
-	Values     []int64 `json:"values"`
+// BudgetVsActual defines model for BudgetVsActual.
+type BudgetVsActual struct {
+	BudgetedCents  int64               `json:"budgeted_cents"`
+	Month          int32               `json:"month"`
+	RemainingCents int64               `json:"remaining_cents"`
+	Rows           []BudgetVsActualRow `json:"rows"`
+	SpentCents     int64               `json:"spent_cents"`
+	Year           int32               `json:"year"`
 }
 
-// TransactionsSummarySection defines model for TransactionsSummarySection.
-type TransactionsSummarySection struct {
-	ColumnTotals []int64                  `json:"column_totals"`
-	Rows         []TransactionsSummaryRow `json:"rows"`
-	Total        int64                    `json:"total"`
+// BudgetVsActualRow defines model for BudgetVsActualRow.
+type BudgetVsActualRow struct {
+	BudgetId      int64 `json:"budget_id"`
+	BudgetedCents int64 `json:"budgeted_cents"`
+	CategoryId    int64 `json:"category_id"`
+
+	// PercentUsed Spent as a percentage of the budget; null for a zero budget.
+	PercentUsed *float64 `json:"percent_used"`
+
+	// RemainingCents Negative when the budget is overspent.
+	RemainingCents int64 `json:"remaining_cents"`
+	SpentCents     int64 `json:"spent_cents"`
 }
 
-// GetMonthlySavingsParams defines parameters for GetMonthlySavings.
-type GetMonthlySavingsParams struct {
-	Year int32 `form:"year" json:"year"`
+// CashFlow defines model for CashFlow.
+type CashFlow struct {
+	AsOf                openapi_types.Date `json:"as_of"`
+	ClosingBalanceCents int64              `json:"closing_balance_cents"`
+	Days                []CashFlowDay      `json:"days"`
+	From                openapi_types.Date `json:"from"`
+	LowestBalanceCents  int64              `json:"lowest_balance_cents"`
+	LowestBalanceDate   openapi_types.Date `json:"lowest_balance_date"`
+
+	// OpeningBalanceCents Balance at the start of from, including projections between as_of and from.
+	OpeningBalanceCents int64              `json:"opening_balance_cents"`
+	To                  openapi_types.Date `json:"to"`
+
+	// Upcoming Projected transactions within the range, by date.
+	Upcoming []ProjectedTransaction `json:"upcoming"`
 }
 
-// GetTransactionsSummaryParams defines parameters for GetTransactionsSummary.
-type GetTransactionsSummaryParams struct {
-	Year int32 `form:"year" json:"year"`
+// CashFlowDay defines model for CashFlowDay.
+type CashFlowDay struct {
+	ActualCents int64 `json:"actual_cents"`
+
+	// BalanceCents Balance at the end of the day.
+	BalanceCents   int64              `json:"balance_cents"`
+	Date           openapi_types.Date `json:"date"`
+	ProjectedCents int64              `json:"projected_cents"`
 }
 
-// ListTransactionsParams defines parameters for ListTransactions.
-type ListTransactionsParams struct {
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+// CashFlowSource defines model for CashFlowSource.
+type CashFlowSource string
 
-	// FromDate Include transactions on or after this date.
-	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
+// CategorizationApplyResult defines model for CategorizationApplyResult.
+type CategorizationApplyResult struct {
+	Changes []CategorizationChange `json:"changes"`
+	DryRun  bool                   `json:"dry_run"`
+}
 
-	// StartDate Include transactions on or before this date.
-	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+// CategorizationChange defines model for CategorizationChange.
+type CategorizationChange struct {
+	AmountCents   int64   `json:"amount_cents"`
+	CategoryId    int64   `json:"category_id"`
+	Description   *string `json:"description"`
+	RuleId        int64   `json:"rule_id"`
+	TransactionId int64   `json:"transaction_id"`
+}
 
-	// ToDate Include transactions on or before this date.
-	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
+// CategorizationRule defines model for CategorizationRule.
+type CategorizationRule struct {
+	AccountId  *int64    `json:"account_id"`
+	CategoryId int64     `json:"category_id"`
+	CreatedAt  time.Time `json:"created_at"`
 
-	// CategoryId Filter by category id.
-	CategoryId *int64 `form:"category_id,omitempty" json:"category_id,omitempty"`
+	// DescriptionContains Case-insensitive substring of the description.
+	DescriptionContains *string `json:"description_contains"`
 
-	// Type Filter by spending (amount < 0) or income (amount > 0).
-	Type *ListTransactionsParamsType `form:"type,omitempty" json:"type,omitempty"`
+	// DescriptionPattern Regular expression (Go syntax) matched against the description.
+	DescriptionPattern *string `json:"description_pattern"`
+	Id                 int64   `json:"id"`
 
-	// AfterDate Cursor date for pagination.
-	AfterDate *openapi_types.Date `form:"after_date,omitempty" json:"after_date,omitempty"`
+	// MaxAmountCents Inclusive upper bound on the signed amount, in minor units of the base currency.
+	MaxAmountCents *int64 `json:"max_amount_cents"`
 
-	// AfterId Cursor id for pagination.
-	AfterId *int64 `form:"after_id,omitempty" json:"after_id,omitempty"`
+	// MinAmountCents Inclusive lower bound on the signed amount, in minor units of the base currency. Rules with amount bounds only match transactions in the base currency.
+	MinAmountCents *int64 `json:"min_amount_cents"`
+
+	// Priority Rules are tried by ascending priority, then by id.
+	Priority int32 `json:"priority"`
 }
 
-// ListTransactionsParamsType defines parameters for ListTransactions.
-type ListTransactionsParamsType string
+// CategorizationRuleCreate A rule needs at least one condition; all set conditions must match.
+type CategorizationRuleCreate struct {
+	AccountId  *int64 `json:"account_id"`
+	CategoryId int64  `json:"category_id"`
 
-// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
-type CreateCategoryJSONRequestBody = CategoryCreate
+	// DescriptionContains Case-insensitive substring of the description.
+	DescriptionContains *string `json:"description_contains"`
 
-// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
-type UpdateCategoryJSONRequestBody = CategoryUpdate
+	// DescriptionPattern Regular expression (Go syntax) matched against the description.
+	DescriptionPattern *string `json:"description_pattern"`
 
-// CreateTransactionJSONRequestBody defines body for CreateTransaction for application/json ContentType.
-type CreateTransactionJSONRequestBody = TransactionCreate
+	// MaxAmountCents Inclusive upper bound on the signed amount, in minor units of the base currency.
+	MaxAmountCents *int64 `json:"max_amount_cents"`
 
-// UpdateTransactionJSONRequestBody defines body for UpdateTransaction for application/json ContentType.
-type UpdateTransactionJSONRequestBody = TransactionUpdate
+	// MinAmountCents Inclusive lower bound on the signed amount, in minor units of the base currency. Rules with amount bounds only match transactions in the base currency.
+	MinAmountCents *int64 `json:"min_amount_cents"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get monthly net savings for a year
-	// (GET /analytics/monthly-savings)
-	GetMonthlySavings(w http.ResponseWriter, r *http.Request, params GetMonthlySavingsParams)
-	// Get monthly spending and income summary by category
-	// (GET /analytics/transactions-summary)
-	GetTransactionsSummary(w http.ResponseWriter, r *http.Request, params GetTransactionsSummaryParams)
-	// List categories
-	// (GET /categories)
-	ListCategories(w http.ResponseWriter, r *http.Request)
-	// Create a category
-	// (POST /categories)
-	CreateCategory(w http.ResponseWriter, r *http.Request)
-	// Delete a category
-	// (DELETE /categories/{categoryId})
-	DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
-	// Get a category
-	// (GET /categories/{categoryId})
-	GetCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
-	// Update a category
-	// (PUT /categories/{categoryId})
-	UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
-	// List transactions
-	// (GET /transactions)
-	ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams)
-	// Create a transaction
-	// (POST /transactions)
-	CreateTransaction(w http.ResponseWriter, r *http.Request)
-	// Delete a transaction
-	// (DELETE /transactions/{transactionId})
-	DeleteTransaction(w http.ResponseWriter, r *http.Request, transactionId int64)
-	// Get a transaction
-	// (GET /transactions/{transactionId})
-	GetTransaction(w http.ResponseWriter, r *http.Request, transactionId int64)
-	// Update a transaction
-	// (PUT /transactions/{transactionId})
-	UpdateTransaction(w http.ResponseWriter, r *http.Request, transactionId int64)
+	// Priority Rules are tried by ascending priority, then by id.
+	Priority *int32 `json:"priority,omitempty"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// CategorizationRuleList defines model for CategorizationRuleList.
+type CategorizationRuleList struct {
+	Items []CategorizationRule `json:"items"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// CategorizationRuleUpdate A rule needs at least one condition; all set conditions must match.
+type CategorizationRuleUpdate struct {
+	AccountId  *int64 `json:"account_id"`
+	CategoryId int64  `json:"category_id"`
 
-// GetMonthlySavings operation middleware
-func (siw *ServerInterfaceWrapper) GetMonthlySavings(w http.ResponseWriter, r *http.Request) {
+	// DescriptionContains Case-insensitive substring of the description.
+	DescriptionContains *string `json:"description_contains"`
 
-	var err error
+	// DescriptionPattern Regular expression (Go syntax) matched against the description.
+	DescriptionPattern *string `json:"description_pattern"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetMonthlySavingsParams
+	// MaxAmountCents Inclusive upper bound on the signed amount, in minor units of the base currency.
+	MaxAmountCents *int64 `json:"max_amount_cents"`
 
-	// ------------- Required query parameter "year" -------------
+	// MinAmountCents Inclusive lower bound on the signed amount, in minor units of the base currency. Rules with amount bounds only match transactions in the base currency.
+	MinAmountCents *int64 `json:"min_amount_cents"`
 
-	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+	// Priority Rules are tried by ascending priority, then by id.
+	Priority *int32 `json:"priority,omitempty"`
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
-		return
-	}
+// Category defines model for Category.
+type Category struct {
+	// ArchivedAt When the category was archived; null while it is active.
+	ArchivedAt *time.Time `json:"archived_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         int64      `json:"id"`
+	Name       string     `json:"name"`
 
-	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
-		return
-	}
+	// ParentId Parent category; null for a top-level category.
+	ParentId *int64 `json:"parent_id"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMonthlySavings(w, r, params)
-	}))
+// CategoryCreate defines model for CategoryCreate.
+type CategoryCreate struct {
+	Name string `json:"name"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ParentId Parent category; null for a top-level category.
+	ParentId *int64 `json:"parent_id"`
+}
 
-	handler.ServeHTTP(w, r)
+// CategoryList defines model for CategoryList.
+type CategoryList struct {
+	Items []Category `json:"items"`
 }
 
-// GetTransactionsSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetTransactionsSummary(w http.ResponseWriter, r *http.Request) {
+// CategoryMerge defines model for CategoryMerge.
+type CategoryMerge struct {
+	TargetCategoryId int64 `json:"target_category_id"`
+}
 
-	var err error
+// CategoryMergeResult defines model for CategoryMergeResult.
+type CategoryMergeResult struct {
+	// BudgetsMoved 1 when the category had a budget. It becomes the target's budget, or is added to it when the target has one.
+	BudgetsMoved int64 `json:"budgets_moved"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTransactionsSummaryParams
+	// EnvelopeAssignmentsMoved Months of envelope assignments moved to the target. A month the target already had money assigned for gets the two added up.
+	EnvelopeAssignmentsMoved int64 `json:"envelope_assignments_moved"`
 
-	// ------------- Required query parameter "year" -------------
+	// RecurringRulesMoved Recurring rules now booking into the target.
+	RecurringRulesMoved int64 `json:"recurring_rules_moved"`
 
-	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+	// RulesMoved Categorization rules now assigning the target.
+	RulesMoved         int64    `json:"rules_moved"`
+	SplitLinesMoved    int64    `json:"split_lines_moved"`
+	SubcategoriesMoved int64    `json:"subcategories_moved"`
+	Target             Category `json:"target"`
+	TransactionsMoved  int64    `json:"transactions_moved"`
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
-		return
-	}
+// CategorySuggestion defines model for CategorySuggestion.
+type CategorySuggestion struct {
+	CategoryId int64 `json:"category_id"`
 
-	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
-		return
-	}
+	// Probability Model probability between 0 and 1.
+	Probability float64 `json:"probability"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTransactionsSummary(w, r, params)
-	}))
+// CategorySuggestionList defines model for CategorySuggestionList.
+type CategorySuggestionList struct {
+	Items []CategorySuggestion `json:"items"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// CategoryUpdate defines model for CategoryUpdate.
+type CategoryUpdate struct {
+	Name string `json:"name"`
 
-	handler.ServeHTTP(w, r)
+	// ParentId Parent category; null for a top-level category.
+	ParentId *int64 `json:"parent_id"`
 }
 
-// ListCategories operation middleware
-func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
+// DuplicateGroup defines model for DuplicateGroup.
+type DuplicateGroup struct {
+	// Transactions Transactions in the group, oldest first.
+	Transactions []Transaction `json:"transactions"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCategories(w, r)
-	}))
+// DuplicateGroupList defines model for DuplicateGroupList.
+type DuplicateGroupList struct {
+	Groups []DuplicateGroup `json:"groups"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// DuplicateResolveRequest defines model for DuplicateResolveRequest.
+type DuplicateResolveRequest struct {
+	Action DuplicateResolveRequestAction `json:"action"`
 
-	handler.ServeHTTP(w, r)
+	// KeepId Transaction to keep; required to merge.
+	KeepId         *int64  `json:"keep_id,omitempty"`
+	TransactionIds []int64 `json:"transaction_ids"`
 }
 
-// CreateCategory operation middleware
-func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
+// DuplicateResolveRequestAction defines model for DuplicateResolveRequest.Action.
+type DuplicateResolveRequestAction string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCategory(w, r)
-	}))
+// DuplicateResolveResult defines model for DuplicateResolveResult.
+type DuplicateResolveResult struct {
+	DeletedIds     []int64      `json:"deleted_ids"`
+	DismissedPairs int          `json:"dismissed_pairs"`
+	Kept           *Transaction `json:"kept,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Envelope defines model for Envelope.
+type Envelope struct {
+	// ActivityCents Spending in the month, as a negative amount.
+	ActivityCents  int64 `json:"activity_cents"`
+	AssignedCents  int64 `json:"assigned_cents"`
+	AvailableCents int64 `json:"available_cents"`
 
-	handler.ServeHTTP(w, r)
+	// CarriedOverCents Left over (or overspent, when negative) at the end of the previous month.
+	CarriedOverCents int64 `json:"carried_over_cents"`
+	CategoryId       int64 `json:"category_id"`
 }
 
-// DeleteCategory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+// EnvelopeAssign defines model for EnvelopeAssign.
+type EnvelopeAssign struct {
+	// AmountCents Amount to assign, in minor units of the base currency; negative to unassign.
+	AmountCents int64 `json:"amount_cents"`
+	CategoryId  int64 `json:"category_id"`
+	Month       int32 `json:"month"`
+	Year        int32 `json:"year"`
+}
 
-	var err error
+// EnvelopeMonth defines model for EnvelopeMonth.
+type EnvelopeMonth struct {
+	AssignedCents int64 `json:"assigned_cents"`
 
-	// ------------- Path parameter "categoryId" -------------
-	var categoryId int64
+	// AvailableToBudgetCents Income so far minus everything assigned so far; negative when over-assigned.
+	AvailableToBudgetCents int64      `json:"available_to_budget_cents"`
+	Envelopes              []Envelope `json:"envelopes"`
+	IncomeCents            int64      `json:"income_cents"`
+	Month                  int32      `json:"month"`
+	Year                   int32      `json:"year"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
-		return
-	}
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCategory(w, r, categoryId)
-	}))
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	Currency string `json:"currency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Rate Units of currency per one euro.
+	Rate     float64            `json:"rate"`
+	RateDate openapi_types.Date `json:"rate_date"`
+}
 
-	handler.ServeHTTP(w, r)
+// ExchangeRateImportResult defines model for ExchangeRateImportResult.
+type ExchangeRateImportResult struct {
+	Currencies []string `json:"currencies"`
+	DryRun     bool     `json:"dry_run"`
+
+	// FromDate Earliest rate date in the file.
+	FromDate *openapi_types.Date `json:"from_date"`
+
+	// Imported Number of rates stored, or that would be in a dry run.
+	Imported int `json:"imported"`
+
+	// ToDate Latest rate date in the file.
+	ToDate *openapi_types.Date `json:"to_date"`
 }
 
-// GetCategory operation middleware
-func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+// ExchangeRateList defines model for ExchangeRateList.
+type ExchangeRateList struct {
+	Items []ExchangeRate `json:"items"`
+}
 
-	var err error
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Created int32             `json:"created"`
+	DryRun  bool              `json:"dry_run"`
+	Failed  int32             `json:"failed"`
+	Rows    []ImportRowResult `json:"rows"`
+	Skipped int32             `json:"skipped"`
+}
 
-	// ------------- Path parameter "categoryId" -------------
-	var categoryId int64
+// ImportRowResult defines model for ImportRowResult.
+type ImportRowResult struct {
+	Line          int32                 `json:"line"`
+	Message       *string               `json:"message"`
+	Status        ImportRowResultStatus `json:"status"`
+	TransactionId *int64                `json:"transaction_id"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
-		return
-	}
+// ImportRowResultStatus defines model for ImportRowResult.Status.
+type ImportRowResultStatus string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCategory(w, r, categoryId)
-	}))
+// MonthlySavings defines model for MonthlySavings.
+type MonthlySavings struct {
+	Average int64   `json:"average"`
+	Months  []int32 `json:"months"`
+	Total   int64   `json:"total"`
+	Values  []int64 `json:"values"`
+	Year    int32   `json:"year"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Payee defines model for Payee.
+type Payee struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
 
-	handler.ServeHTTP(w, r)
+	// Patterns Case-insensitive regular expressions (Go RE2 syntax) matched against transaction descriptions, tried in order. Payees are tried by ascending id; the first match wins.
+	Patterns []string `json:"patterns"`
 }
 
-// UpdateCategory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+// PayeeApplyResult defines model for PayeeApplyResult.
+type PayeeApplyResult struct {
+	Changes []PayeeAssignment `json:"changes"`
+	DryRun  bool              `json:"dry_run"`
+}
 
-	var err error
+// PayeeAssignment defines model for PayeeAssignment.
+type PayeeAssignment struct {
+	Description   *string `json:"description"`
+	PayeeId       int64   `json:"payee_id"`
+	TransactionId int64   `json:"transaction_id"`
+}
 
-	// ------------- Path parameter "categoryId" -------------
-	var categoryId int64
+// PayeeCreate defines model for PayeeCreate.
+type PayeeCreate struct {
+	// Name Unique ignoring case; surrounding spaces are trimmed.
+	Name     string    `json:"name"`
+	Patterns *[]string `json:"patterns,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
-		return
-	}
+// PayeeList defines model for PayeeList.
+type PayeeList struct {
+	Items []Payee `json:"items"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCategory(w, r, categoryId)
-	}))
+// PayeeMerge defines model for PayeeMerge.
+type PayeeMerge struct {
+	TargetPayeeId int64 `json:"target_payee_id"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PayeeMergeResult defines model for PayeeMergeResult.
+type PayeeMergeResult struct {
+	PatternsMoved     int64 `json:"patterns_moved"`
+	Target            Payee `json:"target"`
+	TransactionsMoved int64 `json:"transactions_moved"`
+}
 
-	handler.ServeHTTP(w, r)
+// PayeeUpdate defines model for PayeeUpdate.
+type PayeeUpdate struct {
+	Name string `json:"name"`
+
+	// Patterns Replaces the payee's patterns; omitted means none.
+	Patterns *[]string `json:"patterns,omitempty"`
 }
 
-// ListTransactions operation middleware
-func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+// ProjectedTransaction defines model for ProjectedTransaction.
+type ProjectedTransaction struct {
+	AccountId       *int64             `json:"account_id"`
+	AmountCents     int64              `json:"amount_cents"`
+	CategoryId      *int64             `json:"category_id"`
+	Date            openapi_types.Date `json:"date"`
+	Description     *string            `json:"description"`
+	RecurringRuleId *int64             `json:"recurring_rule_id"`
+	Source          CashFlowSource     `json:"source"`
+}
 
-	var err error
+// RecurringFrequency defines model for RecurringFrequency.
+type RecurringFrequency string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTransactionsParams
+// RecurringRule defines model for RecurringRule.
+type RecurringRule struct {
+	AccountId *int64 `json:"account_id"`
 
-	// ------------- Optional query parameter "limit" -------------
+	// AmountCents In minor units of the base currency, which occurrences are booked in.
+	AmountCents int64     `json:"amount_cents"`
+	CategoryId  *int64    `json:"category_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description *string   `json:"description"`
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	// EndDate Last possible occurrence; null for no end.
+	EndDate *openapi_types.Date `json:"end_date"`
 
-	// ------------- Optional query parameter "from_date" -------------
+	// Every Repeat every N days, weeks, months or years.
+	Every     int32              `json:"every"`
+	Frequency RecurringFrequency `json:"frequency"`
+	Id        int64              `json:"id"`
 
-	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
-		return
-	}
+	// LastError Why the last run could not book the rule, e.g. an archived category; null once it books again or the rule is updated.
+	LastError   *string    `json:"last_error"`
+	LastErrorAt *time.Time `json:"last_error_at"`
 
-	// ------------- Optional query parameter "start_date" -------------
+	// NextDate Next occurrence that has not been booked yet.
+	NextDate openapi_types.Date `json:"next_date"`
 
-	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
-		return
-	}
+	// StartDate First occurrence. Monthly and yearly rules keep its day, clamped to shorter months.
+	StartDate openapi_types.Date `json:"start_date"`
+}
 
-	// ------------- Optional query parameter "to_date" -------------
+// RecurringRuleCreate defines model for RecurringRuleCreate.
+type RecurringRuleCreate struct {
+	AccountId *int64 `json:"account_id"`
 
-	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
-		return
-	}
+	// AmountCents In minor units of the base currency, which occurrences are booked in.
+	AmountCents int64   `json:"amount_cents"`
+	CategoryId  *int64  `json:"category_id"`
+	Description *string `json:"description"`
 
-	// ------------- Optional query parameter "category_id" -------------
+	// EndDate Last possible occurrence; null for no end.
+	EndDate *openapi_types.Date `json:"end_date"`
 
-	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
-		return
-	}
+	// Every Repeat every N days, weeks, months or years.
+	Every     *int32             `json:"every,omitempty"`
+	Frequency RecurringFrequency `json:"frequency"`
 
-	// ------------- Optional query parameter "type" -------------
+	// StartDate First occurrence. Monthly and yearly rules keep its day, clamped to shorter months.
+	StartDate openapi_types.Date `json:"start_date"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
+// RecurringRuleList defines model for RecurringRuleList.
+type RecurringRuleList struct {
+	Items []RecurringRule `json:"items"`
+}
 
-	// ------------- Optional query parameter "after_date" -------------
+// RecurringRuleUpdate defines model for RecurringRuleUpdate.
+type RecurringRuleUpdate struct {
+	AccountId *int64 `json:"account_id"`
 
-	err = runtime.BindQueryParameter("form", true, false, "after_date", r.URL.Query(), &params.AfterDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after_date", Err: err})
-		return
-	}
+	// AmountCents In minor units of the base currency, which occurrences are booked in.
+	AmountCents int64 `json:"amount_cents"`
 
-	// ------------- Optional query parameter "after_id" -------------
+	// ApplyFrom Also update transactions already booked for occurrences on or after this date.
+	ApplyFrom   *openapi_types.Date `json:"apply_from"`
+	CategoryId  *int64              `json:"category_id"`
+	Description *string             `json:"description"`
 
-	err = runtime.BindQueryParameter("form", true, false, "after_id", r.URL.Query(), &params.AfterId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after_id", Err: err})
-		return
-	}
+	// EndDate Last possible occurrence; null for no end.
+	EndDate *openapi_types.Date `json:"end_date"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTransactions(w, r, params)
-	}))
+	// Every Repeat every N days, weeks, months or years.
+	Every     *int32             `json:"every,omitempty"`
+	Frequency RecurringFrequency `json:"frequency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// StartDate First occurrence. Monthly and yearly rules keep its day, clamped to shorter months.
+	StartDate openapi_types.Date `json:"start_date"`
+}
 
-	handler.ServeHTTP(w, r)
+// RecurringRuleUpdateResult defines model for RecurringRuleUpdateResult.
+type RecurringRuleUpdateResult struct {
+	Rule                RecurringRule `json:"rule"`
+	TransactionsUpdated int64         `json:"transactions_updated"`
 }
 
-// CreateTransaction operation middleware
-func (siw *ServerInterfaceWrapper) CreateTransaction(w http.ResponseWriter, r *http.Request) {
+// RecurringRunFailure defines model for RecurringRunFailure.
+type RecurringRunFailure struct {
+	Message string `json:"message"`
+	RuleId  int64  `json:"rule_id"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTransaction(w, r)
-	}))
+// RecurringRunResult defines model for RecurringRunResult.
+type RecurringRunResult struct {
+	// Failures Rules that could not be booked; they are retried on the next run.
+	Failures            []RecurringRunFailure `json:"failures"`
+	RulesRun            int32                 `json:"rules_run"`
+	TransactionsCreated int32                 `json:"transactions_created"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Tag defines model for Tag.
+type Tag struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+}
 
-	handler.ServeHTTP(w, r)
+// TagCreate defines model for TagCreate.
+type TagCreate struct {
+	// Name Unique ignoring case; surrounding spaces are trimmed.
+	Name string `json:"name"`
 }
 
-// DeleteTransaction operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTransaction(w http.ResponseWriter, r *http.Request) {
+// TagList defines model for TagList.
+type TagList struct {
+	Items []Tag `json:"items"`
+}
 
-	var err error
+// TagTotal defines model for TagTotal.
+type TagTotal struct {
+	// IncomeCents In minor units of the base currency.
+	IncomeCents int64  `json:"income_cents"`
+	Name        string `json:"name"`
 
-	// ------------- Path parameter "transactionId" -------------
-	var transactionId int64
+	// SpendingCents Sum of negative amounts, as a positive number, in minor units of the base currency.
+	SpendingCents    int64 `json:"spending_cents"`
+	TagId            int64 `json:"tag_id"`
+	TransactionCount int64 `json:"transaction_count"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
-		return
-	}
+// TagTotalList defines model for TagTotalList.
+type TagTotalList struct {
+	Items []TagTotal `json:"items"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTransaction(w, r, transactionId)
-	}))
+// TagUpdate defines model for TagUpdate.
+type TagUpdate struct {
+	Name string `json:"name"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TopPayee defines model for TopPayee.
+type TopPayee struct {
+	Name    string `json:"name"`
+	PayeeId int64  `json:"payee_id"`
 
-	handler.ServeHTTP(w, r)
+	// SpendingCents Sum of negative amounts, as a positive number, in minor units of the base currency.
+	SpendingCents    int64 `json:"spending_cents"`
+	TransactionCount int64 `json:"transaction_count"`
 }
 
-// GetTransaction operation middleware
-func (siw *ServerInterfaceWrapper) GetTransaction(w http.ResponseWriter, r *http.Request) {
+// TopPayeeList defines model for TopPayeeList.
+type TopPayeeList struct {
+	Items []TopPayee `json:"items"`
+}
 
-	var err error
+// Transaction defines model for Transaction.
+type Transaction struct {
+	AccountId *int64 `json:"account_id"`
 
-	// ------------- Path parameter "transactionId" -------------
-	var transactionId int64
+	// AmountCents Amount in minor units of currency; divide by 10^currency_exponent for the major unit.
+	AmountCents int64     `json:"amount_cents"`
+	CategoryId  *int64    `json:"category_id"`
+	CreatedAt   time.Time `json:"created_at"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
-		return
-	}
+	// Currency ISO 4217 code of amount_cents.
+	Currency string `json:"currency"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTransaction(w, r, transactionId)
-	}))
+	// CurrencyExponent Number of decimals of the currency's minor unit, e.g. 2 for EUR, 0 for JPY and 3 for BHD.
+	CurrencyExponent int     `json:"currency_exponent"`
+	Description      *string `json:"description"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ExternalId Bank identifier of an imported transaction (e.g. OFX FITID).
+	ExternalId *string `json:"external_id"`
+	Id         int64   `json:"id"`
+	PayeeId    *int64  `json:"payee_id"`
 
-	handler.ServeHTTP(w, r)
-}
+	// RecurringRuleId Set when the transaction was booked by a recurring rule.
+	RecurringRuleId *int64 `json:"recurring_rule_id"`
 
-// UpdateTransaction operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTransaction(w http.ResponseWriter, r *http.Request) {
+	// SearchRank Relevance of the match when listing with `q`; higher is better. Absent otherwise.
+	SearchRank *float64           `json:"search_rank,omitempty"`
+	Splits     []TransactionSplit `json:"splits"`
 
-	var err error
+	// Tags Names of the transaction's tags, sorted ignoring case.
+	Tags            []string           `json:"tags"`
+	TransactionDate openapi_types.Date `json:"transaction_date"`
 
-	// ------------- Path parameter "transactionId" -------------
-	var transactionId int64
+	// TransferId Set when the transaction is one leg of a transfer. Updating a leg keeps its direction and mirrors date, amount and description on the other leg; deleting a leg deletes the whole transfer.
+	TransferId *int64 `json:"transfer_id"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
-		return
-	}
+// TransactionChange defines model for TransactionChange.
+type TransactionChange struct {
+	// Action delete moves the transaction to the trash and purge removes it for good.
+	Action TransactionChangeAction `json:"action"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTransaction(w, r, transactionId)
-	}))
+	// After Stored transaction row after the change, in the same form as before; null for a purge.
+	After *map[string]interface{} `json:"after"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Before Stored transaction row before the change, with the amounts in amount_cents, its split lines as splits and its tag names as tags; null for a create. Entries recorded before lines and tags were audited have neither.
+	Before    *map[string]interface{} `json:"before"`
+	ChangedAt time.Time               `json:"changed_at"`
+	Id        int64                   `json:"id"`
 
-	handler.ServeHTTP(w, r)
+	// RequestId Request that made the change; null for changes made by background jobs.
+	RequestId *string `json:"request_id"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// TransactionChangeAction delete moves the transaction to the trash and purge removes it for good.
+type TransactionChangeAction string
+
+// TransactionCreate defines model for TransactionCreate.
+type TransactionCreate struct {
+	AccountId *int64 `json:"account_id"`
+
+	// AmountCents Amount in minor units of currency, e.g. cents for EUR, yen for JPY and fils for BHD.
+	AmountCents int64  `json:"amount_cents"`
+	CategoryId  *int64 `json:"category_id"`
+
+	// Currency ISO 4217 code of amount_cents. Defaults to the base currency.
+	Currency    *string `json:"currency,omitempty"`
+	Description *string `json:"description"`
+
+	// PayeeId Set from the payee patterns when omitted.
+	PayeeId *int64 `json:"payee_id"`
+
+	// Splits Optional category lines whose amounts must add up to amount_cents. Analytics attribute the transaction to these lines instead of category_id.
+	Splits *[]TransactionSplit `json:"splits,omitempty"`
+
+	// Tags Names of existing tags, matched ignoring case.
+	Tags            *[]string          `json:"tags,omitempty"`
+	TransactionDate openapi_types.Date `json:"transaction_date"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// TransactionHistory defines model for TransactionHistory.
+type TransactionHistory struct {
+	Items []TransactionChange `json:"items"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// TransactionList defines model for TransactionList.
+type TransactionList struct {
+	Items []Transaction `json:"items"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// TransactionSplit defines model for TransactionSplit.
+type TransactionSplit struct {
+	AmountCents int64   `json:"amount_cents"`
+	CategoryId  int64   `json:"category_id"`
+	Description *string `json:"description"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// TransactionUpdate defines model for TransactionUpdate.
+type TransactionUpdate struct {
+	AccountId *int64 `json:"account_id"`
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// AmountCents Amount in minor units of currency, e.g. cents for EUR, yen for JPY and fils for BHD.
+	AmountCents int64  `json:"amount_cents"`
+	CategoryId  *int64 `json:"category_id"`
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// Currency ISO 4217 code of amount_cents. Unchanged when omitted.
+	Currency    *string `json:"currency,omitempty"`
+	Description *string `json:"description"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// PayeeId Null or omitted leaves the transaction without a payee.
+	PayeeId *int64 `json:"payee_id"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// Splits Optional category lines whose amounts must add up to amount_cents. Analytics attribute the transaction to these lines instead of category_id.
+	Splits *[]TransactionSplit `json:"splits,omitempty"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	// Tags Names of existing tags, matched ignoring case. Replaces the transaction's tags; when omitted they are left unchanged.
+	Tags            *[]string          `json:"tags,omitempty"`
+	TransactionDate openapi_types.Date `json:"transaction_date"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// TransactionsSummary defines model for TransactionsSummary.
+type TransactionsSummary struct {
+	Income   TransactionsSummarySection `json:"income"`
+	Months   []int32                    `json:"months"`
+	Spending TransactionsSummarySection `json:"spending"`
+	Year     int32                      `json:"year"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// TransactionsSummaryRow defines model for TransactionsSummaryRow.
+type TransactionsSummaryRow struct {
+	Average    int64   `json:"average"`
+	CategoryId int64   `json:"category_id"`
+	ParentId   *int64  `json:"parent_id"`
+	Total      int64   `json:"total"`
+	Values     []int64 `json:"values"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// TransactionsSummarySection defines model for TransactionsSummarySection.
+type TransactionsSummarySection struct {
+	ColumnTotals []int64                  `json:"column_totals"`
+	Rows         []TransactionsSummaryRow `json:"rows"`
+	Total        int64                    `json:"total"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// AmountCents Amount in minor units of currency.
+	AmountCents         int64     `json:"amount_cents"`
+	CreatedAt           time.Time `json:"created_at"`
+	CreditTransactionId int64     `json:"credit_transaction_id"`
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// Currency ISO 4217 code of amount_cents and of both legs.
+	Currency string `json:"currency"`
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	// CurrencyExponent Number of decimals of the currency's minor unit.
+	CurrencyExponent   int                `json:"currency_exponent"`
+	DebitTransactionId int64              `json:"debit_transaction_id"`
+	Description        *string            `json:"description"`
+	FromAccountId      int64              `json:"from_account_id"`
+	Id                 int64              `json:"id"`
+	ToAccountId        int64              `json:"to_account_id"`
+	TransferDate       openapi_types.Date `json:"transfer_date"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+// TransferCreate defines model for TransferCreate.
+type TransferCreate struct {
+	// AmountCents Amount in minor units of currency.
+	AmountCents int64 `json:"amount_cents"`
+
+	// Currency ISO 4217 code of amount_cents and of both legs. Defaults to the base currency on create and to the transfer's currency on update.
+	Currency      *string            `json:"currency,omitempty"`
+	Description   *string            `json:"description"`
+	FromAccountId int64              `json:"from_account_id"`
+	ToAccountId   int64              `json:"to_account_id"`
+	TransferDate  openapi_types.Date `json:"transfer_date"`
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
+// TransferList defines model for TransferList.
+type TransferList struct {
+	Items []Transfer `json:"items"`
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// Trash defines model for Trash.
+type Trash struct {
+	Categories   []TrashedCategory    `json:"categories"`
+	Transactions []TrashedTransaction `json:"transactions"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+// TrashedCategory defines model for TrashedCategory.
+type TrashedCategory struct {
+	Category  Category  `json:"category"`
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt When the category is permanently deleted.
+	PurgeAt time.Time `json:"purge_at"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// TrashedTransaction defines model for TrashedTransaction.
+type TrashedTransaction struct {
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt When the transaction is permanently deleted.
+	PurgeAt     time.Time   `json:"purge_at"`
+	Transaction Transaction `json:"transaction"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// GetBudgetVsActualParams defines parameters for GetBudgetVsActual.
+type GetBudgetVsActualParams struct {
+	Year  int32 `form:"year" json:"year"`
+	Month int32 `form:"month" json:"month"`
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	// AccountId Only include transactions of this account.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// GetCashFlowParams defines parameters for GetCashFlow.
+type GetCashFlowParams struct {
+	From openapi_types.Date `form:"from" json:"from"`
 
-	m.HandleFunc("GET "+options.BaseURL+"/analytics/monthly-savings", wrapper.GetMonthlySavings)
-	m.HandleFunc("GET "+options.BaseURL+"/analytics/transactions-summary", wrapper.GetTransactionsSummary)
-	m.HandleFunc("GET "+options.BaseURL+"/categories", wrapper.ListCategories)
-	m.HandleFunc("POST "+options.BaseURL+"/categories", wrapper.CreateCategory)
-	m.HandleFunc("DELETE "+options.BaseURL+"/categories/{categoryId}", wrapper.DeleteCategory)
-	m.HandleFunc("GET "+options.BaseURL+"/categories/{categoryId}", wrapper.GetCategory)
-	m.HandleFunc("PUT "+options.BaseURL+"/categories/{categoryId}", wrapper.UpdateCategory)
-	m.HandleFunc("GET "+options.BaseURL+"/transactions", wrapper.ListTransactions)
-	m.HandleFunc("POST "+options.BaseURL+"/transactions", wrapper.CreateTransaction)
-	m.HandleFunc("DELETE "+options.BaseURL+"/transactions/{transactionId}", wrapper.DeleteTransaction)
-	m.HandleFunc("GET "+options.BaseURL+"/transactions/{transactionId}", wrapper.GetTransaction)
-	m.HandleFunc("PUT "+options.BaseURL+"/transactions/{transactionId}", wrapper.UpdateTransaction)
+	// To Last day of the series; at most 366 days after from.
+	To openapi_types.Date `form:"to" json:"to"`
 
-	return m
-}
+	// AsOf Last day of known history. Defaults to today.
+	AsOf *openapi_types.Date `form:"as_of,omitempty" json:"as_of,omitempty"`
 
-type GetMonthlySavingsRequestObject struct {
-	Params GetMonthlySavingsParams
+	// AccountId Only include transactions of this account.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
 }
 
-type GetMonthlySavingsResponseObject interface {
-	VisitGetMonthlySavingsResponse(w http.ResponseWriter) error
-}
+// GetMonthlySavingsParams defines parameters for GetMonthlySavings.
+type GetMonthlySavingsParams struct {
+	Year int32 `form:"year" json:"year"`
 
-type GetMonthlySavings200ResponseHeaders struct {
-	XRequestID string
+	// AccountId Only include transactions of this account.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
 }
 
-type GetMonthlySavings200JSONResponse struct {
-	Body    MonthlySavings
-	Headers GetMonthlySavings200ResponseHeaders
+// GetTagTotalsParams defines parameters for GetTagTotals.
+type GetTagTotalsParams struct {
+	// FromDate Include transactions on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
+
+	// ToDate Include transactions on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 }
 
-func (response GetMonthlySavings200JSONResponse) VisitGetMonthlySavingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// GetTopPayeesParams defines parameters for GetTopPayees.
+type GetTopPayeesParams struct {
+	// FromDate Include transactions on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// ToDate Include transactions on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 
-type GetMonthlySavings400ResponseHeaders struct {
-	XRequestID string
+	// Limit Maximum number of payees to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type GetMonthlySavings400JSONResponse struct {
-	Body    Error
-	Headers GetMonthlySavings400ResponseHeaders
-}
+// GetTransactionsSummaryParams defines parameters for GetTransactionsSummary.
+type GetTransactionsSummaryParams struct {
+	Year int32 `form:"year" json:"year"`
 
-func (response GetMonthlySavings400JSONResponse) VisitGetMonthlySavingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+	// AccountId Only include transactions of this account.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// Depth Roll categories up to this level of the hierarchy (1 = top-level only). Each row then includes the amounts of all its descendants, and section totals are summed over top-level rows. Without it every category reports only its own amounts.
+	Depth *int32 `form:"depth,omitempty" json:"depth,omitempty"`
 }
 
-type GetTransactionsSummaryRequestObject struct {
-	Params GetTransactionsSummaryParams
+// ListCategoriesParams defines parameters for ListCategories.
+type ListCategoriesParams struct {
+	// IncludeArchived Include archived categories.
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
 }
 
-type GetTransactionsSummaryResponseObject interface {
-	VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error
+// SuggestCategoriesParams defines parameters for SuggestCategories.
+type SuggestCategoriesParams struct {
+	Description string `form:"description" json:"description"`
+	AmountCents int64  `form:"amount_cents" json:"amount_cents"`
+	Limit       *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type GetTransactionsSummary200ResponseHeaders struct {
-	XRequestID string
+// DeleteCategoryParams defines parameters for DeleteCategory.
+type DeleteCategoryParams struct {
+	// IfMatch ETag from an earlier read. The request fails with 412 when the category has changed since.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type GetTransactionsSummary200JSONResponse struct {
-	Body    TransactionsSummary
-	Headers GetTransactionsSummary200ResponseHeaders
+// UpdateCategoryParams defines parameters for UpdateCategory.
+type UpdateCategoryParams struct {
+	// IfMatch ETag from an earlier read. The request fails with 412 when the category has changed since.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-func (response GetTransactionsSummary200JSONResponse) VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response.Body)
+// ApplyCategorizationRulesParams defines parameters for ApplyCategorizationRules.
+type ApplyCategorizationRulesParams struct {
+	// DryRun Preview the changes without saving them.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-type GetTransactionsSummary400ResponseHeaders struct {
-	XRequestID string
+// GetEnvelopeMonthParams defines parameters for GetEnvelopeMonth.
+type GetEnvelopeMonthParams struct {
+	Year  int32 `form:"year" json:"year"`
+	Month int32 `form:"month" json:"month"`
 }
 
-type GetTransactionsSummary400JSONResponse struct {
-	Body    Error
-	Headers GetTransactionsSummary400ResponseHeaders
-}
+// ListExchangeRatesParams defines parameters for ListExchangeRates.
+type ListExchangeRatesParams struct {
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-func (response GetTransactionsSummary400JSONResponse) VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+	// FromDate Include rates on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// ToDate Include rates on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 }
 
-type ListCategoriesRequestObject struct {
+// ImportExchangeRatesCsvParams defines parameters for ImportExchangeRatesCsv.
+type ImportExchangeRatesCsvParams struct {
+	// DryRun Validate the file without storing the rates.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-type ListCategoriesResponseObject interface {
-	VisitListCategoriesResponse(w http.ResponseWriter) error
+// ImportExchangeRatesXmlParams defines parameters for ImportExchangeRatesXml.
+type ImportExchangeRatesXmlParams struct {
+	// DryRun Validate the file without storing the rates.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-type ListCategories200ResponseHeaders struct {
-	XRequestID string
+// ApplyPayeePatternsParams defines parameters for ApplyPayeePatterns.
+type ApplyPayeePatternsParams struct {
+	// DryRun Preview the changes without saving them.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-type ListCategories200JSONResponse struct {
-	Body    CategoryList
-	Headers ListCategories200ResponseHeaders
+// RunRecurringRulesParams defines parameters for RunRecurringRules.
+type RunRecurringRulesParams struct {
+	// Through Book occurrences up to this date (inclusive). Defaults to today.
+	Through *openapi_types.Date `form:"through,omitempty" json:"through,omitempty"`
 }
 
-func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// ListTransactionsParams defines parameters for ListTransactions.
+type ListTransactionsParams struct {
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// FromDate Include transactions on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
 
-type CreateCategoryRequestObject struct {
-	Body *CreateCategoryJSONRequestBody
-}
+	// StartDate Include transactions on or before this date.
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
 
-type CreateCategoryResponseObject interface {
-	VisitCreateCategoryResponse(w http.ResponseWriter) error
-}
+	// ToDate Include transactions on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 
-type CreateCategory201ResponseHeaders struct {
-	XRequestID string
-}
+	// AccountId Filter by account id.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
 
-type CreateCategory201JSONResponse struct {
-	Body    Category
-	Headers CreateCategory201ResponseHeaders
-}
+	// CategoryId Filter by category id.
+	CategoryId *int64 `form:"category_id,omitempty" json:"category_id,omitempty"`
 
-func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(201)
+	// PayeeId Filter by payee id.
+	PayeeId *int64 `form:"payee_id,omitempty" json:"payee_id,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// Type Filter by spending (amount < 0) or income (amount > 0).
+	Type *ListTransactionsParamsType `form:"type,omitempty" json:"type,omitempty"`
 
-type CreateCategory400ResponseHeaders struct {
-	XRequestID string
-}
+	// Tag Filter by tag name, ignoring case. Repeat the parameter to filter by several tags; see tag_mode.
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
 
-type CreateCategory400JSONResponse struct {
-	Body    Error
-	Headers CreateCategory400ResponseHeaders
-}
+	// TagMode Match transactions with any (default) or all of the tags.
+	TagMode *ListTransactionsParamsTagMode `form:"tag_mode,omitempty" json:"tag_mode,omitempty"`
 
-func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+	// Q Full-text search over descriptions. Every word must match, as a prefix and case-insensitively. Results keep the newest-first order and cursor; each item carries its `search_rank`.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// AfterDate Cursor date for pagination.
+	AfterDate *openapi_types.Date `form:"after_date,omitempty" json:"after_date,omitempty"`
 
-type DeleteCategoryRequestObject struct {
-	CategoryId int64 `json:"categoryId"`
+	// AfterId Cursor id for pagination.
+	AfterId *int64 `form:"after_id,omitempty" json:"after_id,omitempty"`
 }
 
-type DeleteCategoryResponseObject interface {
-	VisitDeleteCategoryResponse(w http.ResponseWriter) error
-}
+// ListTransactionsParamsType defines parameters for ListTransactions.
+type ListTransactionsParamsType string
 
-type DeleteCategory204ResponseHeaders struct {
-	XRequestID string
-}
+// ListTransactionsParamsTagMode defines parameters for ListTransactions.
+type ListTransactionsParamsTagMode string
 
-type DeleteCategory204Response struct {
-	Headers DeleteCategory204ResponseHeaders
-}
+// FindDuplicateTransactionsParams defines parameters for FindDuplicateTransactions.
+type FindDuplicateTransactionsParams struct {
+	// Days Largest number of days between two duplicates.
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
 
-func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(204)
-	return nil
-}
+	// FromDate Only consider transactions on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
 
-type DeleteCategory404ResponseHeaders struct {
-	XRequestID string
+	// ToDate Only consider transactions on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 }
 
-type DeleteCategory404JSONResponse struct {
-	Body    Error
-	Headers DeleteCategory404ResponseHeaders
-}
+// ExportTransactionsParams defines parameters for ExportTransactions.
+type ExportTransactionsParams struct {
+	// Format Output format; overrides the Accept header.
+	Format *ExportTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 
-func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+	// FromDate Include transactions on or after this date.
+	FromDate *openapi_types.Date `form:"from_date,omitempty" json:"from_date,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// ToDate Include transactions on or before this date.
+	ToDate *openapi_types.Date `form:"to_date,omitempty" json:"to_date,omitempty"`
 
-type GetCategoryRequestObject struct {
-	CategoryId int64 `json:"categoryId"`
-}
+	// AccountId Filter by account id.
+	AccountId *int64 `form:"account_id,omitempty" json:"account_id,omitempty"`
 
-type GetCategoryResponseObject interface {
-	VisitGetCategoryResponse(w http.ResponseWriter) error
-}
+	// CategoryId Filter by category id.
+	CategoryId *int64 `form:"category_id,omitempty" json:"category_id,omitempty"`
 
-type GetCategory200ResponseHeaders struct {
-	XRequestID string
+	// Type Filter by spending (amount < 0) or income (amount > 0).
+	Type   *ExportTransactionsParamsType `form:"type,omitempty" json:"type,omitempty"`
+	Accept *string                       `json:"Accept,omitempty"`
 }
 
-type GetCategory200JSONResponse struct {
-	Body    Category
-	Headers GetCategory200ResponseHeaders
-}
+// ExportTransactionsParamsFormat defines parameters for ExportTransactions.
+type ExportTransactionsParamsFormat string
 
-func (response GetCategory200JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// ExportTransactionsParamsType defines parameters for ExportTransactions.
+type ExportTransactionsParamsType string
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// ImportTransactionsParams defines parameters for ImportTransactions.
+type ImportTransactionsParams struct {
+	// DryRun Validate the file without creating transactions.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 
-type GetCategory404ResponseHeaders struct {
-	XRequestID string
-}
+	// DateColumn Header of the column holding the transaction date.
+	DateColumn *string `form:"date_column,omitempty" json:"date_column,omitempty"`
 
-type GetCategory404JSONResponse struct {
-	Body    Error
-	Headers GetCategory404ResponseHeaders
-}
+	// AmountColumn Header of the column holding the signed amount.
+	AmountColumn *string `form:"amount_column,omitempty" json:"amount_column,omitempty"`
 
-func (response GetCategory404JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+	// DescriptionColumn Header of the column holding the description.
+	DescriptionColumn *string `form:"description_column,omitempty" json:"description_column,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// CategoryColumn Header of the column holding an existing category name.
+	CategoryColumn *string `form:"category_column,omitempty" json:"category_column,omitempty"`
 
-type UpdateCategoryRequestObject struct {
-	CategoryId int64 `json:"categoryId"`
-	Body       *UpdateCategoryJSONRequestBody
-}
+	// DateFormat Layout of the date column.
+	DateFormat *ImportTransactionsParamsDateFormat `form:"date_format,omitempty" json:"date_format,omitempty"`
 
-type UpdateCategoryResponseObject interface {
-	VisitUpdateCategoryResponse(w http.ResponseWriter) error
-}
+	// Delimiter Field delimiter of the file.
+	Delimiter *ImportTransactionsParamsDelimiter `form:"delimiter,omitempty" json:"delimiter,omitempty"`
 
-type UpdateCategory200ResponseHeaders struct {
-	XRequestID string
+	// DecimalSeparator Decimal separator used in the amount column.
+	DecimalSeparator *ImportTransactionsParamsDecimalSeparator `form:"decimal_separator,omitempty" json:"decimal_separator,omitempty"`
 }
 
-type UpdateCategory200JSONResponse struct {
-	Body    Category
-	Headers UpdateCategory200ResponseHeaders
-}
+// ImportTransactionsParamsDateFormat defines parameters for ImportTransactions.
+type ImportTransactionsParamsDateFormat string
 
-func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// ImportTransactionsParamsDelimiter defines parameters for ImportTransactions.
+type ImportTransactionsParamsDelimiter string
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// ImportTransactionsParamsDecimalSeparator defines parameters for ImportTransactions.
+type ImportTransactionsParamsDecimalSeparator string
 
-type UpdateCategory400ResponseHeaders struct {
-	XRequestID string
+// ImportTransactionsCamtParams defines parameters for ImportTransactionsCamt.
+type ImportTransactionsCamtParams struct {
+	// DryRun Validate the file without creating transactions.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-type UpdateCategory400JSONResponse struct {
-	Body    Error
-	Headers UpdateCategory400ResponseHeaders
+// ImportTransactionsOfxParams defines parameters for ImportTransactionsOfx.
+type ImportTransactionsOfxParams struct {
+	// DryRun Validate the file without creating transactions.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response.Body)
+// DeleteTransactionParams defines parameters for DeleteTransaction.
+type DeleteTransactionParams struct {
+	// IfMatch ETag from an earlier read. The request fails with 412 when the transaction has changed since.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type UpdateCategory404ResponseHeaders struct {
-	XRequestID string
+// UpdateTransactionParams defines parameters for UpdateTransaction.
+type UpdateTransactionParams struct {
+	// IfMatch ETag from an earlier read. The request fails with 412 when the transaction has changed since.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type UpdateCategory404JSONResponse struct {
-	Body    Error
-	Headers UpdateCategory404ResponseHeaders
-}
+// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
+type CreateAccountJSONRequestBody = AccountCreate
 
-func (response UpdateCategory404JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+// UpdateAccountJSONRequestBody defines body for UpdateAccount for application/json ContentType.
+type UpdateAccountJSONRequestBody = AccountUpdate
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// CreateBudgetJSONRequestBody defines body for CreateBudget for application/json ContentType.
+type CreateBudgetJSONRequestBody = BudgetCreate
 
-type ListTransactionsRequestObject struct {
-	Params ListTransactionsParams
-}
+// UpdateBudgetJSONRequestBody defines body for UpdateBudget for application/json ContentType.
+type UpdateBudgetJSONRequestBody = BudgetUpdate
 
-type ListTransactionsResponseObject interface {
-	VisitListTransactionsResponse(w http.ResponseWriter) error
-}
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody = CategoryCreate
 
-type ListTransactions200ResponseHeaders struct {
-	XRequestID string
-}
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody = CategoryUpdate
 
-type ListTransactions200JSONResponse struct {
-	Body    TransactionList
-	Headers ListTransactions200ResponseHeaders
-}
+// MergeCategoryJSONRequestBody defines body for MergeCategory for application/json ContentType.
+type MergeCategoryJSONRequestBody = CategoryMerge
 
-func (response ListTransactions200JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// CreateCategorizationRuleJSONRequestBody defines body for CreateCategorizationRule for application/json ContentType.
+type CreateCategorizationRuleJSONRequestBody = CategorizationRuleCreate
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// UpdateCategorizationRuleJSONRequestBody defines body for UpdateCategorizationRule for application/json ContentType.
+type UpdateCategorizationRuleJSONRequestBody = CategorizationRuleUpdate
 
-type ListTransactions400ResponseHeaders struct {
-	XRequestID string
-}
+// AssignEnvelopeJSONRequestBody defines body for AssignEnvelope for application/json ContentType.
+type AssignEnvelopeJSONRequestBody = EnvelopeAssign
 
-type ListTransactions400JSONResponse struct {
-	Body    Error
-	Headers ListTransactions400ResponseHeaders
-}
+// CreatePayeeJSONRequestBody defines body for CreatePayee for application/json ContentType.
+type CreatePayeeJSONRequestBody = PayeeCreate
 
-func (response ListTransactions400JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+// UpdatePayeeJSONRequestBody defines body for UpdatePayee for application/json ContentType.
+type UpdatePayeeJSONRequestBody = PayeeUpdate
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// MergePayeeJSONRequestBody defines body for MergePayee for application/json ContentType.
+type MergePayeeJSONRequestBody = PayeeMerge
 
-type CreateTransactionRequestObject struct {
-	Body *CreateTransactionJSONRequestBody
-}
+// CreateRecurringRuleJSONRequestBody defines body for CreateRecurringRule for application/json ContentType.
+type CreateRecurringRuleJSONRequestBody = RecurringRuleCreate
 
-type CreateTransactionResponseObject interface {
-	VisitCreateTransactionResponse(w http.ResponseWriter) error
-}
+// UpdateRecurringRuleJSONRequestBody defines body for UpdateRecurringRule for application/json ContentType.
+type UpdateRecurringRuleJSONRequestBody = RecurringRuleUpdate
 
-type CreateTransaction201ResponseHeaders struct {
-	XRequestID string
-}
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = TagCreate
 
-type CreateTransaction201JSONResponse struct {
-	Body    Transaction
-	Headers CreateTransaction201ResponseHeaders
-}
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = TagUpdate
 
-func (response CreateTransaction201JSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(201)
+// CreateTransactionJSONRequestBody defines body for CreateTransaction for application/json ContentType.
+type CreateTransactionJSONRequestBody = TransactionCreate
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// ResolveDuplicateTransactionsJSONRequestBody defines body for ResolveDuplicateTransactions for application/json ContentType.
+type ResolveDuplicateTransactionsJSONRequestBody = DuplicateResolveRequest
 
-type CreateTransaction400ResponseHeaders struct {
-	XRequestID string
-}
+// UpdateTransactionJSONRequestBody defines body for UpdateTransaction for application/json ContentType.
+type UpdateTransactionJSONRequestBody = TransactionUpdate
 
-type CreateTransaction400JSONResponse struct {
-	Body    Error
-	Headers CreateTransaction400ResponseHeaders
-}
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = TransferCreate
 
-func (response CreateTransaction400JSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+// UpdateTransferJSONRequestBody defines body for UpdateTransfer for application/json ContentType.
+type UpdateTransferJSONRequestBody = TransferCreate
 
-	return json.NewEncoder(w).Encode(response.Body)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List accounts with their balances
+	// (GET /accounts)
+	ListAccounts(w http.ResponseWriter, r *http.Request)
+	// Create an account
+	// (POST /accounts)
+	CreateAccount(w http.ResponseWriter, r *http.Request)
+	// Delete an account without transactions or recurring rules
+	// (DELETE /accounts/{accountId})
+	DeleteAccount(w http.ResponseWriter, r *http.Request, accountId int64)
+	// Get an account with its balance
+	// (GET /accounts/{accountId})
+	GetAccount(w http.ResponseWriter, r *http.Request, accountId int64)
+	// Update an account
+	// (PUT /accounts/{accountId})
+	UpdateAccount(w http.ResponseWriter, r *http.Request, accountId int64)
+	// Compare monthly budgets with actual spending
+	// (GET /analytics/budget-vs-actual)
+	GetBudgetVsActual(w http.ResponseWriter, r *http.Request, params GetBudgetVsActualParams)
+	// Projected daily balance
+	// (GET /analytics/cash-flow)
+	GetCashFlow(w http.ResponseWriter, r *http.Request, params GetCashFlowParams)
+	// Get monthly net savings for a year
+	// (GET /analytics/monthly-savings)
+	GetMonthlySavings(w http.ResponseWriter, r *http.Request, params GetMonthlySavingsParams)
+	// Spending and income per tag
+	// (GET /analytics/tags)
+	GetTagTotals(w http.ResponseWriter, r *http.Request, params GetTagTotalsParams)
+	// Payees ranked by spending
+	// (GET /analytics/top-payees)
+	GetTopPayees(w http.ResponseWriter, r *http.Request, params GetTopPayeesParams)
+	// Get monthly spending and income summary by category
+	// (GET /analytics/transactions-summary)
+	GetTransactionsSummary(w http.ResponseWriter, r *http.Request, params GetTransactionsSummaryParams)
+	// List budgets
+	// (GET /budgets)
+	ListBudgets(w http.ResponseWriter, r *http.Request)
+	// Create a monthly budget for a category
+	// (POST /budgets)
+	CreateBudget(w http.ResponseWriter, r *http.Request)
+	// Delete a budget
+	// (DELETE /budgets/{budgetId})
+	DeleteBudget(w http.ResponseWriter, r *http.Request, budgetId int64)
+	// Get a budget
+	// (GET /budgets/{budgetId})
+	GetBudget(w http.ResponseWriter, r *http.Request, budgetId int64)
+	// Update a budget
+	// (PUT /budgets/{budgetId})
+	UpdateBudget(w http.ResponseWriter, r *http.Request, budgetId int64)
+	// List categories
+	// (GET /categories)
+	ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams)
+	// Create a category
+	// (POST /categories)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
+	// Suggest categories for a transaction
+	// (GET /categories/suggestions)
+	SuggestCategories(w http.ResponseWriter, r *http.Request, params SuggestCategoriesParams)
+	// Delete a category
+	// (DELETE /categories/{categoryId})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId int64, params DeleteCategoryParams)
+	// Get a category
+	// (GET /categories/{categoryId})
+	GetCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
+	// Update a category
+	// (PUT /categories/{categoryId})
+	UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId int64, params UpdateCategoryParams)
+	// Unarchive a category
+	// (DELETE /categories/{categoryId}/archive)
+	UnarchiveCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
+	// Archive a category
+	// (POST /categories/{categoryId}/archive)
+	ArchiveCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
+	// Merge a category into another one
+	// (POST /categories/{categoryId}/merge)
+	MergeCategory(w http.ResponseWriter, r *http.Request, categoryId int64)
+	// List categorization rules
+	// (GET /categorization-rules)
+	ListCategorizationRules(w http.ResponseWriter, r *http.Request)
+	// Create a categorization rule
+	// (POST /categorization-rules)
+	CreateCategorizationRule(w http.ResponseWriter, r *http.Request)
+	// Categorize existing transactions
+	// (POST /categorization-rules/apply)
+	ApplyCategorizationRules(w http.ResponseWriter, r *http.Request, params ApplyCategorizationRulesParams)
+	// Delete a categorization rule
+	// (DELETE /categorization-rules/{ruleId})
+	DeleteCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// Get a categorization rule
+	// (GET /categorization-rules/{ruleId})
+	GetCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// Update a categorization rule
+	// (PUT /categorization-rules/{ruleId})
+	UpdateCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// Get the envelope budget for a month
+	// (GET /envelopes)
+	GetEnvelopeMonth(w http.ResponseWriter, r *http.Request, params GetEnvelopeMonthParams)
+	// Assign money to a category envelope
+	// (POST /envelopes/assign)
+	AssignEnvelope(w http.ResponseWriter, r *http.Request)
+	// List exchange rates
+	// (GET /exchange-rates)
+	ListExchangeRates(w http.ResponseWriter, r *http.Request, params ListExchangeRatesParams)
+	// Import exchange rates from an ECB CSV file
+	// (POST /exchange-rates/import/csv)
+	ImportExchangeRatesCsv(w http.ResponseWriter, r *http.Request, params ImportExchangeRatesCsvParams)
+	// Import exchange rates from an ECB XML file
+	// (POST /exchange-rates/import/xml)
+	ImportExchangeRatesXml(w http.ResponseWriter, r *http.Request, params ImportExchangeRatesXmlParams)
+	// List payees
+	// (GET /payees)
+	ListPayees(w http.ResponseWriter, r *http.Request)
+	// Create a payee
+	// (POST /payees)
+	CreatePayee(w http.ResponseWriter, r *http.Request)
+	// Link existing transactions to payees
+	// (POST /payees/apply)
+	ApplyPayeePatterns(w http.ResponseWriter, r *http.Request, params ApplyPayeePatternsParams)
+	// Delete a payee
+	// (DELETE /payees/{payeeId})
+	DeletePayee(w http.ResponseWriter, r *http.Request, payeeId int64)
+	// Get a payee
+	// (GET /payees/{payeeId})
+	GetPayee(w http.ResponseWriter, r *http.Request, payeeId int64)
+	// Update a payee
+	// (PUT /payees/{payeeId})
+	UpdatePayee(w http.ResponseWriter, r *http.Request, payeeId int64)
+	// Merge a payee into another one
+	// (POST /payees/{payeeId}/merge)
+	MergePayee(w http.ResponseWriter, r *http.Request, payeeId int64)
+	// List recurring rules
+	// (GET /recurring-rules)
+	ListRecurringRules(w http.ResponseWriter, r *http.Request)
+	// Create a recurring rule
+	// (POST /recurring-rules)
+	CreateRecurringRule(w http.ResponseWriter, r *http.Request)
+	// Book due recurring occurrences now
+	// (POST /recurring-rules/run)
+	RunRecurringRules(w http.ResponseWriter, r *http.Request, params RunRecurringRulesParams)
+	// Delete a recurring rule
+	// (DELETE /recurring-rules/{ruleId})
+	DeleteRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// Get a recurring rule
+	// (GET /recurring-rules/{ruleId})
+	GetRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// Update a recurring rule
+	// (PUT /recurring-rules/{ruleId})
+	UpdateRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64)
+	// List tags
+	// (GET /tags)
+	ListTags(w http.ResponseWriter, r *http.Request)
+	// Create a tag
+	// (POST /tags)
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	// Delete a tag
+	// (DELETE /tags/{tagId})
+	DeleteTag(w http.ResponseWriter, r *http.Request, tagId int64)
+	// Get a tag
+	// (GET /tags/{tagId})
+	GetTag(w http.ResponseWriter, r *http.Request, tagId int64)
+	// Rename a tag
+	// (PUT /tags/{tagId})
+	UpdateTag(w http.ResponseWriter, r *http.Request, tagId int64)
+	// List transactions
+	// (GET /transactions)
+	ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams)
+	// Create a transaction
+	// (POST /transactions)
+	CreateTransaction(w http.ResponseWriter, r *http.Request)
+	// Find likely duplicate transactions
+	// (GET /transactions/duplicates)
+	FindDuplicateTransactions(w http.ResponseWriter, r *http.Request, params FindDuplicateTransactionsParams)
+	// Merge or dismiss a group of duplicates
+	// (POST /transactions/duplicates/resolve)
+	ResolveDuplicateTransactions(w http.ResponseWriter, r *http.Request)
+	// Export transactions as CSV, JSON Lines or OFX
+	// (GET /transactions/export)
+	ExportTransactions(w http.ResponseWriter, r *http.Request, params ExportTransactionsParams)
+	// Import transactions from a CSV bank statement
+	// (POST /transactions/import)
+	ImportTransactions(w http.ResponseWriter, r *http.Request, params ImportTransactionsParams)
+	// Import transactions from an ISO 20022 camt.053 or camt.052 file
+	// (POST /transactions/import/camt)
+	ImportTransactionsCamt(w http.ResponseWriter, r *http.Request, params ImportTransactionsCamtParams)
+	// Import transactions from an OFX or QFX statement
+	// (POST /transactions/import/ofx)
+	ImportTransactionsOfx(w http.ResponseWriter, r *http.Request, params ImportTransactionsOfxParams)
+	// Delete a transaction
+	// (DELETE /transactions/{transactionId})
+	DeleteTransaction(w http.ResponseWriter, r *http.Request, transactionId int64, params DeleteTransactionParams)
+	// Get a transaction
+	// (GET /transactions/{transactionId})
+	GetTransaction(w http.ResponseWriter, r *http.Request, transactionId int64)
+	// Update a transaction
+	// (PUT /transactions/{transactionId})
+	UpdateTransaction(w http.ResponseWriter, r *http.Request, transactionId int64, params UpdateTransactionParams)
+	// Get the change history of a transaction
+	// (GET /transactions/{transactionId}/history)
+	GetTransactionHistory(w http.ResponseWriter, r *http.Request, transactionId int64)
+	// Restore a transaction from the trash
+	// (POST /transactions/{transactionId}/restore)
+	RestoreTransaction(w http.ResponseWriter, r *http.Request, transactionId int64)
+	// List transfers
+	// (GET /transfers)
+	ListTransfers(w http.ResponseWriter, r *http.Request)
+	// Move money between two accounts
+	// (POST /transfers)
+	CreateTransfer(w http.ResponseWriter, r *http.Request)
+	// Delete a transfer and both of its transactions
+	// (DELETE /transfers/{transferId})
+	DeleteTransfer(w http.ResponseWriter, r *http.Request, transferId int64)
+	// Get a transfer
+	// (GET /transfers/{transferId})
+	GetTransfer(w http.ResponseWriter, r *http.Request, transferId int64)
+	// Update a transfer and both of its transactions
+	// (PUT /transfers/{transferId})
+	UpdateTransfer(w http.ResponseWriter, r *http.Request, transferId int64)
+	// List the trash
+	// (GET /trash)
+	GetTrash(w http.ResponseWriter, r *http.Request)
 }
 
-type DeleteTransactionRequestObject struct {
-	TransactionId int64 `json:"transactionId"`
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type DeleteTransactionResponseObject interface {
-	VisitDeleteTransactionResponse(w http.ResponseWriter) error
-}
+type MiddlewareFunc func(http.Handler) http.Handler
 
-type DeleteTransaction204ResponseHeaders struct {
-	XRequestID string
-}
+// ListAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListAccounts(w http.ResponseWriter, r *http.Request) {
 
-type DeleteTransaction204Response struct {
-	Headers DeleteTransaction204ResponseHeaders
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAccounts(w, r)
+	}))
 
-func (response DeleteTransaction204Response) VisitDeleteTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(204)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteTransaction404ResponseHeaders struct {
-	XRequestID string
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteTransaction404JSONResponse struct {
-	Body    Error
-	Headers DeleteTransaction404ResponseHeaders
-}
+// CreateAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccount(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteTransaction404JSONResponse) VisitDeleteTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccount(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetTransactionRequestObject struct {
-	TransactionId int64 `json:"transactionId"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetTransactionResponseObject interface {
-	VisitGetTransactionResponse(w http.ResponseWriter) error
-}
+// DeleteAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 
-type GetTransaction200ResponseHeaders struct {
-	XRequestID string
+	var err error
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", r.PathValue("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAccount(w, r, accountId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", r.PathValue("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccount(w, r, accountId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAccount operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", r.PathValue("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAccount(w, r, accountId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBudgetVsActual operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetVsActual(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetVsActualParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := r.URL.Query().Get("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "month"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBudgetVsActual(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCashFlow operation middleware
+func (siw *ServerInterfaceWrapper) GetCashFlow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCashFlowParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", r.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "as_of", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCashFlow(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMonthlySavings operation middleware
+func (siw *ServerInterfaceWrapper) GetMonthlySavings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMonthlySavingsParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMonthlySavings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTagTotals operation middleware
+func (siw *ServerInterfaceWrapper) GetTagTotals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagTotalsParams
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagTotals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTopPayees operation middleware
+func (siw *ServerInterfaceWrapper) GetTopPayees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTopPayeesParams
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTopPayees(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransactionsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionsSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsSummaryParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransactionsSummary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBudgets operation middleware
+func (siw *ServerInterfaceWrapper) ListBudgets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBudgets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBudget operation middleware
+func (siw *ServerInterfaceWrapper) CreateBudget(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBudget(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBudget operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBudget(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "budgetId" -------------
+	var budgetId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budgetId", r.PathValue("budgetId"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budgetId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBudget(w, r, budgetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetBudget(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "budgetId" -------------
+	var budgetId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budgetId", r.PathValue("budgetId"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budgetId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBudget(w, r, budgetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBudget operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBudget(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "budgetId" -------------
+	var budgetId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budgetId", r.PathValue("budgetId"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budgetId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBudget(w, r, budgetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCategoriesParams
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuggestCategories operation middleware
+func (siw *ServerInterfaceWrapper) SuggestCategories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestCategoriesParams
+
+	// ------------- Required query parameter "description" -------------
+
+	if paramValue := r.URL.Query().Get("description"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "description"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "amount_cents" -------------
+
+	if paramValue := r.URL.Query().Get("amount_cents"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "amount_cents"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "amount_cents", r.URL.Query(), &params.AmountCents)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount_cents", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestCategories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCategoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, categoryId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCategory operation middleware
+func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCategoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategory(w, r, categoryId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnarchiveCategory operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnarchiveCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveCategory operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergeCategory operation middleware
+func (siw *ServerInterfaceWrapper) MergeCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "categoryId" -------------
+	var categoryId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryId", r.PathValue("categoryId"), &categoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeCategory(w, r, categoryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCategorizationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListCategorizationRules(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategorizationRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategorizationRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyCategorizationRules operation middleware
+func (siw *ServerInterfaceWrapper) ApplyCategorizationRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyCategorizationRulesParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyCategorizationRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategorizationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategorizationRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetCategorizationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCategorizationRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategorizationRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnvelopeMonth operation middleware
+func (siw *ServerInterfaceWrapper) GetEnvelopeMonth(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnvelopeMonthParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := r.URL.Query().Get("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "month"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnvelopeMonth(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssignEnvelope operation middleware
+func (siw *ServerInterfaceWrapper) AssignEnvelope(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignEnvelope(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) ListExchangeRates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExchangeRatesParams
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExchangeRates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportExchangeRatesCsv operation middleware
+func (siw *ServerInterfaceWrapper) ImportExchangeRatesCsv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportExchangeRatesCsvParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportExchangeRatesCsv(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportExchangeRatesXml operation middleware
+func (siw *ServerInterfaceWrapper) ImportExchangeRatesXml(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportExchangeRatesXmlParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportExchangeRatesXml(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPayees operation middleware
+func (siw *ServerInterfaceWrapper) ListPayees(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPayees(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePayee operation middleware
+func (siw *ServerInterfaceWrapper) CreatePayee(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePayee(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyPayeePatterns operation middleware
+func (siw *ServerInterfaceWrapper) ApplyPayeePatterns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyPayeePatternsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyPayeePatterns(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePayee operation middleware
+func (siw *ServerInterfaceWrapper) DeletePayee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "payeeId" -------------
+	var payeeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "payeeId", r.PathValue("payeeId"), &payeeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePayee(w, r, payeeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPayee operation middleware
+func (siw *ServerInterfaceWrapper) GetPayee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "payeeId" -------------
+	var payeeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "payeeId", r.PathValue("payeeId"), &payeeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPayee(w, r, payeeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePayee operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePayee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "payeeId" -------------
+	var payeeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "payeeId", r.PathValue("payeeId"), &payeeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePayee(w, r, payeeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePayee operation middleware
+func (siw *ServerInterfaceWrapper) MergePayee(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "payeeId" -------------
+	var payeeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "payeeId", r.PathValue("payeeId"), &payeeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePayee(w, r, payeeId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRecurringRules operation middleware
+func (siw *ServerInterfaceWrapper) ListRecurringRules(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRecurringRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRecurringRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateRecurringRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRecurringRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunRecurringRules operation middleware
+func (siw *ServerInterfaceWrapper) RunRecurringRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunRecurringRulesParams
+
+	// ------------- Optional query parameter "through" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "through", r.URL.Query(), &params.Through)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "through", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunRecurringRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRecurringRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRecurringRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRecurringRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecurringRule operation middleware
+func (siw *ServerInterfaceWrapper) GetRecurringRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecurringRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRecurringRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRecurringRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", r.PathValue("ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRecurringRule(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTag(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", r.PathValue("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, tagId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTag operation middleware
+func (siw *ServerInterfaceWrapper) GetTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", r.PathValue("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTag(w, r, tagId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", r.PathValue("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTag(w, r, tagId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransactionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "payee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "payee_id", r.URL.Query(), &params.PayeeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payee_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_mode", r.URL.Query(), &params.TagMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after_date", r.URL.Query(), &params.AfterDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after_id", r.URL.Query(), &params.AfterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTransaction operation middleware
+func (siw *ServerInterfaceWrapper) CreateTransaction(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTransaction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindDuplicateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) FindDuplicateTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindDuplicateTransactionsParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", r.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindDuplicateTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResolveDuplicateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ResolveDuplicateTransactions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveDuplicateTransactions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ExportTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTransactionsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_date", r.URL.Query(), &params.FromDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_date", r.URL.Query(), &params.ToDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "account_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ImportTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTransactionsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "date_column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date_column", r.URL.Query(), &params.DateColumn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amount_column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amount_column", r.URL.Query(), &params.AmountColumn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amount_column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description_column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description_column", r.URL.Query(), &params.DescriptionColumn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description_column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_column", r.URL.Query(), &params.CategoryColumn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "date_format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date_format", r.URL.Query(), &params.DateFormat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "delimiter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delimiter", r.URL.Query(), &params.Delimiter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delimiter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "decimal_separator" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decimal_separator", r.URL.Query(), &params.DecimalSeparator)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "decimal_separator", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTransactionsCamt operation middleware
+func (siw *ServerInterfaceWrapper) ImportTransactionsCamt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTransactionsCamtParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTransactionsCamt(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTransactionsOfx operation middleware
+func (siw *ServerInterfaceWrapper) ImportTransactionsOfx(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTransactionsOfxParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTransactionsOfx(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTransaction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTransaction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTransactionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTransaction(w, r, transactionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransaction operation middleware
+func (siw *ServerInterfaceWrapper) GetTransaction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransaction(w, r, transactionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTransaction operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTransaction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTransactionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTransaction(w, r, transactionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransactionHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransactionHistory(w, r, transactionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreTransaction operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTransaction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transactionId" -------------
+	var transactionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transactionId", r.PathValue("transactionId"), &transactionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTransaction(w, r, transactionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransfers operation middleware
+func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransfers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTransfer operation middleware
+func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTransfer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTransfer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", r.PathValue("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTransfer(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransfer operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", r.PathValue("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransfer(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTransfer operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", r.PathValue("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTransfer(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTrash(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/accounts", wrapper.ListAccounts)
+	m.HandleFunc("POST "+options.BaseURL+"/accounts", wrapper.CreateAccount)
+	m.HandleFunc("DELETE "+options.BaseURL+"/accounts/{accountId}", wrapper.DeleteAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/accounts/{accountId}", wrapper.GetAccount)
+	m.HandleFunc("PUT "+options.BaseURL+"/accounts/{accountId}", wrapper.UpdateAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/budget-vs-actual", wrapper.GetBudgetVsActual)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/cash-flow", wrapper.GetCashFlow)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/monthly-savings", wrapper.GetMonthlySavings)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/tags", wrapper.GetTagTotals)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/top-payees", wrapper.GetTopPayees)
+	m.HandleFunc("GET "+options.BaseURL+"/analytics/transactions-summary", wrapper.GetTransactionsSummary)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets", wrapper.ListBudgets)
+	m.HandleFunc("POST "+options.BaseURL+"/budgets", wrapper.CreateBudget)
+	m.HandleFunc("DELETE "+options.BaseURL+"/budgets/{budgetId}", wrapper.DeleteBudget)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets/{budgetId}", wrapper.GetBudget)
+	m.HandleFunc("PUT "+options.BaseURL+"/budgets/{budgetId}", wrapper.UpdateBudget)
+	m.HandleFunc("GET "+options.BaseURL+"/categories", wrapper.ListCategories)
+	m.HandleFunc("POST "+options.BaseURL+"/categories", wrapper.CreateCategory)
+	m.HandleFunc("GET "+options.BaseURL+"/categories/suggestions", wrapper.SuggestCategories)
+	m.HandleFunc("DELETE "+options.BaseURL+"/categories/{categoryId}", wrapper.DeleteCategory)
+	m.HandleFunc("GET "+options.BaseURL+"/categories/{categoryId}", wrapper.GetCategory)
+	m.HandleFunc("PUT "+options.BaseURL+"/categories/{categoryId}", wrapper.UpdateCategory)
+	m.HandleFunc("DELETE "+options.BaseURL+"/categories/{categoryId}/archive", wrapper.UnarchiveCategory)
+	m.HandleFunc("POST "+options.BaseURL+"/categories/{categoryId}/archive", wrapper.ArchiveCategory)
+	m.HandleFunc("POST "+options.BaseURL+"/categories/{categoryId}/merge", wrapper.MergeCategory)
+	m.HandleFunc("GET "+options.BaseURL+"/categorization-rules", wrapper.ListCategorizationRules)
+	m.HandleFunc("POST "+options.BaseURL+"/categorization-rules", wrapper.CreateCategorizationRule)
+	m.HandleFunc("POST "+options.BaseURL+"/categorization-rules/apply", wrapper.ApplyCategorizationRules)
+	m.HandleFunc("DELETE "+options.BaseURL+"/categorization-rules/{ruleId}", wrapper.DeleteCategorizationRule)
+	m.HandleFunc("GET "+options.BaseURL+"/categorization-rules/{ruleId}", wrapper.GetCategorizationRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/categorization-rules/{ruleId}", wrapper.UpdateCategorizationRule)
+	m.HandleFunc("GET "+options.BaseURL+"/envelopes", wrapper.GetEnvelopeMonth)
+	m.HandleFunc("POST "+options.BaseURL+"/envelopes/assign", wrapper.AssignEnvelope)
+	m.HandleFunc("GET "+options.BaseURL+"/exchange-rates", wrapper.ListExchangeRates)
+	m.HandleFunc("POST "+options.BaseURL+"/exchange-rates/import/csv", wrapper.ImportExchangeRatesCsv)
+	m.HandleFunc("POST "+options.BaseURL+"/exchange-rates/import/xml", wrapper.ImportExchangeRatesXml)
+	m.HandleFunc("GET "+options.BaseURL+"/payees", wrapper.ListPayees)
+	m.HandleFunc("POST "+options.BaseURL+"/payees", wrapper.CreatePayee)
+	m.HandleFunc("POST "+options.BaseURL+"/payees/apply", wrapper.ApplyPayeePatterns)
+	m.HandleFunc("DELETE "+options.BaseURL+"/payees/{payeeId}", wrapper.DeletePayee)
+	m.HandleFunc("GET "+options.BaseURL+"/payees/{payeeId}", wrapper.GetPayee)
+	m.HandleFunc("PUT "+options.BaseURL+"/payees/{payeeId}", wrapper.UpdatePayee)
+	m.HandleFunc("POST "+options.BaseURL+"/payees/{payeeId}/merge", wrapper.MergePayee)
+	m.HandleFunc("GET "+options.BaseURL+"/recurring-rules", wrapper.ListRecurringRules)
+	m.HandleFunc("POST "+options.BaseURL+"/recurring-rules", wrapper.CreateRecurringRule)
+	m.HandleFunc("POST "+options.BaseURL+"/recurring-rules/run", wrapper.RunRecurringRules)
+	m.HandleFunc("DELETE "+options.BaseURL+"/recurring-rules/{ruleId}", wrapper.DeleteRecurringRule)
+	m.HandleFunc("GET "+options.BaseURL+"/recurring-rules/{ruleId}", wrapper.GetRecurringRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/recurring-rules/{ruleId}", wrapper.UpdateRecurringRule)
+	m.HandleFunc("GET "+options.BaseURL+"/tags", wrapper.ListTags)
+	m.HandleFunc("POST "+options.BaseURL+"/tags", wrapper.CreateTag)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tags/{tagId}", wrapper.DeleteTag)
+	m.HandleFunc("GET "+options.BaseURL+"/tags/{tagId}", wrapper.GetTag)
+	m.HandleFunc("PUT "+options.BaseURL+"/tags/{tagId}", wrapper.UpdateTag)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions", wrapper.ListTransactions)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions", wrapper.CreateTransaction)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions/duplicates", wrapper.FindDuplicateTransactions)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions/duplicates/resolve", wrapper.ResolveDuplicateTransactions)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions/export", wrapper.ExportTransactions)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions/import", wrapper.ImportTransactions)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions/import/camt", wrapper.ImportTransactionsCamt)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions/import/ofx", wrapper.ImportTransactionsOfx)
+	m.HandleFunc("DELETE "+options.BaseURL+"/transactions/{transactionId}", wrapper.DeleteTransaction)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions/{transactionId}", wrapper.GetTransaction)
+	m.HandleFunc("PUT "+options.BaseURL+"/transactions/{transactionId}", wrapper.UpdateTransaction)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions/{transactionId}/history", wrapper.GetTransactionHistory)
+	m.HandleFunc("POST "+options.BaseURL+"/transactions/{transactionId}/restore", wrapper.RestoreTransaction)
+	m.HandleFunc("GET "+options.BaseURL+"/transfers", wrapper.ListTransfers)
+	m.HandleFunc("POST "+options.BaseURL+"/transfers", wrapper.CreateTransfer)
+	m.HandleFunc("DELETE "+options.BaseURL+"/transfers/{transferId}", wrapper.DeleteTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/transfers/{transferId}", wrapper.GetTransfer)
+	m.HandleFunc("PUT "+options.BaseURL+"/transfers/{transferId}", wrapper.UpdateTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/trash", wrapper.GetTrash)
+
+	return m
+}
+
+type ListAccountsRequestObject struct {
+}
+
+type ListAccountsResponseObject interface {
+	VisitListAccountsResponse(w http.ResponseWriter) error
+}
+
+type ListAccounts200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListAccounts200JSONResponse struct {
+	Body    AccountList
+	Headers ListAccounts200ResponseHeaders
+}
+
+func (response ListAccounts200JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAccountRequestObject struct {
+	Body *CreateAccountJSONRequestBody
+}
+
+type CreateAccountResponseObject interface {
+	VisitCreateAccountResponse(w http.ResponseWriter) error
+}
+
+type CreateAccount201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateAccount201JSONResponse struct {
+	Body    Account
+	Headers CreateAccount201ResponseHeaders
+}
+
+func (response CreateAccount201JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateAccount400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateAccount400JSONResponse struct {
+	Body    Error
+	Headers CreateAccount400ResponseHeaders
+}
+
+func (response CreateAccount400JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAccountRequestObject struct {
+	AccountId int64 `json:"accountId"`
+}
+
+type DeleteAccountResponseObject interface {
+	VisitDeleteAccountResponse(w http.ResponseWriter) error
+}
+
+type DeleteAccount204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteAccount204Response struct {
+	Headers DeleteAccount204ResponseHeaders
+}
+
+func (response DeleteAccount204Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAccount404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteAccount404JSONResponse struct {
+	Body    Error
+	Headers DeleteAccount404ResponseHeaders
+}
+
+func (response DeleteAccount404JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAccount409ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteAccount409JSONResponse struct {
+	Body    Error
+	Headers DeleteAccount409ResponseHeaders
+}
+
+func (response DeleteAccount409JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAccountRequestObject struct {
+	AccountId int64 `json:"accountId"`
+}
+
+type GetAccountResponseObject interface {
+	VisitGetAccountResponse(w http.ResponseWriter) error
+}
+
+type GetAccount200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetAccount200JSONResponse struct {
+	Body    Account
+	Headers GetAccount200ResponseHeaders
+}
+
+func (response GetAccount200JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAccount404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetAccount404JSONResponse struct {
+	Body    Error
+	Headers GetAccount404ResponseHeaders
+}
+
+func (response GetAccount404JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAccountRequestObject struct {
+	AccountId int64 `json:"accountId"`
+	Body      *UpdateAccountJSONRequestBody
+}
+
+type UpdateAccountResponseObject interface {
+	VisitUpdateAccountResponse(w http.ResponseWriter) error
+}
+
+type UpdateAccount200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateAccount200JSONResponse struct {
+	Body    Account
+	Headers UpdateAccount200ResponseHeaders
+}
+
+func (response UpdateAccount200JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAccount400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateAccount400JSONResponse struct {
+	Body    Error
+	Headers UpdateAccount400ResponseHeaders
+}
+
+func (response UpdateAccount400JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAccount404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateAccount404JSONResponse struct {
+	Body    Error
+	Headers UpdateAccount404ResponseHeaders
+}
+
+func (response UpdateAccount404JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBudgetVsActualRequestObject struct {
+	Params GetBudgetVsActualParams
+}
+
+type GetBudgetVsActualResponseObject interface {
+	VisitGetBudgetVsActualResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetVsActual200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetBudgetVsActual200JSONResponse struct {
+	Body    BudgetVsActual
+	Headers GetBudgetVsActual200ResponseHeaders
+}
+
+func (response GetBudgetVsActual200JSONResponse) VisitGetBudgetVsActualResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBudgetVsActual400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetBudgetVsActual400JSONResponse struct {
+	Body    Error
+	Headers GetBudgetVsActual400ResponseHeaders
+}
+
+func (response GetBudgetVsActual400JSONResponse) VisitGetBudgetVsActualResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCashFlowRequestObject struct {
+	Params GetCashFlowParams
+}
+
+type GetCashFlowResponseObject interface {
+	VisitGetCashFlowResponse(w http.ResponseWriter) error
+}
+
+type GetCashFlow200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetCashFlow200JSONResponse struct {
+	Body    CashFlow
+	Headers GetCashFlow200ResponseHeaders
+}
+
+func (response GetCashFlow200JSONResponse) VisitGetCashFlowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCashFlow400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetCashFlow400JSONResponse struct {
+	Body    Error
+	Headers GetCashFlow400ResponseHeaders
+}
+
+func (response GetCashFlow400JSONResponse) VisitGetCashFlowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMonthlySavingsRequestObject struct {
+	Params GetMonthlySavingsParams
+}
+
+type GetMonthlySavingsResponseObject interface {
+	VisitGetMonthlySavingsResponse(w http.ResponseWriter) error
+}
+
+type GetMonthlySavings200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetMonthlySavings200JSONResponse struct {
+	Body    MonthlySavings
+	Headers GetMonthlySavings200ResponseHeaders
+}
+
+func (response GetMonthlySavings200JSONResponse) VisitGetMonthlySavingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMonthlySavings400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetMonthlySavings400JSONResponse struct {
+	Body    Error
+	Headers GetMonthlySavings400ResponseHeaders
+}
+
+func (response GetMonthlySavings400JSONResponse) VisitGetMonthlySavingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTagTotalsRequestObject struct {
+	Params GetTagTotalsParams
+}
+
+type GetTagTotalsResponseObject interface {
+	VisitGetTagTotalsResponse(w http.ResponseWriter) error
+}
+
+type GetTagTotals200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTagTotals200JSONResponse struct {
+	Body    TagTotalList
+	Headers GetTagTotals200ResponseHeaders
+}
+
+func (response GetTagTotals200JSONResponse) VisitGetTagTotalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTagTotals400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTagTotals400JSONResponse struct {
+	Body    Error
+	Headers GetTagTotals400ResponseHeaders
+}
+
+func (response GetTagTotals400JSONResponse) VisitGetTagTotalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTopPayeesRequestObject struct {
+	Params GetTopPayeesParams
+}
+
+type GetTopPayeesResponseObject interface {
+	VisitGetTopPayeesResponse(w http.ResponseWriter) error
+}
+
+type GetTopPayees200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTopPayees200JSONResponse struct {
+	Body    TopPayeeList
+	Headers GetTopPayees200ResponseHeaders
+}
+
+func (response GetTopPayees200JSONResponse) VisitGetTopPayeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTopPayees400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTopPayees400JSONResponse struct {
+	Body    Error
+	Headers GetTopPayees400ResponseHeaders
+}
+
+func (response GetTopPayees400JSONResponse) VisitGetTopPayeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionsSummaryRequestObject struct {
+	Params GetTransactionsSummaryParams
+}
+
+type GetTransactionsSummaryResponseObject interface {
+	VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsSummary200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransactionsSummary200JSONResponse struct {
+	Body    TransactionsSummary
+	Headers GetTransactionsSummary200ResponseHeaders
+}
+
+func (response GetTransactionsSummary200JSONResponse) VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionsSummary400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransactionsSummary400JSONResponse struct {
+	Body    Error
+	Headers GetTransactionsSummary400ResponseHeaders
+}
+
+func (response GetTransactionsSummary400JSONResponse) VisitGetTransactionsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListBudgetsRequestObject struct {
+}
+
+type ListBudgetsResponseObject interface {
+	VisitListBudgetsResponse(w http.ResponseWriter) error
+}
+
+type ListBudgets200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListBudgets200JSONResponse struct {
+	Body    BudgetList
+	Headers ListBudgets200ResponseHeaders
+}
+
+func (response ListBudgets200JSONResponse) VisitListBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateBudgetRequestObject struct {
+	Body *CreateBudgetJSONRequestBody
+}
+
+type CreateBudgetResponseObject interface {
+	VisitCreateBudgetResponse(w http.ResponseWriter) error
+}
+
+type CreateBudget201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateBudget201JSONResponse struct {
+	Body    Budget
+	Headers CreateBudget201ResponseHeaders
+}
+
+func (response CreateBudget201JSONResponse) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateBudget400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateBudget400JSONResponse struct {
+	Body    Error
+	Headers CreateBudget400ResponseHeaders
+}
+
+func (response CreateBudget400JSONResponse) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteBudgetRequestObject struct {
+	BudgetId int64 `json:"budgetId"`
+}
+
+type DeleteBudgetResponseObject interface {
+	VisitDeleteBudgetResponse(w http.ResponseWriter) error
+}
+
+type DeleteBudget204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteBudget204Response struct {
+	Headers DeleteBudget204ResponseHeaders
+}
+
+func (response DeleteBudget204Response) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBudget404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteBudget404JSONResponse struct {
+	Body    Error
+	Headers DeleteBudget404ResponseHeaders
+}
+
+func (response DeleteBudget404JSONResponse) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBudgetRequestObject struct {
+	BudgetId int64 `json:"budgetId"`
+}
+
+type GetBudgetResponseObject interface {
+	VisitGetBudgetResponse(w http.ResponseWriter) error
+}
+
+type GetBudget200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetBudget200JSONResponse struct {
+	Body    Budget
+	Headers GetBudget200ResponseHeaders
+}
+
+func (response GetBudget200JSONResponse) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBudget404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetBudget404JSONResponse struct {
+	Body    Error
+	Headers GetBudget404ResponseHeaders
+}
+
+func (response GetBudget404JSONResponse) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateBudgetRequestObject struct {
+	BudgetId int64 `json:"budgetId"`
+	Body     *UpdateBudgetJSONRequestBody
+}
+
+type UpdateBudgetResponseObject interface {
+	VisitUpdateBudgetResponse(w http.ResponseWriter) error
+}
+
+type UpdateBudget200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateBudget200JSONResponse struct {
+	Body    Budget
+	Headers UpdateBudget200ResponseHeaders
+}
+
+func (response UpdateBudget200JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateBudget400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateBudget400JSONResponse struct {
+	Body    Error
+	Headers UpdateBudget400ResponseHeaders
+}
+
+func (response UpdateBudget400JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateBudget404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateBudget404JSONResponse struct {
+	Body    Error
+	Headers UpdateBudget404ResponseHeaders
+}
+
+func (response UpdateBudget404JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListCategoriesRequestObject struct {
+	Params ListCategoriesParams
+}
+
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(w http.ResponseWriter) error
+}
+
+type ListCategories200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListCategories200JSONResponse struct {
+	Body    CategoryList
+	Headers ListCategories200ResponseHeaders
+}
+
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategoryRequestObject struct {
+	Body *CreateCategoryJSONRequestBody
+}
+
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(w http.ResponseWriter) error
+}
+
+type CreateCategory201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateCategory201JSONResponse struct {
+	Body    Category
+	Headers CreateCategory201ResponseHeaders
+}
+
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategory400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateCategory400JSONResponse struct {
+	Body    Error
+	Headers CreateCategory400ResponseHeaders
+}
+
+func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SuggestCategoriesRequestObject struct {
+	Params SuggestCategoriesParams
+}
+
+type SuggestCategoriesResponseObject interface {
+	VisitSuggestCategoriesResponse(w http.ResponseWriter) error
+}
+
+type SuggestCategories200ResponseHeaders struct {
+	XRequestID string
+}
+
+type SuggestCategories200JSONResponse struct {
+	Body    CategorySuggestionList
+	Headers SuggestCategories200ResponseHeaders
+}
+
+func (response SuggestCategories200JSONResponse) VisitSuggestCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+	Params     DeleteCategoryParams
+}
+
+type DeleteCategoryResponseObject interface {
+	VisitDeleteCategoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategory204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategory204Response struct {
+	Headers DeleteCategory204ResponseHeaders
+}
+
+func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategory404JSONResponse struct {
+	Body    Error
+	Headers DeleteCategory404ResponseHeaders
+}
+
+func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteCategory409ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategory409JSONResponse struct {
+	Body    Error
+	Headers DeleteCategory409ResponseHeaders
+}
+
+func (response DeleteCategory409JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteCategory412ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategory412JSONResponse struct {
+	Body    Error
+	Headers DeleteCategory412ResponseHeaders
+}
+
+func (response DeleteCategory412JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+}
+
+type GetCategoryResponseObject interface {
+	VisitGetCategoryResponse(w http.ResponseWriter) error
+}
+
+type GetCategory200ResponseHeaders struct {
+	ETag       string
+	XRequestID string
+}
+
+type GetCategory200JSONResponse struct {
+	Body    Category
+	Headers GetCategory200ResponseHeaders
+}
+
+func (response GetCategory200JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetCategory404JSONResponse struct {
+	Body    Error
+	Headers GetCategory404ResponseHeaders
+}
+
+func (response GetCategory404JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+	Params     UpdateCategoryParams
+	Body       *UpdateCategoryJSONRequestBody
+}
+
+type UpdateCategoryResponseObject interface {
+	VisitUpdateCategoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategory200ResponseHeaders struct {
+	ETag       string
+	XRequestID string
+}
+
+type UpdateCategory200JSONResponse struct {
+	Body    Category
+	Headers UpdateCategory200ResponseHeaders
+}
+
+func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategory400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategory400JSONResponse struct {
+	Body    Error
+	Headers UpdateCategory400ResponseHeaders
+}
+
+func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategory404JSONResponse struct {
+	Body    Error
+	Headers UpdateCategory404ResponseHeaders
+}
+
+func (response UpdateCategory404JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategory412ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategory412JSONResponse struct {
+	Body    Error
+	Headers UpdateCategory412ResponseHeaders
+}
+
+func (response UpdateCategory412JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UnarchiveCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+}
+
+type UnarchiveCategoryResponseObject interface {
+	VisitUnarchiveCategoryResponse(w http.ResponseWriter) error
+}
+
+type UnarchiveCategory200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UnarchiveCategory200JSONResponse struct {
+	Body    Category
+	Headers UnarchiveCategory200ResponseHeaders
+}
+
+func (response UnarchiveCategory200JSONResponse) VisitUnarchiveCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UnarchiveCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UnarchiveCategory404JSONResponse struct {
+	Body    Error
+	Headers UnarchiveCategory404ResponseHeaders
+}
+
+func (response UnarchiveCategory404JSONResponse) VisitUnarchiveCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ArchiveCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+}
+
+type ArchiveCategoryResponseObject interface {
+	VisitArchiveCategoryResponse(w http.ResponseWriter) error
+}
+
+type ArchiveCategory200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ArchiveCategory200JSONResponse struct {
+	Body    Category
+	Headers ArchiveCategory200ResponseHeaders
+}
+
+func (response ArchiveCategory200JSONResponse) VisitArchiveCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ArchiveCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type ArchiveCategory404JSONResponse struct {
+	Body    Error
+	Headers ArchiveCategory404ResponseHeaders
+}
+
+func (response ArchiveCategory404JSONResponse) VisitArchiveCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergeCategoryRequestObject struct {
+	CategoryId int64 `json:"categoryId"`
+	Body       *MergeCategoryJSONRequestBody
+}
+
+type MergeCategoryResponseObject interface {
+	VisitMergeCategoryResponse(w http.ResponseWriter) error
+}
+
+type MergeCategory200ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergeCategory200JSONResponse struct {
+	Body    CategoryMergeResult
+	Headers MergeCategory200ResponseHeaders
+}
+
+func (response MergeCategory200JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergeCategory400ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergeCategory400JSONResponse struct {
+	Body    Error
+	Headers MergeCategory400ResponseHeaders
+}
+
+func (response MergeCategory400JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergeCategory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergeCategory404JSONResponse struct {
+	Body    Error
+	Headers MergeCategory404ResponseHeaders
+}
+
+func (response MergeCategory404JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListCategorizationRulesRequestObject struct {
+}
+
+type ListCategorizationRulesResponseObject interface {
+	VisitListCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type ListCategorizationRules200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListCategorizationRules200JSONResponse struct {
+	Body    CategorizationRuleList
+	Headers ListCategorizationRules200ResponseHeaders
+}
+
+func (response ListCategorizationRules200JSONResponse) VisitListCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategorizationRuleRequestObject struct {
+	Body *CreateCategorizationRuleJSONRequestBody
+}
+
+type CreateCategorizationRuleResponseObject interface {
+	VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateCategorizationRule201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateCategorizationRule201JSONResponse struct {
+	Body    CategorizationRule
+	Headers CreateCategorizationRule201ResponseHeaders
+}
+
+func (response CreateCategorizationRule201JSONResponse) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategorizationRule400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateCategorizationRule400JSONResponse struct {
+	Body    Error
+	Headers CreateCategorizationRule400ResponseHeaders
+}
+
+func (response CreateCategorizationRule400JSONResponse) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApplyCategorizationRulesRequestObject struct {
+	Params ApplyCategorizationRulesParams
+}
+
+type ApplyCategorizationRulesResponseObject interface {
+	VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type ApplyCategorizationRules200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ApplyCategorizationRules200JSONResponse struct {
+	Body    CategorizationApplyResult
+	Headers ApplyCategorizationRules200ResponseHeaders
+}
+
+func (response ApplyCategorizationRules200JSONResponse) VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteCategorizationRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+}
+
+type DeleteCategorizationRuleResponseObject interface {
+	VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategorizationRule204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategorizationRule204Response struct {
+	Headers DeleteCategorizationRule204ResponseHeaders
+}
+
+func (response DeleteCategorizationRule204Response) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategorizationRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteCategorizationRule404JSONResponse struct {
+	Body    Error
+	Headers DeleteCategorizationRule404ResponseHeaders
+}
+
+func (response DeleteCategorizationRule404JSONResponse) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategorizationRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+}
+
+type GetCategorizationRuleResponseObject interface {
+	VisitGetCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type GetCategorizationRule200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetCategorizationRule200JSONResponse struct {
+	Body    CategorizationRule
+	Headers GetCategorizationRule200ResponseHeaders
+}
+
+func (response GetCategorizationRule200JSONResponse) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategorizationRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetCategorizationRule404JSONResponse struct {
+	Body    Error
+	Headers GetCategorizationRule404ResponseHeaders
+}
+
+func (response GetCategorizationRule404JSONResponse) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategorizationRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+	Body   *UpdateCategorizationRuleJSONRequestBody
+}
+
+type UpdateCategorizationRuleResponseObject interface {
+	VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategorizationRule200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategorizationRule200JSONResponse struct {
+	Body    CategorizationRule
+	Headers UpdateCategorizationRule200ResponseHeaders
+}
+
+func (response UpdateCategorizationRule200JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategorizationRule400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategorizationRule400JSONResponse struct {
+	Body    Error
+	Headers UpdateCategorizationRule400ResponseHeaders
+}
+
+func (response UpdateCategorizationRule400JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategorizationRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateCategorizationRule404JSONResponse struct {
+	Body    Error
+	Headers UpdateCategorizationRule404ResponseHeaders
+}
+
+func (response UpdateCategorizationRule404JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetEnvelopeMonthRequestObject struct {
+	Params GetEnvelopeMonthParams
+}
+
+type GetEnvelopeMonthResponseObject interface {
+	VisitGetEnvelopeMonthResponse(w http.ResponseWriter) error
+}
+
+type GetEnvelopeMonth200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetEnvelopeMonth200JSONResponse struct {
+	Body    EnvelopeMonth
+	Headers GetEnvelopeMonth200ResponseHeaders
+}
+
+func (response GetEnvelopeMonth200JSONResponse) VisitGetEnvelopeMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetEnvelopeMonth400ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetEnvelopeMonth400JSONResponse struct {
+	Body    Error
+	Headers GetEnvelopeMonth400ResponseHeaders
+}
+
+func (response GetEnvelopeMonth400JSONResponse) VisitGetEnvelopeMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AssignEnvelopeRequestObject struct {
+	Body *AssignEnvelopeJSONRequestBody
+}
+
+type AssignEnvelopeResponseObject interface {
+	VisitAssignEnvelopeResponse(w http.ResponseWriter) error
+}
+
+type AssignEnvelope200ResponseHeaders struct {
+	XRequestID string
+}
+
+type AssignEnvelope200JSONResponse struct {
+	Body    EnvelopeMonth
+	Headers AssignEnvelope200ResponseHeaders
+}
+
+func (response AssignEnvelope200JSONResponse) VisitAssignEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AssignEnvelope400ResponseHeaders struct {
+	XRequestID string
+}
+
+type AssignEnvelope400JSONResponse struct {
+	Body    Error
+	Headers AssignEnvelope400ResponseHeaders
+}
+
+func (response AssignEnvelope400JSONResponse) VisitAssignEnvelopeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListExchangeRatesRequestObject struct {
+	Params ListExchangeRatesParams
+}
+
+type ListExchangeRatesResponseObject interface {
+	VisitListExchangeRatesResponse(w http.ResponseWriter) error
+}
+
+type ListExchangeRates200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListExchangeRates200JSONResponse struct {
+	Body    ExchangeRateList
+	Headers ListExchangeRates200ResponseHeaders
+}
+
+func (response ListExchangeRates200JSONResponse) VisitListExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListExchangeRates400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListExchangeRates400JSONResponse struct {
+	Body    Error
+	Headers ListExchangeRates400ResponseHeaders
+}
+
+func (response ListExchangeRates400JSONResponse) VisitListExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportExchangeRatesCsvRequestObject struct {
+	Params ImportExchangeRatesCsvParams
+	Body   io.Reader
+}
+
+type ImportExchangeRatesCsvResponseObject interface {
+	VisitImportExchangeRatesCsvResponse(w http.ResponseWriter) error
+}
+
+type ImportExchangeRatesCsv200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportExchangeRatesCsv200JSONResponse struct {
+	Body    ExchangeRateImportResult
+	Headers ImportExchangeRatesCsv200ResponseHeaders
+}
+
+func (response ImportExchangeRatesCsv200JSONResponse) VisitImportExchangeRatesCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportExchangeRatesCsv400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportExchangeRatesCsv400JSONResponse struct {
+	Body    Error
+	Headers ImportExchangeRatesCsv400ResponseHeaders
+}
+
+func (response ImportExchangeRatesCsv400JSONResponse) VisitImportExchangeRatesCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportExchangeRatesXmlRequestObject struct {
+	Params ImportExchangeRatesXmlParams
+	Body   io.Reader
+}
+
+type ImportExchangeRatesXmlResponseObject interface {
+	VisitImportExchangeRatesXmlResponse(w http.ResponseWriter) error
+}
+
+type ImportExchangeRatesXml200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportExchangeRatesXml200JSONResponse struct {
+	Body    ExchangeRateImportResult
+	Headers ImportExchangeRatesXml200ResponseHeaders
+}
+
+func (response ImportExchangeRatesXml200JSONResponse) VisitImportExchangeRatesXmlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportExchangeRatesXml400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportExchangeRatesXml400JSONResponse struct {
+	Body    Error
+	Headers ImportExchangeRatesXml400ResponseHeaders
+}
+
+func (response ImportExchangeRatesXml400JSONResponse) VisitImportExchangeRatesXmlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPayeesRequestObject struct {
+}
+
+type ListPayeesResponseObject interface {
+	VisitListPayeesResponse(w http.ResponseWriter) error
+}
+
+type ListPayees200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListPayees200JSONResponse struct {
+	Body    PayeeList
+	Headers ListPayees200ResponseHeaders
+}
+
+func (response ListPayees200JSONResponse) VisitListPayeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePayeeRequestObject struct {
+	Body *CreatePayeeJSONRequestBody
+}
+
+type CreatePayeeResponseObject interface {
+	VisitCreatePayeeResponse(w http.ResponseWriter) error
+}
+
+type CreatePayee201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreatePayee201JSONResponse struct {
+	Body    Payee
+	Headers CreatePayee201ResponseHeaders
+}
+
+func (response CreatePayee201JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePayee400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreatePayee400JSONResponse struct {
+	Body    Error
+	Headers CreatePayee400ResponseHeaders
+}
+
+func (response CreatePayee400JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApplyPayeePatternsRequestObject struct {
+	Params ApplyPayeePatternsParams
+}
+
+type ApplyPayeePatternsResponseObject interface {
+	VisitApplyPayeePatternsResponse(w http.ResponseWriter) error
+}
+
+type ApplyPayeePatterns200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ApplyPayeePatterns200JSONResponse struct {
+	Body    PayeeApplyResult
+	Headers ApplyPayeePatterns200ResponseHeaders
+}
+
+func (response ApplyPayeePatterns200JSONResponse) VisitApplyPayeePatternsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeletePayeeRequestObject struct {
+	PayeeId int64 `json:"payeeId"`
+}
+
+type DeletePayeeResponseObject interface {
+	VisitDeletePayeeResponse(w http.ResponseWriter) error
+}
+
+type DeletePayee204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeletePayee204Response struct {
+	Headers DeletePayee204ResponseHeaders
+}
+
+func (response DeletePayee204Response) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePayee404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeletePayee404JSONResponse struct {
+	Body    Error
+	Headers DeletePayee404ResponseHeaders
+}
+
+func (response DeletePayee404JSONResponse) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPayeeRequestObject struct {
+	PayeeId int64 `json:"payeeId"`
+}
+
+type GetPayeeResponseObject interface {
+	VisitGetPayeeResponse(w http.ResponseWriter) error
+}
+
+type GetPayee200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetPayee200JSONResponse struct {
+	Body    Payee
+	Headers GetPayee200ResponseHeaders
+}
+
+func (response GetPayee200JSONResponse) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPayee404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetPayee404JSONResponse struct {
+	Body    Error
+	Headers GetPayee404ResponseHeaders
+}
+
+func (response GetPayee404JSONResponse) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePayeeRequestObject struct {
+	PayeeId int64 `json:"payeeId"`
+	Body    *UpdatePayeeJSONRequestBody
+}
+
+type UpdatePayeeResponseObject interface {
+	VisitUpdatePayeeResponse(w http.ResponseWriter) error
+}
+
+type UpdatePayee200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdatePayee200JSONResponse struct {
+	Body    Payee
+	Headers UpdatePayee200ResponseHeaders
+}
+
+func (response UpdatePayee200JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePayee400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdatePayee400JSONResponse struct {
+	Body    Error
+	Headers UpdatePayee400ResponseHeaders
+}
+
+func (response UpdatePayee400JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePayee404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdatePayee404JSONResponse struct {
+	Body    Error
+	Headers UpdatePayee404ResponseHeaders
+}
+
+func (response UpdatePayee404JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePayeeRequestObject struct {
+	PayeeId int64 `json:"payeeId"`
+	Body    *MergePayeeJSONRequestBody
+}
+
+type MergePayeeResponseObject interface {
+	VisitMergePayeeResponse(w http.ResponseWriter) error
+}
+
+type MergePayee200ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergePayee200JSONResponse struct {
+	Body    PayeeMergeResult
+	Headers MergePayee200ResponseHeaders
+}
+
+func (response MergePayee200JSONResponse) VisitMergePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePayee400ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergePayee400JSONResponse struct {
+	Body    Error
+	Headers MergePayee400ResponseHeaders
+}
+
+func (response MergePayee400JSONResponse) VisitMergePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePayee404ResponseHeaders struct {
+	XRequestID string
+}
+
+type MergePayee404JSONResponse struct {
+	Body    Error
+	Headers MergePayee404ResponseHeaders
+}
+
+func (response MergePayee404JSONResponse) VisitMergePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListRecurringRulesRequestObject struct {
+}
+
+type ListRecurringRulesResponseObject interface {
+	VisitListRecurringRulesResponse(w http.ResponseWriter) error
+}
+
+type ListRecurringRules200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListRecurringRules200JSONResponse struct {
+	Body    RecurringRuleList
+	Headers ListRecurringRules200ResponseHeaders
+}
+
+func (response ListRecurringRules200JSONResponse) VisitListRecurringRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateRecurringRuleRequestObject struct {
+	Body *CreateRecurringRuleJSONRequestBody
+}
+
+type CreateRecurringRuleResponseObject interface {
+	VisitCreateRecurringRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateRecurringRule201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateRecurringRule201JSONResponse struct {
+	Body    RecurringRule
+	Headers CreateRecurringRule201ResponseHeaders
+}
+
+func (response CreateRecurringRule201JSONResponse) VisitCreateRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateRecurringRule400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateRecurringRule400JSONResponse struct {
+	Body    Error
+	Headers CreateRecurringRule400ResponseHeaders
+}
+
+func (response CreateRecurringRule400JSONResponse) VisitCreateRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RunRecurringRulesRequestObject struct {
+	Params RunRecurringRulesParams
+}
+
+type RunRecurringRulesResponseObject interface {
+	VisitRunRecurringRulesResponse(w http.ResponseWriter) error
+}
+
+type RunRecurringRules200ResponseHeaders struct {
+	XRequestID string
+}
+
+type RunRecurringRules200JSONResponse struct {
+	Body    RecurringRunResult
+	Headers RunRecurringRules200ResponseHeaders
+}
+
+func (response RunRecurringRules200JSONResponse) VisitRunRecurringRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteRecurringRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+}
+
+type DeleteRecurringRuleResponseObject interface {
+	VisitDeleteRecurringRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteRecurringRule204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteRecurringRule204Response struct {
+	Headers DeleteRecurringRule204ResponseHeaders
+}
+
+func (response DeleteRecurringRule204Response) VisitDeleteRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRecurringRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteRecurringRule404JSONResponse struct {
+	Body    Error
+	Headers DeleteRecurringRule404ResponseHeaders
+}
+
+func (response DeleteRecurringRule404JSONResponse) VisitDeleteRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRecurringRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+}
+
+type GetRecurringRuleResponseObject interface {
+	VisitGetRecurringRuleResponse(w http.ResponseWriter) error
+}
+
+type GetRecurringRule200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetRecurringRule200JSONResponse struct {
+	Body    RecurringRule
+	Headers GetRecurringRule200ResponseHeaders
+}
+
+func (response GetRecurringRule200JSONResponse) VisitGetRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRecurringRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetRecurringRule404JSONResponse struct {
+	Body    Error
+	Headers GetRecurringRule404ResponseHeaders
+}
+
+func (response GetRecurringRule404JSONResponse) VisitGetRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateRecurringRuleRequestObject struct {
+	RuleId int64 `json:"ruleId"`
+	Body   *UpdateRecurringRuleJSONRequestBody
+}
+
+type UpdateRecurringRuleResponseObject interface {
+	VisitUpdateRecurringRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRecurringRule200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateRecurringRule200JSONResponse struct {
+	Body    RecurringRuleUpdateResult
+	Headers UpdateRecurringRule200ResponseHeaders
+}
+
+func (response UpdateRecurringRule200JSONResponse) VisitUpdateRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateRecurringRule400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateRecurringRule400JSONResponse struct {
+	Body    Error
+	Headers UpdateRecurringRule400ResponseHeaders
+}
+
+func (response UpdateRecurringRule400JSONResponse) VisitUpdateRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateRecurringRule404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateRecurringRule404JSONResponse struct {
+	Body    Error
+	Headers UpdateRecurringRule404ResponseHeaders
+}
+
+func (response UpdateRecurringRule404JSONResponse) VisitUpdateRecurringRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTagsRequestObject struct {
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListTags200JSONResponse struct {
+	Body    TagList
+	Headers ListTags200ResponseHeaders
+}
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTag201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTag201JSONResponse struct {
+	Body    Tag
+	Headers CreateTag201ResponseHeaders
+}
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTag400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTag400JSONResponse struct {
+	Body    Error
+	Headers CreateTag400ResponseHeaders
+}
+
+func (response CreateTag400JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTagRequestObject struct {
+	TagId int64 `json:"tagId"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTag204Response struct {
+	Headers DeleteTag204ResponseHeaders
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTag404JSONResponse struct {
+	Body    Error
+	Headers DeleteTag404ResponseHeaders
+}
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTagRequestObject struct {
+	TagId int64 `json:"tagId"`
+}
+
+type GetTagResponseObject interface {
+	VisitGetTagResponse(w http.ResponseWriter) error
+}
+
+type GetTag200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTag200JSONResponse struct {
+	Body    Tag
+	Headers GetTag200ResponseHeaders
+}
+
+func (response GetTag200JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTag404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTag404JSONResponse struct {
+	Body    Error
+	Headers GetTag404ResponseHeaders
+}
+
+func (response GetTag404JSONResponse) VisitGetTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTagRequestObject struct {
+	TagId int64 `json:"tagId"`
+	Body  *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateTag200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTag200JSONResponse struct {
+	Body    Tag
+	Headers UpdateTag200ResponseHeaders
+}
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTag400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTag400JSONResponse struct {
+	Body    Error
+	Headers UpdateTag400ResponseHeaders
+}
+
+func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTag404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTag404JSONResponse struct {
+	Body    Error
+	Headers UpdateTag404ResponseHeaders
+}
+
+func (response UpdateTag404JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTransactionsRequestObject struct {
+	Params ListTransactionsParams
+}
+
+type ListTransactionsResponseObject interface {
+	VisitListTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ListTransactions200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListTransactions200JSONResponse struct {
+	Body    TransactionList
+	Headers ListTransactions200ResponseHeaders
+}
+
+func (response ListTransactions200JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTransactions400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListTransactions400JSONResponse struct {
+	Body    Error
+	Headers ListTransactions400ResponseHeaders
+}
+
+func (response ListTransactions400JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTransactionRequestObject struct {
+	Body *CreateTransactionJSONRequestBody
+}
+
+type CreateTransactionResponseObject interface {
+	VisitCreateTransactionResponse(w http.ResponseWriter) error
+}
+
+type CreateTransaction201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTransaction201JSONResponse struct {
+	Body    Transaction
+	Headers CreateTransaction201ResponseHeaders
+}
+
+func (response CreateTransaction201JSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTransaction400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTransaction400JSONResponse struct {
+	Body    Error
+	Headers CreateTransaction400ResponseHeaders
+}
+
+func (response CreateTransaction400JSONResponse) VisitCreateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FindDuplicateTransactionsRequestObject struct {
+	Params FindDuplicateTransactionsParams
+}
+
+type FindDuplicateTransactionsResponseObject interface {
+	VisitFindDuplicateTransactionsResponse(w http.ResponseWriter) error
+}
+
+type FindDuplicateTransactions200ResponseHeaders struct {
+	XRequestID string
+}
+
+type FindDuplicateTransactions200JSONResponse struct {
+	Body    DuplicateGroupList
+	Headers FindDuplicateTransactions200ResponseHeaders
+}
+
+func (response FindDuplicateTransactions200JSONResponse) VisitFindDuplicateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FindDuplicateTransactions400ResponseHeaders struct {
+	XRequestID string
+}
+
+type FindDuplicateTransactions400JSONResponse struct {
+	Body    Error
+	Headers FindDuplicateTransactions400ResponseHeaders
+}
+
+func (response FindDuplicateTransactions400JSONResponse) VisitFindDuplicateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResolveDuplicateTransactionsRequestObject struct {
+	Body *ResolveDuplicateTransactionsJSONRequestBody
+}
+
+type ResolveDuplicateTransactionsResponseObject interface {
+	VisitResolveDuplicateTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ResolveDuplicateTransactions200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ResolveDuplicateTransactions200JSONResponse struct {
+	Body    DuplicateResolveResult
+	Headers ResolveDuplicateTransactions200ResponseHeaders
+}
+
+func (response ResolveDuplicateTransactions200JSONResponse) VisitResolveDuplicateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResolveDuplicateTransactions400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ResolveDuplicateTransactions400JSONResponse struct {
+	Body    Error
+	Headers ResolveDuplicateTransactions400ResponseHeaders
+}
+
+func (response ResolveDuplicateTransactions400JSONResponse) VisitResolveDuplicateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResolveDuplicateTransactions404ResponseHeaders struct {
+	XRequestID string
+}
+
+type ResolveDuplicateTransactions404JSONResponse struct {
+	Body    Error
+	Headers ResolveDuplicateTransactions404ResponseHeaders
+}
+
+func (response ResolveDuplicateTransactions404JSONResponse) VisitResolveDuplicateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportTransactionsRequestObject struct {
+	Params ExportTransactionsParams
+}
+
+type ExportTransactionsResponseObject interface {
+	VisitExportTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ExportTransactions200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ExportTransactions200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       ExportTransactions200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportTransactions200ApplicationxNdjsonResponse) VisitExportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTransactions200ApplicationxOfxResponse struct {
+	Body          io.Reader
+	Headers       ExportTransactions200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportTransactions200ApplicationxOfxResponse) VisitExportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ofx")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTransactions200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       ExportTransactions200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportTransactions200TextcsvResponse) VisitExportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTransactions400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ExportTransactions400JSONResponse struct {
+	Body    Error
+	Headers ExportTransactions400ResponseHeaders
+}
+
+func (response ExportTransactions400JSONResponse) VisitExportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactionsRequestObject struct {
+	Params ImportTransactionsParams
+	Body   io.Reader
+}
+
+type ImportTransactionsResponseObject interface {
+	VisitImportTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ImportTransactions200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactions200JSONResponse struct {
+	Body    ImportResult
+	Headers ImportTransactions200ResponseHeaders
+}
+
+func (response ImportTransactions200JSONResponse) VisitImportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactions400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactions400JSONResponse struct {
+	Body    Error
+	Headers ImportTransactions400ResponseHeaders
+}
+
+func (response ImportTransactions400JSONResponse) VisitImportTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactionsCamtRequestObject struct {
+	Params ImportTransactionsCamtParams
+	Body   io.Reader
+}
+
+type ImportTransactionsCamtResponseObject interface {
+	VisitImportTransactionsCamtResponse(w http.ResponseWriter) error
+}
+
+type ImportTransactionsCamt200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactionsCamt200JSONResponse struct {
+	Body    ImportResult
+	Headers ImportTransactionsCamt200ResponseHeaders
+}
+
+func (response ImportTransactionsCamt200JSONResponse) VisitImportTransactionsCamtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactionsCamt400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactionsCamt400JSONResponse struct {
+	Body    Error
+	Headers ImportTransactionsCamt400ResponseHeaders
+}
+
+func (response ImportTransactionsCamt400JSONResponse) VisitImportTransactionsCamtResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactionsOfxRequestObject struct {
+	Params ImportTransactionsOfxParams
+	Body   io.Reader
+}
+
+type ImportTransactionsOfxResponseObject interface {
+	VisitImportTransactionsOfxResponse(w http.ResponseWriter) error
+}
+
+type ImportTransactionsOfx200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactionsOfx200JSONResponse struct {
+	Body    ImportResult
+	Headers ImportTransactionsOfx200ResponseHeaders
+}
+
+func (response ImportTransactionsOfx200JSONResponse) VisitImportTransactionsOfxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTransactionsOfx400ResponseHeaders struct {
+	XRequestID string
+}
+
+type ImportTransactionsOfx400JSONResponse struct {
+	Body    Error
+	Headers ImportTransactionsOfx400ResponseHeaders
+}
+
+func (response ImportTransactionsOfx400JSONResponse) VisitImportTransactionsOfxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTransactionRequestObject struct {
+	TransactionId int64 `json:"transactionId"`
+	Params        DeleteTransactionParams
+}
+
+type DeleteTransactionResponseObject interface {
+	VisitDeleteTransactionResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransaction204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTransaction204Response struct {
+	Headers DeleteTransaction204ResponseHeaders
+}
+
+func (response DeleteTransaction204Response) VisitDeleteTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransaction404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTransaction404JSONResponse struct {
+	Body    Error
+	Headers DeleteTransaction404ResponseHeaders
+}
+
+func (response DeleteTransaction404JSONResponse) VisitDeleteTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTransaction412ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTransaction412JSONResponse struct {
+	Body    Error
+	Headers DeleteTransaction412ResponseHeaders
+}
+
+func (response DeleteTransaction412JSONResponse) VisitDeleteTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionRequestObject struct {
+	TransactionId int64 `json:"transactionId"`
+}
+
+type GetTransactionResponseObject interface {
+	VisitGetTransactionResponse(w http.ResponseWriter) error
+}
+
+type GetTransaction200ResponseHeaders struct {
+	ETag       string
+	XRequestID string
+}
+
+type GetTransaction200JSONResponse struct {
+	Body    Transaction
+	Headers GetTransaction200ResponseHeaders
+}
+
+func (response GetTransaction200JSONResponse) VisitGetTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransaction404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransaction404JSONResponse struct {
+	Body    Error
+	Headers GetTransaction404ResponseHeaders
+}
+
+func (response GetTransaction404JSONResponse) VisitGetTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransactionRequestObject struct {
+	TransactionId int64 `json:"transactionId"`
+	Params        UpdateTransactionParams
+	Body          *UpdateTransactionJSONRequestBody
+}
+
+type UpdateTransactionResponseObject interface {
+	VisitUpdateTransactionResponse(w http.ResponseWriter) error
+}
+
+type UpdateTransaction200ResponseHeaders struct {
+	ETag       string
+	XRequestID string
+}
+
+type UpdateTransaction200JSONResponse struct {
+	Body    Transaction
+	Headers UpdateTransaction200ResponseHeaders
+}
+
+func (response UpdateTransaction200JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransaction400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransaction400JSONResponse struct {
+	Body    Error
+	Headers UpdateTransaction400ResponseHeaders
+}
+
+func (response UpdateTransaction400JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransaction404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransaction404JSONResponse struct {
+	Body    Error
+	Headers UpdateTransaction404ResponseHeaders
+}
+
+func (response UpdateTransaction404JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransaction412ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransaction412JSONResponse struct {
+	Body    Error
+	Headers UpdateTransaction412ResponseHeaders
+}
+
+func (response UpdateTransaction412JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionHistoryRequestObject struct {
+	TransactionId int64 `json:"transactionId"`
+}
+
+type GetTransactionHistoryResponseObject interface {
+	VisitGetTransactionHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionHistory200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransactionHistory200JSONResponse struct {
+	Body    TransactionHistory
+	Headers GetTransactionHistory200ResponseHeaders
+}
+
+func (response GetTransactionHistory200JSONResponse) VisitGetTransactionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransactionHistory404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransactionHistory404JSONResponse struct {
+	Body    Error
+	Headers GetTransactionHistory404ResponseHeaders
+}
+
+func (response GetTransactionHistory404JSONResponse) VisitGetTransactionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreTransactionRequestObject struct {
+	TransactionId int64 `json:"transactionId"`
+}
+
+type RestoreTransactionResponseObject interface {
+	VisitRestoreTransactionResponse(w http.ResponseWriter) error
+}
+
+type RestoreTransaction200ResponseHeaders struct {
+	XRequestID string
+}
+
+type RestoreTransaction200JSONResponse struct {
+	Body    Transaction
+	Headers RestoreTransaction200ResponseHeaders
+}
+
+func (response RestoreTransaction200JSONResponse) VisitRestoreTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreTransaction404ResponseHeaders struct {
+	XRequestID string
+}
+
+type RestoreTransaction404JSONResponse struct {
+	Body    Error
+	Headers RestoreTransaction404ResponseHeaders
+}
+
+func (response RestoreTransaction404JSONResponse) VisitRestoreTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTransfersRequestObject struct {
+}
+
+type ListTransfersResponseObject interface {
+	VisitListTransfersResponse(w http.ResponseWriter) error
+}
+
+type ListTransfers200ResponseHeaders struct {
+	XRequestID string
+}
+
+type ListTransfers200JSONResponse struct {
+	Body    TransferList
+	Headers ListTransfers200ResponseHeaders
+}
+
+func (response ListTransfers200JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTransferRequestObject struct {
+	Body *CreateTransferJSONRequestBody
+}
+
+type CreateTransferResponseObject interface {
+	VisitCreateTransferResponse(w http.ResponseWriter) error
+}
+
+type CreateTransfer201ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTransfer201JSONResponse struct {
+	Body    Transfer
+	Headers CreateTransfer201ResponseHeaders
+}
+
+func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTransfer400ResponseHeaders struct {
+	XRequestID string
+}
+
+type CreateTransfer400JSONResponse struct {
+	Body    Error
+	Headers CreateTransfer400ResponseHeaders
+}
+
+func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTransferRequestObject struct {
+	TransferId int64 `json:"transferId"`
+}
+
+type DeleteTransferResponseObject interface {
+	VisitDeleteTransferResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransfer204ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTransfer204Response struct {
+	Headers DeleteTransfer204ResponseHeaders
+}
+
+func (response DeleteTransfer204Response) VisitDeleteTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransfer404ResponseHeaders struct {
+	XRequestID string
+}
+
+type DeleteTransfer404JSONResponse struct {
+	Body    Error
+	Headers DeleteTransfer404ResponseHeaders
+}
+
+func (response DeleteTransfer404JSONResponse) VisitDeleteTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransferRequestObject struct {
+	TransferId int64 `json:"transferId"`
+}
+
+type GetTransferResponseObject interface {
+	VisitGetTransferResponse(w http.ResponseWriter) error
+}
+
+type GetTransfer200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransfer200JSONResponse struct {
+	Body    Transfer
+	Headers GetTransfer200ResponseHeaders
+}
+
+func (response GetTransfer200JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTransfer404ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTransfer404JSONResponse struct {
+	Body    Error
+	Headers GetTransfer404ResponseHeaders
+}
+
+func (response GetTransfer404JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransferRequestObject struct {
+	TransferId int64 `json:"transferId"`
+	Body       *UpdateTransferJSONRequestBody
+}
+
+type UpdateTransferResponseObject interface {
+	VisitUpdateTransferResponse(w http.ResponseWriter) error
+}
+
+type UpdateTransfer200ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransfer200JSONResponse struct {
+	Body    Transfer
+	Headers UpdateTransfer200ResponseHeaders
+}
+
+func (response UpdateTransfer200JSONResponse) VisitUpdateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransfer400ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransfer400JSONResponse struct {
+	Body    Error
+	Headers UpdateTransfer400ResponseHeaders
+}
+
+func (response UpdateTransfer400JSONResponse) VisitUpdateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTransfer404ResponseHeaders struct {
+	XRequestID string
+}
+
+type UpdateTransfer404JSONResponse struct {
+	Body    Error
+	Headers UpdateTransfer404ResponseHeaders
+}
+
+func (response UpdateTransfer404JSONResponse) VisitUpdateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTrashRequestObject struct {
+}
+
+type GetTrashResponseObject interface {
+	VisitGetTrashResponse(w http.ResponseWriter) error
+}
+
+type GetTrash200ResponseHeaders struct {
+	XRequestID string
+}
+
+type GetTrash200JSONResponse struct {
+	Body    Trash
+	Headers GetTrash200ResponseHeaders
+}
+
+func (response GetTrash200JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List accounts with their balances
+	// (GET /accounts)
+	ListAccounts(ctx context.Context, request ListAccountsRequestObject) (ListAccountsResponseObject, error)
+	// Create an account
+	// (POST /accounts)
+	CreateAccount(ctx context.Context, request CreateAccountRequestObject) (CreateAccountResponseObject, error)
+	// Delete an account without transactions or recurring rules
+	// (DELETE /accounts/{accountId})
+	DeleteAccount(ctx context.Context, request DeleteAccountRequestObject) (DeleteAccountResponseObject, error)
+	// Get an account with its balance
+	// (GET /accounts/{accountId})
+	GetAccount(ctx context.Context, request GetAccountRequestObject) (GetAccountResponseObject, error)
+	// Update an account
+	// (PUT /accounts/{accountId})
+	UpdateAccount(ctx context.Context, request UpdateAccountRequestObject) (UpdateAccountResponseObject, error)
+	// Compare monthly budgets with actual spending
+	// (GET /analytics/budget-vs-actual)
+	GetBudgetVsActual(ctx context.Context, request GetBudgetVsActualRequestObject) (GetBudgetVsActualResponseObject, error)
+	// Projected daily balance
+	// (GET /analytics/cash-flow)
+	GetCashFlow(ctx context.Context, request GetCashFlowRequestObject) (GetCashFlowResponseObject, error)
+	// Get monthly net savings for a year
+	// (GET /analytics/monthly-savings)
+	GetMonthlySavings(ctx context.Context, request GetMonthlySavingsRequestObject) (GetMonthlySavingsResponseObject, error)
+	// Spending and income per tag
+	// (GET /analytics/tags)
+	GetTagTotals(ctx context.Context, request GetTagTotalsRequestObject) (GetTagTotalsResponseObject, error)
+	// Payees ranked by spending
+	// (GET /analytics/top-payees)
+	GetTopPayees(ctx context.Context, request GetTopPayeesRequestObject) (GetTopPayeesResponseObject, error)
+	// Get monthly spending and income summary by category
+	// (GET /analytics/transactions-summary)
+	GetTransactionsSummary(ctx context.Context, request GetTransactionsSummaryRequestObject) (GetTransactionsSummaryResponseObject, error)
+	// List budgets
+	// (GET /budgets)
+	ListBudgets(ctx context.Context, request ListBudgetsRequestObject) (ListBudgetsResponseObject, error)
+	// Create a monthly budget for a category
+	// (POST /budgets)
+	CreateBudget(ctx context.Context, request CreateBudgetRequestObject) (CreateBudgetResponseObject, error)
+	// Delete a budget
+	// (DELETE /budgets/{budgetId})
+	DeleteBudget(ctx context.Context, request DeleteBudgetRequestObject) (DeleteBudgetResponseObject, error)
+	// Get a budget
+	// (GET /budgets/{budgetId})
+	GetBudget(ctx context.Context, request GetBudgetRequestObject) (GetBudgetResponseObject, error)
+	// Update a budget
+	// (PUT /budgets/{budgetId})
+	UpdateBudget(ctx context.Context, request UpdateBudgetRequestObject) (UpdateBudgetResponseObject, error)
+	// List categories
+	// (GET /categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+	// Create a category
+	// (POST /categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+	// Suggest categories for a transaction
+	// (GET /categories/suggestions)
+	SuggestCategories(ctx context.Context, request SuggestCategoriesRequestObject) (SuggestCategoriesResponseObject, error)
+	// Delete a category
+	// (DELETE /categories/{categoryId})
+	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
+	// Get a category
+	// (GET /categories/{categoryId})
+	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
+	// Update a category
+	// (PUT /categories/{categoryId})
+	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
+	// Unarchive a category
+	// (DELETE /categories/{categoryId}/archive)
+	UnarchiveCategory(ctx context.Context, request UnarchiveCategoryRequestObject) (UnarchiveCategoryResponseObject, error)
+	// Archive a category
+	// (POST /categories/{categoryId}/archive)
+	ArchiveCategory(ctx context.Context, request ArchiveCategoryRequestObject) (ArchiveCategoryResponseObject, error)
+	// Merge a category into another one
+	// (POST /categories/{categoryId}/merge)
+	MergeCategory(ctx context.Context, request MergeCategoryRequestObject) (MergeCategoryResponseObject, error)
+	// List categorization rules
+	// (GET /categorization-rules)
+	ListCategorizationRules(ctx context.Context, request ListCategorizationRulesRequestObject) (ListCategorizationRulesResponseObject, error)
+	// Create a categorization rule
+	// (POST /categorization-rules)
+	CreateCategorizationRule(ctx context.Context, request CreateCategorizationRuleRequestObject) (CreateCategorizationRuleResponseObject, error)
+	// Categorize existing transactions
+	// (POST /categorization-rules/apply)
+	ApplyCategorizationRules(ctx context.Context, request ApplyCategorizationRulesRequestObject) (ApplyCategorizationRulesResponseObject, error)
+	// Delete a categorization rule
+	// (DELETE /categorization-rules/{ruleId})
+	DeleteCategorizationRule(ctx context.Context, request DeleteCategorizationRuleRequestObject) (DeleteCategorizationRuleResponseObject, error)
+	// Get a categorization rule
+	// (GET /categorization-rules/{ruleId})
+	GetCategorizationRule(ctx context.Context, request GetCategorizationRuleRequestObject) (GetCategorizationRuleResponseObject, error)
+	// Update a categorization rule
+	// (PUT /categorization-rules/{ruleId})
+	UpdateCategorizationRule(ctx context.Context, request UpdateCategorizationRuleRequestObject) (UpdateCategorizationRuleResponseObject, error)
+	// Get the envelope budget for a month
+	// (GET /envelopes)
+	GetEnvelopeMonth(ctx context.Context, request GetEnvelopeMonthRequestObject) (GetEnvelopeMonthResponseObject, error)
+	// Assign money to a category envelope
+	// (POST /envelopes/assign)
+	AssignEnvelope(ctx context.Context, request AssignEnvelopeRequestObject) (AssignEnvelopeResponseObject, error)
+	// List exchange rates
+	// (GET /exchange-rates)
+	ListExchangeRates(ctx context.Context, request ListExchangeRatesRequestObject) (ListExchangeRatesResponseObject, error)
+	// Import exchange rates from an ECB CSV file
+	// (POST /exchange-rates/import/csv)
+	ImportExchangeRatesCsv(ctx context.Context, request ImportExchangeRatesCsvRequestObject) (ImportExchangeRatesCsvResponseObject, error)
+	// Import exchange rates from an ECB XML file
+	// (POST /exchange-rates/import/xml)
+	ImportExchangeRatesXml(ctx context.Context, request ImportExchangeRatesXmlRequestObject) (ImportExchangeRatesXmlResponseObject, error)
+	// List payees
+	// (GET /payees)
+	ListPayees(ctx context.Context, request ListPayeesRequestObject) (ListPayeesResponseObject, error)
+	// Create a payee
+	// (POST /payees)
+	CreatePayee(ctx context.Context, request CreatePayeeRequestObject) (CreatePayeeResponseObject, error)
+	// Link existing transactions to payees
+	// (POST /payees/apply)
+	ApplyPayeePatterns(ctx context.Context, request ApplyPayeePatternsRequestObject) (ApplyPayeePatternsResponseObject, error)
+	// Delete a payee
+	// (DELETE /payees/{payeeId})
+	DeletePayee(ctx context.Context, request DeletePayeeRequestObject) (DeletePayeeResponseObject, error)
+	// Get a payee
+	// (GET /payees/{payeeId})
+	GetPayee(ctx context.Context, request GetPayeeRequestObject) (GetPayeeResponseObject, error)
+	// Update a payee
+	// (PUT /payees/{payeeId})
+	UpdatePayee(ctx context.Context, request UpdatePayeeRequestObject) (UpdatePayeeResponseObject, error)
+	// Merge a payee into another one
+	// (POST /payees/{payeeId}/merge)
+	MergePayee(ctx context.Context, request MergePayeeRequestObject) (MergePayeeResponseObject, error)
+	// List recurring rules
+	// (GET /recurring-rules)
+	ListRecurringRules(ctx context.Context, request ListRecurringRulesRequestObject) (ListRecurringRulesResponseObject, error)
+	// Create a recurring rule
+	// (POST /recurring-rules)
+	CreateRecurringRule(ctx context.Context, request CreateRecurringRuleRequestObject) (CreateRecurringRuleResponseObject, error)
+	// Book due recurring occurrences now
+	// (POST /recurring-rules/run)
+	RunRecurringRules(ctx context.Context, request RunRecurringRulesRequestObject) (RunRecurringRulesResponseObject, error)
+	// Delete a recurring rule
+	// (DELETE /recurring-rules/{ruleId})
+	DeleteRecurringRule(ctx context.Context, request DeleteRecurringRuleRequestObject) (DeleteRecurringRuleResponseObject, error)
+	// Get a recurring rule
+	// (GET /recurring-rules/{ruleId})
+	GetRecurringRule(ctx context.Context, request GetRecurringRuleRequestObject) (GetRecurringRuleResponseObject, error)
+	// Update a recurring rule
+	// (PUT /recurring-rules/{ruleId})
+	UpdateRecurringRule(ctx context.Context, request UpdateRecurringRuleRequestObject) (UpdateRecurringRuleResponseObject, error)
+	// List tags
+	// (GET /tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Create a tag
+	// (POST /tags)
+	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Delete a tag
+	// (DELETE /tags/{tagId})
+	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Get a tag
+	// (GET /tags/{tagId})
+	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+	// Rename a tag
+	// (PUT /tags/{tagId})
+	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// List transactions
+	// (GET /transactions)
+	ListTransactions(ctx context.Context, request ListTransactionsRequestObject) (ListTransactionsResponseObject, error)
+	// Create a transaction
+	// (POST /transactions)
+	CreateTransaction(ctx context.Context, request CreateTransactionRequestObject) (CreateTransactionResponseObject, error)
+	// Find likely duplicate transactions
+	// (GET /transactions/duplicates)
+	FindDuplicateTransactions(ctx context.Context, request FindDuplicateTransactionsRequestObject) (FindDuplicateTransactionsResponseObject, error)
+	// Merge or dismiss a group of duplicates
+	// (POST /transactions/duplicates/resolve)
+	ResolveDuplicateTransactions(ctx context.Context, request ResolveDuplicateTransactionsRequestObject) (ResolveDuplicateTransactionsResponseObject, error)
+	// Export transactions as CSV, JSON Lines or OFX
+	// (GET /transactions/export)
+	ExportTransactions(ctx context.Context, request ExportTransactionsRequestObject) (ExportTransactionsResponseObject, error)
+	// Import transactions from a CSV bank statement
+	// (POST /transactions/import)
+	ImportTransactions(ctx context.Context, request ImportTransactionsRequestObject) (ImportTransactionsResponseObject, error)
+	// Import transactions from an ISO 20022 camt.053 or camt.052 file
+	// (POST /transactions/import/camt)
+	ImportTransactionsCamt(ctx context.Context, request ImportTransactionsCamtRequestObject) (ImportTransactionsCamtResponseObject, error)
+	// Import transactions from an OFX or QFX statement
+	// (POST /transactions/import/ofx)
+	ImportTransactionsOfx(ctx context.Context, request ImportTransactionsOfxRequestObject) (ImportTransactionsOfxResponseObject, error)
+	// Delete a transaction
+	// (DELETE /transactions/{transactionId})
+	DeleteTransaction(ctx context.Context, request DeleteTransactionRequestObject) (DeleteTransactionResponseObject, error)
+	// Get a transaction
+	// (GET /transactions/{transactionId})
+	GetTransaction(ctx context.Context, request GetTransactionRequestObject) (GetTransactionResponseObject, error)
+	// Update a transaction
+	// (PUT /transactions/{transactionId})
+	UpdateTransaction(ctx context.Context, request UpdateTransactionRequestObject) (UpdateTransactionResponseObject, error)
+	// Get the change history of a transaction
+	// (GET /transactions/{transactionId}/history)
+	GetTransactionHistory(ctx context.Context, request GetTransactionHistoryRequestObject) (GetTransactionHistoryResponseObject, error)
+	// Restore a transaction from the trash
+	// (POST /transactions/{transactionId}/restore)
+	RestoreTransaction(ctx context.Context, request RestoreTransactionRequestObject) (RestoreTransactionResponseObject, error)
+	// List transfers
+	// (GET /transfers)
+	ListTransfers(ctx context.Context, request ListTransfersRequestObject) (ListTransfersResponseObject, error)
+	// Move money between two accounts
+	// (POST /transfers)
+	CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error)
+	// Delete a transfer and both of its transactions
+	// (DELETE /transfers/{transferId})
+	DeleteTransfer(ctx context.Context, request DeleteTransferRequestObject) (DeleteTransferResponseObject, error)
+	// Get a transfer
+	// (GET /transfers/{transferId})
+	GetTransfer(ctx context.Context, request GetTransferRequestObject) (GetTransferResponseObject, error)
+	// Update a transfer and both of its transactions
+	// (PUT /transfers/{transferId})
+	UpdateTransfer(ctx context.Context, request UpdateTransferRequestObject) (UpdateTransferResponseObject, error)
+	// List the trash
+	// (GET /trash)
+	GetTrash(ctx context.Context, request GetTrashRequestObject) (GetTrashResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAccounts operation middleware
+func (sh *strictHandler) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	var request ListAccountsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAccounts(ctx, request.(ListAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAccounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAccountsResponseObject); ok {
+		if err := validResponse.VisitListAccountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAccount operation middleware
+func (sh *strictHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	var request CreateAccountRequestObject
+
+	var body CreateAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAccount(ctx, request.(CreateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAccountResponseObject); ok {
+		if err := validResponse.VisitCreateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAccount operation middleware
+func (sh *strictHandler) DeleteAccount(w http.ResponseWriter, r *http.Request, accountId int64) {
+	var request DeleteAccountRequestObject
+
+	request.AccountId = accountId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAccount(ctx, request.(DeleteAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAccountResponseObject); ok {
+		if err := validResponse.VisitDeleteAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAccount operation middleware
+func (sh *strictHandler) GetAccount(w http.ResponseWriter, r *http.Request, accountId int64) {
+	var request GetAccountRequestObject
+
+	request.AccountId = accountId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccount(ctx, request.(GetAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAccountResponseObject); ok {
+		if err := validResponse.VisitGetAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAccount operation middleware
+func (sh *strictHandler) UpdateAccount(w http.ResponseWriter, r *http.Request, accountId int64) {
+	var request UpdateAccountRequestObject
+
+	request.AccountId = accountId
+
+	var body UpdateAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAccount(ctx, request.(UpdateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAccountResponseObject); ok {
+		if err := validResponse.VisitUpdateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudgetVsActual operation middleware
+func (sh *strictHandler) GetBudgetVsActual(w http.ResponseWriter, r *http.Request, params GetBudgetVsActualParams) {
+	var request GetBudgetVsActualRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetVsActual(ctx, request.(GetBudgetVsActualRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetVsActual")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetVsActualResponseObject); ok {
+		if err := validResponse.VisitGetBudgetVsActualResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCashFlow operation middleware
+func (sh *strictHandler) GetCashFlow(w http.ResponseWriter, r *http.Request, params GetCashFlowParams) {
+	var request GetCashFlowRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCashFlow(ctx, request.(GetCashFlowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCashFlow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCashFlowResponseObject); ok {
+		if err := validResponse.VisitGetCashFlowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMonthlySavings operation middleware
+func (sh *strictHandler) GetMonthlySavings(w http.ResponseWriter, r *http.Request, params GetMonthlySavingsParams) {
+	var request GetMonthlySavingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMonthlySavings(ctx, request.(GetMonthlySavingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMonthlySavings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMonthlySavingsResponseObject); ok {
+		if err := validResponse.VisitGetMonthlySavingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTagTotals operation middleware
+func (sh *strictHandler) GetTagTotals(w http.ResponseWriter, r *http.Request, params GetTagTotalsParams) {
+	var request GetTagTotalsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTagTotals(ctx, request.(GetTagTotalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTagTotals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTagTotalsResponseObject); ok {
+		if err := validResponse.VisitGetTagTotalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTopPayees operation middleware
+func (sh *strictHandler) GetTopPayees(w http.ResponseWriter, r *http.Request, params GetTopPayeesParams) {
+	var request GetTopPayeesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTopPayees(ctx, request.(GetTopPayeesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTopPayees")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTopPayeesResponseObject); ok {
+		if err := validResponse.VisitGetTopPayeesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTransactionsSummary operation middleware
+func (sh *strictHandler) GetTransactionsSummary(w http.ResponseWriter, r *http.Request, params GetTransactionsSummaryParams) {
+	var request GetTransactionsSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactionsSummary(ctx, request.(GetTransactionsSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactionsSummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTransactionsSummaryResponseObject); ok {
+		if err := validResponse.VisitGetTransactionsSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListBudgets operation middleware
+func (sh *strictHandler) ListBudgets(w http.ResponseWriter, r *http.Request) {
+	var request ListBudgetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBudgets(ctx, request.(ListBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBudgets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBudgetsResponseObject); ok {
+		if err := validResponse.VisitListBudgetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateBudget operation middleware
+func (sh *strictHandler) CreateBudget(w http.ResponseWriter, r *http.Request) {
+	var request CreateBudgetRequestObject
+
+	var body CreateBudgetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBudget(ctx, request.(CreateBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateBudgetResponseObject); ok {
+		if err := validResponse.VisitCreateBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteBudget operation middleware
+func (sh *strictHandler) DeleteBudget(w http.ResponseWriter, r *http.Request, budgetId int64) {
+	var request DeleteBudgetRequestObject
+
+	request.BudgetId = budgetId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBudget(ctx, request.(DeleteBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBudgetResponseObject); ok {
+		if err := validResponse.VisitDeleteBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudget operation middleware
+func (sh *strictHandler) GetBudget(w http.ResponseWriter, r *http.Request, budgetId int64) {
+	var request GetBudgetRequestObject
+
+	request.BudgetId = budgetId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudget(ctx, request.(GetBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetResponseObject); ok {
+		if err := validResponse.VisitGetBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateBudget operation middleware
+func (sh *strictHandler) UpdateBudget(w http.ResponseWriter, r *http.Request, budgetId int64) {
+	var request UpdateBudgetRequestObject
+
+	request.BudgetId = budgetId
+
+	var body UpdateBudgetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateBudget(ctx, request.(UpdateBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateBudgetResponseObject); ok {
+		if err := validResponse.VisitUpdateBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCategories operation middleware
+func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams) {
+	var request ListCategoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategoryRequestObject
+
+	var body CreateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SuggestCategories operation middleware
+func (sh *strictHandler) SuggestCategories(w http.ResponseWriter, r *http.Request, params SuggestCategoriesParams) {
+	var request SuggestCategoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuggestCategories(ctx, request.(SuggestCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuggestCategories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuggestCategoriesResponseObject); ok {
+		if err := validResponse.VisitSuggestCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCategory operation middleware
+func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId int64, params DeleteCategoryParams) {
+	var request DeleteCategoryRequestObject
+
+	request.CategoryId = categoryId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
+		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCategory operation middleware
+func (sh *strictHandler) GetCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
+	var request GetCategoryRequestObject
+
+	request.CategoryId = categoryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategory(ctx, request.(GetCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
+		if err := validResponse.VisitGetCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCategory operation middleware
+func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId int64, params UpdateCategoryParams) {
+	var request UpdateCategoryRequestObject
+
+	request.CategoryId = categoryId
+	request.Params = params
+
+	var body UpdateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
+		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnarchiveCategory operation middleware
+func (sh *strictHandler) UnarchiveCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
+	var request UnarchiveCategoryRequestObject
+
+	request.CategoryId = categoryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnarchiveCategory(ctx, request.(UnarchiveCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnarchiveCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnarchiveCategoryResponseObject); ok {
+		if err := validResponse.VisitUnarchiveCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveCategory operation middleware
+func (sh *strictHandler) ArchiveCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
+	var request ArchiveCategoryRequestObject
+
+	request.CategoryId = categoryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveCategory(ctx, request.(ArchiveCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveCategoryResponseObject); ok {
+		if err := validResponse.VisitArchiveCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergeCategory operation middleware
+func (sh *strictHandler) MergeCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
+	var request MergeCategoryRequestObject
+
+	request.CategoryId = categoryId
+
+	var body MergeCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergeCategory(ctx, request.(MergeCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergeCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergeCategoryResponseObject); ok {
+		if err := validResponse.VisitMergeCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCategorizationRules operation middleware
+func (sh *strictHandler) ListCategorizationRules(w http.ResponseWriter, r *http.Request) {
+	var request ListCategorizationRulesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategorizationRules(ctx, request.(ListCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategorizationRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategorizationRulesResponseObject); ok {
+		if err := validResponse.VisitListCategorizationRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCategorizationRule operation middleware
+func (sh *strictHandler) CreateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategorizationRuleRequestObject
+
+	var body CreateCategorizationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategorizationRule(ctx, request.(CreateCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitCreateCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyCategorizationRules operation middleware
+func (sh *strictHandler) ApplyCategorizationRules(w http.ResponseWriter, r *http.Request, params ApplyCategorizationRulesParams) {
+	var request ApplyCategorizationRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyCategorizationRules(ctx, request.(ApplyCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyCategorizationRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyCategorizationRulesResponseObject); ok {
+		if err := validResponse.VisitApplyCategorizationRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCategorizationRule operation middleware
+func (sh *strictHandler) DeleteCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request DeleteCategorizationRuleRequestObject
+
+	request.RuleId = ruleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategorizationRule(ctx, request.(DeleteCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCategorizationRule operation middleware
+func (sh *strictHandler) GetCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request GetCategorizationRuleRequestObject
+
+	request.RuleId = ruleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategorizationRule(ctx, request.(GetCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitGetCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCategorizationRule operation middleware
+func (sh *strictHandler) UpdateCategorizationRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request UpdateCategorizationRuleRequestObject
+
+	request.RuleId = ruleId
+
+	var body UpdateCategorizationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategorizationRule(ctx, request.(UpdateCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEnvelopeMonth operation middleware
+func (sh *strictHandler) GetEnvelopeMonth(w http.ResponseWriter, r *http.Request, params GetEnvelopeMonthParams) {
+	var request GetEnvelopeMonthRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnvelopeMonth(ctx, request.(GetEnvelopeMonthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnvelopeMonth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEnvelopeMonthResponseObject); ok {
+		if err := validResponse.VisitGetEnvelopeMonthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AssignEnvelope operation middleware
+func (sh *strictHandler) AssignEnvelope(w http.ResponseWriter, r *http.Request) {
+	var request AssignEnvelopeRequestObject
+
+	var body AssignEnvelopeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AssignEnvelope(ctx, request.(AssignEnvelopeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssignEnvelope")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AssignEnvelopeResponseObject); ok {
+		if err := validResponse.VisitAssignEnvelopeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListExchangeRates operation middleware
+func (sh *strictHandler) ListExchangeRates(w http.ResponseWriter, r *http.Request, params ListExchangeRatesParams) {
+	var request ListExchangeRatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListExchangeRates(ctx, request.(ListExchangeRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListExchangeRates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListExchangeRatesResponseObject); ok {
+		if err := validResponse.VisitListExchangeRatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportExchangeRatesCsv operation middleware
+func (sh *strictHandler) ImportExchangeRatesCsv(w http.ResponseWriter, r *http.Request, params ImportExchangeRatesCsvParams) {
+	var request ImportExchangeRatesCsvRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportExchangeRatesCsv(ctx, request.(ImportExchangeRatesCsvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportExchangeRatesCsv")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportExchangeRatesCsvResponseObject); ok {
+		if err := validResponse.VisitImportExchangeRatesCsvResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportExchangeRatesXml operation middleware
+func (sh *strictHandler) ImportExchangeRatesXml(w http.ResponseWriter, r *http.Request, params ImportExchangeRatesXmlParams) {
+	var request ImportExchangeRatesXmlRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportExchangeRatesXml(ctx, request.(ImportExchangeRatesXmlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportExchangeRatesXml")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportExchangeRatesXmlResponseObject); ok {
+		if err := validResponse.VisitImportExchangeRatesXmlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPayees operation middleware
+func (sh *strictHandler) ListPayees(w http.ResponseWriter, r *http.Request) {
+	var request ListPayeesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPayees(ctx, request.(ListPayeesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPayees")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPayeesResponseObject); ok {
+		if err := validResponse.VisitListPayeesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePayee operation middleware
+func (sh *strictHandler) CreatePayee(w http.ResponseWriter, r *http.Request) {
+	var request CreatePayeeRequestObject
+
+	var body CreatePayeeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePayee(ctx, request.(CreatePayeeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePayee")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePayeeResponseObject); ok {
+		if err := validResponse.VisitCreatePayeeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyPayeePatterns operation middleware
+func (sh *strictHandler) ApplyPayeePatterns(w http.ResponseWriter, r *http.Request, params ApplyPayeePatternsParams) {
+	var request ApplyPayeePatternsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyPayeePatterns(ctx, request.(ApplyPayeePatternsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyPayeePatterns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyPayeePatternsResponseObject); ok {
+		if err := validResponse.VisitApplyPayeePatternsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePayee operation middleware
+func (sh *strictHandler) DeletePayee(w http.ResponseWriter, r *http.Request, payeeId int64) {
+	var request DeletePayeeRequestObject
+
+	request.PayeeId = payeeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePayee(ctx, request.(DeletePayeeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePayee")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePayeeResponseObject); ok {
+		if err := validResponse.VisitDeletePayeeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPayee operation middleware
+func (sh *strictHandler) GetPayee(w http.ResponseWriter, r *http.Request, payeeId int64) {
+	var request GetPayeeRequestObject
+
+	request.PayeeId = payeeId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPayee(ctx, request.(GetPayeeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPayee")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPayeeResponseObject); ok {
+		if err := validResponse.VisitGetPayeeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePayee operation middleware
+func (sh *strictHandler) UpdatePayee(w http.ResponseWriter, r *http.Request, payeeId int64) {
+	var request UpdatePayeeRequestObject
+
+	request.PayeeId = payeeId
+
+	var body UpdatePayeeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePayee(ctx, request.(UpdatePayeeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePayee")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePayeeResponseObject); ok {
+		if err := validResponse.VisitUpdatePayeeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePayee operation middleware
+func (sh *strictHandler) MergePayee(w http.ResponseWriter, r *http.Request, payeeId int64) {
+	var request MergePayeeRequestObject
+
+	request.PayeeId = payeeId
+
+	var body MergePayeeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePayee(ctx, request.(MergePayeeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePayee")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePayeeResponseObject); ok {
+		if err := validResponse.VisitMergePayeeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRecurringRules operation middleware
+func (sh *strictHandler) ListRecurringRules(w http.ResponseWriter, r *http.Request) {
+	var request ListRecurringRulesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRecurringRules(ctx, request.(ListRecurringRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRecurringRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRecurringRulesResponseObject); ok {
+		if err := validResponse.VisitListRecurringRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRecurringRule operation middleware
+func (sh *strictHandler) CreateRecurringRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateRecurringRuleRequestObject
+
+	var body CreateRecurringRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRecurringRule(ctx, request.(CreateRecurringRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRecurringRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRecurringRuleResponseObject); ok {
+		if err := validResponse.VisitCreateRecurringRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RunRecurringRules operation middleware
+func (sh *strictHandler) RunRecurringRules(w http.ResponseWriter, r *http.Request, params RunRecurringRulesParams) {
+	var request RunRecurringRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunRecurringRules(ctx, request.(RunRecurringRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunRecurringRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunRecurringRulesResponseObject); ok {
+		if err := validResponse.VisitRunRecurringRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTransaction200JSONResponse struct {
-	Body    Transaction
-	Headers GetTransaction200ResponseHeaders
+// DeleteRecurringRule operation middleware
+func (sh *strictHandler) DeleteRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request DeleteRecurringRuleRequestObject
+
+	request.RuleId = ruleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRecurringRule(ctx, request.(DeleteRecurringRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRecurringRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRecurringRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteRecurringRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetTransaction200JSONResponse) VisitGetTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// GetRecurringRule operation middleware
+func (sh *strictHandler) GetRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request GetRecurringRuleRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.RuleId = ruleId
 
-type GetTransaction404ResponseHeaders struct {
-	XRequestID string
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurringRule(ctx, request.(GetRecurringRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurringRule")
+	}
 
-type GetTransaction404JSONResponse struct {
-	Body    Error
-	Headers GetTransaction404ResponseHeaders
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecurringRuleResponseObject); ok {
+		if err := validResponse.VisitGetRecurringRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetTransaction404JSONResponse) VisitGetTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+// UpdateRecurringRule operation middleware
+func (sh *strictHandler) UpdateRecurringRule(w http.ResponseWriter, r *http.Request, ruleId int64) {
+	var request UpdateRecurringRuleRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.RuleId = ruleId
 
-type UpdateTransactionRequestObject struct {
-	TransactionId int64 `json:"transactionId"`
-	Body          *UpdateTransactionJSONRequestBody
-}
+	var body UpdateRecurringRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateTransactionResponseObject interface {
-	VisitUpdateTransactionResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRecurringRule(ctx, request.(UpdateRecurringRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRecurringRule")
+	}
 
-type UpdateTransaction200ResponseHeaders struct {
-	XRequestID string
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateTransaction200JSONResponse struct {
-	Body    Transaction
-	Headers UpdateTransaction200ResponseHeaders
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRecurringRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateRecurringRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateTransaction200JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(200)
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	var request ListTagsRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTags")
+	}
 
-type UpdateTransaction400ResponseHeaders struct {
-	XRequestID string
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateTransaction400JSONResponse struct {
-	Body    Error
-	Headers UpdateTransaction400ResponseHeaders
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateTransaction400JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(400)
+// CreateTag operation middleware
+func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateTagRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
+	var body CreateTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
+		if err := validResponse.VisitCreateTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateTransaction404ResponseHeaders struct {
-	XRequestID string
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	var request DeleteTagRequestObject
+
+	request.TagId = tagId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateTransaction404JSONResponse struct {
-	Body    Error
-	Headers UpdateTransaction404ResponseHeaders
+// GetTag operation middleware
+func (sh *strictHandler) GetTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	var request GetTagRequestObject
+
+	request.TagId = tagId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTag(ctx, request.(GetTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTagResponseObject); ok {
+		if err := validResponse.VisitGetTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateTransaction404JSONResponse) VisitUpdateTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Request-ID", fmt.Sprint(response.Headers.XRequestID))
-	w.WriteHeader(404)
+// UpdateTag operation middleware
+func (sh *strictHandler) UpdateTag(w http.ResponseWriter, r *http.Request, tagId int64) {
+	var request UpdateTagRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
+	request.TagId = tagId
+
+	var body UpdateTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTag(ctx, request.(UpdateTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateTagResponseObject); ok {
+		if err := validResponse.VisitUpdateTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get monthly net savings for a year
-	// (GET /analytics/monthly-savings)
-	GetMonthlySavings(ctx context.Context, request GetMonthlySavingsRequestObject) (GetMonthlySavingsResponseObject, error)
-	// Get monthly spending and income summary by category
-	// (GET /analytics/transactions-summary)
-	GetTransactionsSummary(ctx context.Context, request GetTransactionsSummaryRequestObject) (GetTransactionsSummaryResponseObject, error)
-	// List categories
-	// (GET /categories)
-	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
-	// Create a category
-	// (POST /categories)
-	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
-	// Delete a category
-	// (DELETE /categories/{categoryId})
-	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
-	// Get a category
-	// (GET /categories/{categoryId})
-	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
-	// Update a category
-	// (PUT /categories/{categoryId})
-	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
-	// List transactions
-	// (GET /transactions)
-	ListTransactions(ctx context.Context, request ListTransactionsRequestObject) (ListTransactionsResponseObject, error)
-	// Create a transaction
-	// (POST /transactions)
-	CreateTransaction(ctx context.Context, request CreateTransactionRequestObject) (CreateTransactionResponseObject, error)
-	// Delete a transaction
-	// (DELETE /transactions/{transactionId})
-	DeleteTransaction(ctx context.Context, request DeleteTransactionRequestObject) (DeleteTransactionResponseObject, error)
-	// Get a transaction
-	// (GET /transactions/{transactionId})
-	GetTransaction(ctx context.Context, request GetTransactionRequestObject) (GetTransactionResponseObject, error)
-	// Update a transaction
-	// (PUT /transactions/{transactionId})
-	UpdateTransaction(ctx context.Context, request UpdateTransactionRequestObject) (UpdateTransactionResponseObject, error)
+// ListTransactions operation middleware
+func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams) {
+	var request ListTransactionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTransactions(ctx, request.(ListTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTransactions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTransactionsResponseObject); ok {
+		if err := validResponse.VisitListTransactionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreateTransaction operation middleware
+func (sh *strictHandler) CreateTransaction(w http.ResponseWriter, r *http.Request) {
+	var request CreateTransactionRequestObject
+
+	var body CreateTransactionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTransaction(ctx, request.(CreateTransactionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTransaction")
+	}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTransactionResponseObject); ok {
+		if err := validResponse.VisitCreateTransactionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// FindDuplicateTransactions operation middleware
+func (sh *strictHandler) FindDuplicateTransactions(w http.ResponseWriter, r *http.Request, params FindDuplicateTransactionsParams) {
+	var request FindDuplicateTransactionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FindDuplicateTransactions(ctx, request.(FindDuplicateTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FindDuplicateTransactions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FindDuplicateTransactionsResponseObject); ok {
+		if err := validResponse.VisitFindDuplicateTransactionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// ResolveDuplicateTransactions operation middleware
+func (sh *strictHandler) ResolveDuplicateTransactions(w http.ResponseWriter, r *http.Request) {
+	var request ResolveDuplicateTransactionsRequestObject
+
+	var body ResolveDuplicateTransactionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveDuplicateTransactions(ctx, request.(ResolveDuplicateTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveDuplicateTransactions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveDuplicateTransactionsResponseObject); ok {
+		if err := validResponse.VisitResolveDuplicateTransactionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetMonthlySavings operation middleware
-func (sh *strictHandler) GetMonthlySavings(w http.ResponseWriter, r *http.Request, params GetMonthlySavingsParams) {
-	var request GetMonthlySavingsRequestObject
+// ExportTransactions operation middleware
+func (sh *strictHandler) ExportTransactions(w http.ResponseWriter, r *http.Request, params ExportTransactionsParams) {
+	var request ExportTransactionsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMonthlySavings(ctx, request.(GetMonthlySavingsRequestObject))
+		return sh.ssi.ExportTransactions(ctx, request.(ExportTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMonthlySavings")
+		handler = middleware(handler, "ExportTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMonthlySavingsResponseObject); ok {
-		if err := validResponse.VisitGetMonthlySavingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportTransactionsResponseObject); ok {
+		if err := validResponse.VisitExportTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1310,25 +8985,27 @@ func (sh *strictHandler) GetMonthlySavings(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetTransactionsSummary operation middleware
-func (sh *strictHandler) GetTransactionsSummary(w http.ResponseWriter, r *http.Request, params GetTransactionsSummaryParams) {
-	var request GetTransactionsSummaryRequestObject
+// ImportTransactions operation middleware
+func (sh *strictHandler) ImportTransactions(w http.ResponseWriter, r *http.Request, params ImportTransactionsParams) {
+	var request ImportTransactionsRequestObject
 
 	request.Params = params
 
+	request.Body = r.Body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTransactionsSummary(ctx, request.(GetTransactionsSummaryRequestObject))
+		return sh.ssi.ImportTransactions(ctx, request.(ImportTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTransactionsSummary")
+		handler = middleware(handler, "ImportTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTransactionsSummaryResponseObject); ok {
-		if err := validResponse.VisitGetTransactionsSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportTransactionsResponseObject); ok {
+		if err := validResponse.VisitImportTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1336,23 +9013,27 @@ func (sh *strictHandler) GetTransactionsSummary(w http.ResponseWriter, r *http.R
 	}
 }
 
-// ListCategories operation middleware
-func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request) {
-	var request ListCategoriesRequestObject
+// ImportTransactionsCamt operation middleware
+func (sh *strictHandler) ImportTransactionsCamt(w http.ResponseWriter, r *http.Request, params ImportTransactionsCamtParams) {
+	var request ImportTransactionsCamtRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+		return sh.ssi.ImportTransactionsCamt(ctx, request.(ImportTransactionsCamtRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCategories")
+		handler = middleware(handler, "ImportTransactionsCamt")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
-		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportTransactionsCamtResponseObject); ok {
+		if err := validResponse.VisitImportTransactionsCamtResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1360,30 +9041,27 @@ func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateCategory operation middleware
-func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
-	var request CreateCategoryRequestObject
+// ImportTransactionsOfx operation middleware
+func (sh *strictHandler) ImportTransactionsOfx(w http.ResponseWriter, r *http.Request, params ImportTransactionsOfxParams) {
+	var request ImportTransactionsOfxRequestObject
 
-	var body CreateCategoryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
+
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+		return sh.ssi.ImportTransactionsOfx(ctx, request.(ImportTransactionsOfxRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCategory")
+		handler = middleware(handler, "ImportTransactionsOfx")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
-		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportTransactionsOfxResponseObject); ok {
+		if err := validResponse.VisitImportTransactionsOfxResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1391,25 +9069,26 @@ func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteCategory operation middleware
-func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
-	var request DeleteCategoryRequestObject
+// DeleteTransaction operation middleware
+func (sh *strictHandler) DeleteTransaction(w http.ResponseWriter, r *http.Request, transactionId int64, params DeleteTransactionParams) {
+	var request DeleteTransactionRequestObject
 
-	request.CategoryId = categoryId
+	request.TransactionId = transactionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+		return sh.ssi.DeleteTransaction(ctx, request.(DeleteTransactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCategory")
+		handler = middleware(handler, "DeleteTransaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
-		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTransactionResponseObject); ok {
+		if err := validResponse.VisitDeleteTransactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1417,25 +9096,25 @@ func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetCategory operation middleware
-func (sh *strictHandler) GetCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
-	var request GetCategoryRequestObject
+// GetTransaction operation middleware
+func (sh *strictHandler) GetTransaction(w http.ResponseWriter, r *http.Request, transactionId int64) {
+	var request GetTransactionRequestObject
 
-	request.CategoryId = categoryId
+	request.TransactionId = transactionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCategory(ctx, request.(GetCategoryRequestObject))
+		return sh.ssi.GetTransaction(ctx, request.(GetTransactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCategory")
+		handler = middleware(handler, "GetTransaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
-		if err := validResponse.VisitGetCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransactionResponseObject); ok {
+		if err := validResponse.VisitGetTransactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1443,13 +9122,14 @@ func (sh *strictHandler) GetCategory(w http.ResponseWriter, r *http.Request, cat
 	}
 }
 
-// UpdateCategory operation middleware
-func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, categoryId int64) {
-	var request UpdateCategoryRequestObject
+// UpdateTransaction operation middleware
+func (sh *strictHandler) UpdateTransaction(w http.ResponseWriter, r *http.Request, transactionId int64, params UpdateTransactionParams) {
+	var request UpdateTransactionRequestObject
 
-	request.CategoryId = categoryId
+	request.TransactionId = transactionId
+	request.Params = params
 
-	var body UpdateCategoryJSONRequestBody
+	var body UpdateTransactionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1457,18 +9137,18 @@ func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+		return sh.ssi.UpdateTransaction(ctx, request.(UpdateTransactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCategory")
+		handler = middleware(handler, "UpdateTransaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
-		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTransactionResponseObject); ok {
+		if err := validResponse.VisitUpdateTransactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1476,25 +9156,25 @@ func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListTransactions operation middleware
-func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams) {
-	var request ListTransactionsRequestObject
+// GetTransactionHistory operation middleware
+func (sh *strictHandler) GetTransactionHistory(w http.ResponseWriter, r *http.Request, transactionId int64) {
+	var request GetTransactionHistoryRequestObject
 
-	request.Params = params
+	request.TransactionId = transactionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTransactions(ctx, request.(ListTransactionsRequestObject))
+		return sh.ssi.GetTransactionHistory(ctx, request.(GetTransactionHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTransactions")
+		handler = middleware(handler, "GetTransactionHistory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTransactionsResponseObject); ok {
-		if err := validResponse.VisitListTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransactionHistoryResponseObject); ok {
+		if err := validResponse.VisitGetTransactionHistoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1502,11 +9182,61 @@ func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateTransaction operation middleware
-func (sh *strictHandler) CreateTransaction(w http.ResponseWriter, r *http.Request) {
-	var request CreateTransactionRequestObject
+// RestoreTransaction operation middleware
+func (sh *strictHandler) RestoreTransaction(w http.ResponseWriter, r *http.Request, transactionId int64) {
+	var request RestoreTransactionRequestObject
 
-	var body CreateTransactionJSONRequestBody
+	request.TransactionId = transactionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreTransaction(ctx, request.(RestoreTransactionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreTransaction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreTransactionResponseObject); ok {
+		if err := validResponse.VisitRestoreTransactionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTransfers operation middleware
+func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request) {
+	var request ListTransfersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTransfers(ctx, request.(ListTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTransfersResponseObject); ok {
+		if err := validResponse.VisitListTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTransfer operation middleware
+func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+	var request CreateTransferRequestObject
+
+	var body CreateTransferJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1514,18 +9244,18 @@ func (sh *strictHandler) CreateTransaction(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTransaction(ctx, request.(CreateTransactionRequestObject))
+		return sh.ssi.CreateTransfer(ctx, request.(CreateTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTransaction")
+		handler = middleware(handler, "CreateTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTransactionResponseObject); ok {
-		if err := validResponse.VisitCreateTransactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
+		if err := validResponse.VisitCreateTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1533,25 +9263,25 @@ func (sh *strictHandler) CreateTransaction(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteTransaction operation middleware
-func (sh *strictHandler) DeleteTransaction(w http.ResponseWriter, r *http.Request, transactionId int64) {
-	var request DeleteTransactionRequestObject
+// DeleteTransfer operation middleware
+func (sh *strictHandler) DeleteTransfer(w http.ResponseWriter, r *http.Request, transferId int64) {
+	var request DeleteTransferRequestObject
 
-	request.TransactionId = transactionId
+	request.TransferId = transferId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTransaction(ctx, request.(DeleteTransactionRequestObject))
+		return sh.ssi.DeleteTransfer(ctx, request.(DeleteTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTransaction")
+		handler = middleware(handler, "DeleteTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTransactionResponseObject); ok {
-		if err := validResponse.VisitDeleteTransactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTransferResponseObject); ok {
+		if err := validResponse.VisitDeleteTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1559,25 +9289,25 @@ func (sh *strictHandler) DeleteTransaction(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetTransaction operation middleware
-func (sh *strictHandler) GetTransaction(w http.ResponseWriter, r *http.Request, transactionId int64) {
-	var request GetTransactionRequestObject
+// GetTransfer operation middleware
+func (sh *strictHandler) GetTransfer(w http.ResponseWriter, r *http.Request, transferId int64) {
+	var request GetTransferRequestObject
 
-	request.TransactionId = transactionId
+	request.TransferId = transferId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTransaction(ctx, request.(GetTransactionRequestObject))
+		return sh.ssi.GetTransfer(ctx, request.(GetTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTransaction")
+		handler = middleware(handler, "GetTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTransactionResponseObject); ok {
-		if err := validResponse.VisitGetTransactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransferResponseObject); ok {
+		if err := validResponse.VisitGetTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1585,13 +9315,13 @@ func (sh *strictHandler) GetTransaction(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateTransaction operation middleware
-func (sh *strictHandler) UpdateTransaction(w http.ResponseWriter, r *http.Request, transactionId int64) {
-	var request UpdateTransactionRequestObject
+// UpdateTransfer operation middleware
+func (sh *strictHandler) UpdateTransfer(w http.ResponseWriter, r *http.Request, transferId int64) {
+	var request UpdateTransferRequestObject
 
-	request.TransactionId = transactionId
+	request.TransferId = transferId
 
-	var body UpdateTransactionJSONRequestBody
+	var body UpdateTransferJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1599,18 +9329,42 @@ func (sh *strictHandler) UpdateTransaction(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTransaction(ctx, request.(UpdateTransactionRequestObject))
+		return sh.ssi.UpdateTransfer(ctx, request.(UpdateTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTransaction")
+		handler = middleware(handler, "UpdateTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTransactionResponseObject); ok {
-		if err := validResponse.VisitUpdateTransactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTransferResponseObject); ok {
+		if err := validResponse.VisitUpdateTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTrash operation middleware
+func (sh *strictHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	var request GetTrashRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTrash(ctx, request.(GetTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTrash")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTrashResponseObject); ok {
+		if err := validResponse.VisitGetTrashResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1621,28 +9375,189 @@ func (sh *strictHandler) UpdateTransaction(w http.ResponseWriter, r *http.Reques
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW0/jRhT+K6NpH7qSScxFffBbYdsKtbutllaqRBEa7JMwK3vGzByzjVD+ezXjSzy+",
-	"JAaCgyBvCRmfy3e+OTfzQEOZpFKAQE2DB6rDW0iY/XjGEOZSLcznVMkUFHKwv4QKGEJ0zdB8m0mVmE80",
-	"YggHyBOgHsVFCjSgGhUXc7r0KI+cs1zgjyerc1wgzEGZg4IlYI42JCw9quAu4woiGlwaccVRr27OVSVR",
-	"3nyFEI3A0o8ze6ztzTCF9tQ68b9zjW3hHCFxP3yvYEYD+t10Bfy0QH1aQb6sFDGl2KLtvpW2zpy/0+gl",
-	"vP1ZKanaUhPQms0HCC4Pdsn+JAXexosLds/FXLeVsHtQhZIBPEqMNBf6+lPHR51Puah7FCWyeKDGexZn",
-	"0K+x56mmxgUwNcjYBrL2ucrtyprSBa+Crwv6vxQTmoXIpejAPZGZwOuwzBEDnAoLFl73XHuRxTG7iYEG",
-	"qDLolPCEHBOBDhVPSy96lDwhJ+EKnuvyWjkmta3pylctMZ6L7cZUVgtTXzbbSbAeC/zz8dwE5Qb0tpGs",
-	"63fm6fm6JqUvZe9DOiSk+iJLEtbVrnARyrzoDQxnKeoCquhup5roFERkfH+WLduqEJU1XgnRQIi/yG/P",
-	"rc6bCNqB5ciVuAFe3eCnF9dmNNuttYyzRFxbsc9uJZT89qR0Vo/zs3qiBobWHq/hYymvjZx5nIuZtF0l",
-	"R5N86CeYs9MsmgOSn/48N5EApS2S1J8cTnxjn0xBsJTTgB5P/Mkx9WjKiss7ZYLFC+ShniZ5t3mgV+3m",
-	"HGxRMPFgBofziAb0V8BGY2rkKZYAgtI0uDQJhgb0LgO1KIeSoLxsK/fzvJmj3Xl7Ey54kiU0OOwA8sqI",
-	"0qkUOufJke/ndBEIwlrN0jTmobV7+lXn3FppWxf5hnsWdyf90z9+ox69BRZZjx/oPwdf4C4DjQfnH813",
-	"93TxG+ERCOQzDorMpCKoWMjFfELrKDRLgtF9skXf8nmlw6VTFhGVGzqeb6YElFXKEIsUJCQCkBREtPIY",
-	"sfwxD9QoW6uQ+kCvyl0fb7uq41sjb5ePewaPz+CymSBMRCRvJ0hxktwsSFhtNgyli29Fzeukr+nQz1bH",
-	"XpBBzgJnx9Rx4DUGkRpUS4+mUndglc+C1fIov7ig8VRGi63DVAyeS7e4mwSxbAXpcOvauwKUWxTtL/jW",
-	"GZgjS1jv9Z0+lL+cR8vclBjy4c+l6Ef7d4eiDlNO2n58lqTEd9TInrx8ZD9LJDOZiWhHcc2j4cTV6+0i",
-	"+oPmj3K9xy7d7yD+pnC7we9qCs3UtOoJVxd9cGfYPRNeeTTNOqiWr79GqmLFrm1QFXubNH+rBeydXOGc",
-	"wK3SXB8R1/bW9blp2FAY84QjXT8Fsv/yKfDI9zfMhF4Tm3MRxlkEpO4BkYKYaXiGoAjeck2M0xO7sGwb",
-	"OFMyKTfHHUb2LaEfYcgNzKSCzZZoZApfhykot2DHLzw2EajNcYRHfRrdTeljKsMavdV4+UP+ToD8m/n+",
-	"cUj8DwaMYt50fgPif+gFxWiu2wbC8PRy7Uq8H56zTGmpbBjsfU7ZnAt73foMsIzeQmAKzTx6lN5HB2ak",
-	"Bc5rmMDfzWxntwtOudiwX6i/73yZ5qz9envkLYPzSne/aNjBogGdCDQamulD7dughUOTs/udw+53Duj+",
-	"38SAlxd0nPKzXz6MuHxosGDz/sG5+i+6ghi10O1mEfHKWL/fRbyNXYRbvZfL/wMAAP//MLSzM14uAAA=",
+	"H4sIAAAAAAAC/+x9/XPbOLLgv4LSvapJqmjZyczbu5vU/eA4zo7fi5M527M7c/vmObDYkrAhAQ0A2tam",
+	"5n+/anyQIAlKlK0PJ9EviSWRQKPR3ehvfB6MRD4THLhWgx8/D9RoCjk1fx6PRqLgGv+cSTEDqRmYH25o",
+	"RvkIrkf+pRTUSLKZZoIPfhx8mAFnfELcY2SWFYrALcg50ZJyRUf4ILkR4hOkRHCip0ConSwhjJOccSFJ",
+	"wZlWRIzNzzdUARkVUgIfzYfkqhpH4RtCT0H63xkoQiWQkeC3IDWkhGoziKQa3IBMkpRqeEV4kWXkbgqc",
+	"UPs7UyRnSjE+Gf4XHySDsZA51YMfB4zrv/wwSAb4Br3JYPCjlgUkAz2fgf0ZJiAHfyaDkQSqIb2mBnfl",
+	"ADjhgWY5DMqXlJaMT/Adltae9ZO1B+c0B3y0NYKwaL9esjtnPfAbW3YbEvvN58G/SRgPfhz8j8OKkg4d",
+	"GR06GrrCR//8MxlI+KNgEtLBj//AJbvluLG61pAMmp8DDP9egiZu/gkjjZC5WU/MU236XR2FW8BGiIgF",
+	"a3rHVIQjmYa8/kcPMCqoB1RKOm9vkBlsATBXbs3AixxfGE1h9AlxmQwUvWV84vYqZfp6RCXu94iqaTBk",
+	"hXs35C+z9Mvcsy5IYuh7XaQTiGwjzXGqLs49F1xPszlRM+ApCtiM5aynxOzH0SOqYSLk/Lq3NNqgqItJ",
+	"ixDApI6upULBIr1LJmwX9TnjLEeeOVrHNjQwtQBJ3XhZh1yxIz1CrNgBukTA09mixoJ6ovhv6nikC5pF",
+	"FCrzO6QrCa8cF9x89vuX0Wcl5JQZ4bTKDFLcrbr5fo0X4q5NB8kAN0evBMMcqOy1yMaemPc8ktxSkiai",
+	"6wC10bR8L3GdHdvZX4g+aPtXl9UzkDjBdaEgbfPPJWKCUEUocQ/SCZTsYiB02vJYSELJv0AK932Nf1JR",
+	"oHLcqSfzIr/pJMo6SO9hQjW7BaufV3Cgji5uQZrN63m0rUp5DXKqtrR5CK1IUo1tiFHYCVXTt1mMsKi6",
+	"FuPWQRs7Y0eZUA/VilI678/2Htg3lsGbDD+WIu8FcCbuQOkHwdt41R8eS6fsaTC9tj97I1JpKjXyBS4N",
+	"j5dRVpjzZibFP8GZpDeg7wA4MRtGKE/N033tKtEL/GI2Ejn+3YL4ZwsJpKG9rcgd01PGnSXMJ5CQm7mx",
+	"ghGuXptdDhzY30uPe0MCZlWJI+BuOy9Oth3UEd95R78BfhbxGJJtm82MaF+JBlejIeCpl6wp7auc96br",
+	"md+lh4s7N3QNEe2Bm8tehOhLUchRzVicUa1BciMjURPDfZdFBlHr8MTKXPYvihg9ns2y+QWoIovorKMp",
+	"EvcqEiwc+sS8HRNlqZxfy4IHRuiNEBlQ3saeezIpYYljJjLvUqV3I5pBjVg/d53c1W7gNvUfPRBCD7Ro",
+	"GiO0LD8PT33ty5F+UWQxlFuLvwvWHv6/rZjRwaZdjwTXlPGI5DmhCg4YV8AVM7qUKm7sGKUEqp4fdi8v",
+	"Pq/n4da0FzApMioJ3M8kKMUEJ8/+Koiac03vn5Oc6tEUUkInCLV+EBy9kZvT++vFtuMZHuIKsVPMZiDJ",
+	"jSh46ZlWbMIR1Ly/f/phbuOc8d5w4sn3eDgJMoBVDNx7dkhFBM/mdpfqOgTjkWEe6iefSSYk0/MI+RSZ",
+	"c+JrySBFRYWqkTPs/WsJgsLxN5Y2Ud7HRDQCo4Shqdcv8Sa1BUnlWaqv5ZigeCIcIFWoAGRAlSaCmwBF",
+	"yvCpV4RmGVGgq68UyQul7RYMB8nuRdS3Lm72UuSLkCJjapTCo2QXEmV17WMdbtf2qI9wwbYHq9yxe7m2",
+	"l2t7ubaXa11CI+ZKkaMpuy0tmzrsf/duXT8+uaOK+FfK3AyWAWHG6Yt4voW6uzkwkZbS+m4SM2ZUQinT",
+	"Gp4681O5/pqDXYvZQQa3kJU/P4hzFiRd9FNx5yunUTypBRsoFy1vjUfw/PEH7/wcZMwPpKnEAMQjQ8OR",
+	"UZbC0uVns4EPdZ2L21g86UUVtSnZe0pTQn3QiJxpcgMjkYMyT1nYvlPu94QIaXg+TSElWqAIKEe0z5Ip",
+	"RVkMHSK2zaLAbyETM7imCs+DHHg3/CaebE4G/xYJ3iLmLQSrAmdIjomJN4Yg0kwCTe3Sc8Fh7kaB1FA9",
+	"YtA+fifcUotZ7/XUHaedS7nwjxmNTREu7kz2HX7DeH0NPSdeNF1dhQzmtCvHWVeeUM0ypq8zxsNp+7xX",
+	"3DjqYyu+acFbifEDVWCFqaIsOogOF8NDfI31LeqilKTBxAs5ZJGguCwmE1Def9zwx68eqJbiht6wLOoa",
+	"OhcpZCR4pAy2HZlA24t4NLoRfV6YMhPO32/V6zxDqlHXcJqsnE73ZZzdb4pZxnCmv0pRzNrLC3mnvZCr",
+	"iM4+wYESIrIUlCZjJpXuHRZdJRpag2z50uKEZYDtT1kNbC0D0Y2+ELgLUCK7hQv4o4AYhA4bQbgvN3pN",
+	"MkiZwvzqaJTvE8AsSnoBhvHMxedeEQ8zfmNG7xtfr0WT6mjs8XrO+Jl9/OUSRDoktGfsh9q42pVCZtJN",
+	"HgB5K6JptwLS6xllUgXyIHjpE8z0SgzQDIYG8LanjGHi1J1Bcaq6ZXre5QG49Ll/jqmNNpbYpCbuk4ms",
+	"Kd+TVryytlIAlt5SZsTcimFbKRmk15jZ1LXAdzDWJvWJPBOyyoFKrGbsl/g8kmcwk3DLRKEsTjaUD7zw",
+	"VI2sr4XfpLnFbWQuophjM9qqmaPH5leUIhaaXt6hVxVBaUEKbl/dWJ51d7JnTu9tnuqLl0HS6ouVcikX",
+	"vbZwTxtZlksTYf1GnfvlNPbpcdymxbXL0+v2EIociBJkTCVusS9M0lOUGn5690CwxYa9kHAP/DPD1SzO",
+	"/od1Kf0iApsZ+DeWJbymXNsalDEW79ywEF9R8pFSyDbZ5KAUncT02Qag/sHo2Pc2Weciqi97tje/eG/9",
+	"4L//cXzw/37//P2f/xbzHMpo2OQXL1L8kGQG0oRNoJCil/Fih+6b6NhkYL+ScBT791K8nOUzIXVn4lVZ",
+	"flcj9hZeVkissgmk1/EA1CmVGQOlbd0ePuPP/THL2l7iXjklZoExp8Z7g3vcN5xNEaWFhNQ4qfSUanIn",
+	"iiwlNwYESlI5J7Iwx0Esv7NjQe+oXudyOrPSylUm4Z4t2/x12LjheI+wbpfQoXVr9xR6i6mPsqz3SCuV",
+	"S7gliDu3ilixxCc2m/WcvXOvPTKq4cpVOYgXIFjcdeE4Yxx6oiWQzkv5T2mqC1UrKeyGP2ZB9sg1XNEH",
+	"YVZaQhZDlqs8unQVj22t5hakQ0DfA7vbtOtAcpN2tNA0a77bMeMtzQp4tDG5DuVBDUpo/BKSEn0x1P9M",
+	"5wCdEmDrcT6jF/TJHpCtuL4ygf2L05fdwf3ABxIMrxIX+WWcCJmCHBKDlc6gMEtfuVNF+kQMcse4slGH",
+	"vsf2grBiiYelEUYD6FqTue2Ipe96G3nczSkjHpvVsqtnOOBO06tLCDrXuywm3NJ5/yiAsAkXuEgyogpe",
+	"EVVIifkU+I2a0VFFsnluDayc3r8DPkED5sXL/2Xs1PJzspgBS2pZ8s5Cqu70PxscrEMhMgM9QhMy7y8O",
+	"Ga9ETvF48XJ6WBgp9vuygfhbhb8tBN8a6+hExrKgyyPIuhnYnWWGb4yHD6f+ThH/+CsicqY1pCQHyjEC",
+	"y+uVXpvhjFiJ2PpLLDZQFrN80t4VWCvX09Sisw8HUJVFVn1KNl1JVlftV73Exg0d2/IyveAtDuO9JF6B",
+	"TynL5oNkcAfwKZt7Tc/8NQcqs3lUjy/H3FCJzrJMwqX+X3S5s9GUiJH7xp1erqsRW5creDPdhlYlUOBp",
+	"p89CaTITSrGbDAJsBNFhLgjw9EH+GOOcjco9oNr1lHqPNZQqIUhgKrHxDYV+GSQv1SvdMRmMQ9JdxDwR",
+	"Yl/FeMio0tfg3ZjNdMi5oTN8Br1HZGR8SlxoQ1XmN5QPCYHhZEgoL1MlmzF5wUcmZRJfU9aAsI4qOwBh",
+	"ihTmjEqt0r90HyqwF5HZ0mE43OsOOnoP9zogH+tUw/wus3yAsl/YvNlvoEMGmzrtjsneGtOnmm1IfPcQ",
+	"ylNixZLLW8JQM0EhkNJ5QkYZzWc24qymQmqQjt56gBSzmCqy87ReAzxgvBB5LeEciMNmGVO48JqsqNFi",
+	"c4cXivl6sdNeMHeqC1+ekHVZ5i+SNQvcxXHJRwrfp87pIZPXmHtpuLTGcuswN2sDPsLsrI3T2a/pq5EF",
+	"FF1U176XSSN7IFPCnab1YhCf+esmQyYN4RDmSKZjpCw9ZarsxLEy/+4l1V5SPSlJZQVClzdIOotuNTkV",
+	"+mOc7voQB4+ZvGO4JevibynLCgmr5B+s1iojAqtV4hblLYQQdqF8bCFXXYX2RtkOrA0vIU2gYG5EpgQb",
+	"SnDldKiM+gj3qidPiciIc95mpzv3fJ+4V7iRqwR+I7hWztkfHTOpkBjbhSs6eRphqIeXe13Ryda9+n/5",
+	"YYn3s6/b8YpO1qEf4TY+XCu6opMrH3dtQNFI3NpUS+bO2KTvitmZOVvkOG8jSVa53NmZcHFLm4HUr3y2",
+	"bxGTppOHBbvK7uSre/kn1yFjNJDTymBrT7po99dEh2asxxHjCnGI9TGimHUE5RfEzVeKdz5lUl4HdZbo",
+	"6KbPngTptmItBOm39REEudWAUDTFu73XVTp3ym5ZCpgq8eLov/3X13BvMWEsF1NSQP/p3n/SDv4wX7Vx",
+	"2Fx+ID+8fPE/yUikpr9riLnhIOmd3trC0aKkyRRGLKdZyV/+5e9UsCPOsf3SIPv0l4uEHJk//+Pn34wR",
+	"9L359PqnN5Yh12DH3uNKaRatOXpN+SfCUuCajZldBeXE507W0nGeGbg/vP2VvD27OnvzfL39yxbKx+Xk",
+	"Ew0uNkQmhLXVwcKwH4PzX2AOEZG1SuIHdvJQgHGLa0n5p1hoJ4Nb0yvTUYpLUELoMqY0zm06cnz84+Mr",
+	"MmWTKZgy8RvQGnOfjm8Usqu5EOSOqWZteHdKtamsXUEgVli6xDejSXh0EutqTHMo+SBA9neK4AsJUZbA",
+	"anr1cIXUrPr50DtobV4ag1yNQpipwCcZmEY4lPhRhsRoHwg/Nb+i00JZrwWTtk+uYeqcSSmkdX8lvs8K",
+	"/hAA4K1Os6s42itiysqq0c1HlwhxNxUZVIA8rP9KzI5qobV9BUGVXN8WjyWNOcpYbpFV83W2By3P0/p+",
+	"WXyYLgWqtWe+4l9SNTWonhVyAkSCfZzZ024ihAsP1nNxTWtdt3w7zQBxZRLiB8nAjBWN6RtfpwE6tb2g",
+	"aPZzsBi7Dw2yw0HrslZiIwHnNQViE/MSnymvaA4IfI7K3g2MhYRambIBbmHMs8K+fX0t8NqhagAbGYZf",
+	"OPUUVxASU2JYxRAMMeX+hLqPymwZ/q/phHAjTaj5oGprtbs1JKdcSwYKZbeQKaQeGjcqT82r5A4kEFqk",
+	"DGXPlKKGDAz5rSe67MI25OyQts44KppcDbJ1YuU0DfEcIMR+oewTN3NyQ0efJsZTQf4pblSvQHhMKJRF",
+	"vgECljHzboKnS5Vgp3+Z1ysdbA68poWNWaZKRWxrOvAD9VnyxkYKlBd6La9KX3X3MVm87bMUY0lV1l6Z",
+	"s+cKDW3e3kPVq1KRaV7LZmVY1Y7HSoC7qVCVGDLd+WiKbWgQZXVkHnOazTUbKUK1luym0NBxuCgvXxhX",
+	"GqgpQw6ooJFrvmFFC+6d4mj1K59Yv10Fa0HOdd8gS4CKnxiet/NHm/UtDWMt9v1aPA4rNNXoBZSlni+h",
+	"wfrj7nMKlryjyPw3edD8wp0C0JLh2z9h3pskQOmBIBnQmB2AOqgoNOrFONT+vNnmeUNqyfttP8CrGh1V",
+	"gdgMxpoUnthWK9p6AgeXuizynEZPLhN0WWF7/FCXUJ4S6yme9P72x8GyroLIEhofl+qL4ujdZKsVoz6g",
+	"d1vYP+wB0mTbhasLz9qValEXUENrF0YiK3J+bYZ9dOXtSnXnHXTyqBLiBg7dXXv1NfrxOjE3BvnAxj3d",
+	"ekZfreEhARZ7q+0Dii8frGMYpUiMyY3QU3R67jhaM+zQdG8eipZV9R/TGmSJKtuepfeDWqw+eOlDf9jZ",
+	"6moC6stqQtKcZVUndHSHugi6n496DPJh9+o+jIMX52iujbsW+44wJmFxY96sfOoGHd+p2oPWYW6VtU2Z",
+	"Aw9jhx1Q+SMJfBEVrs3xMO6hKyz0OqhpZydcBisBo6aQdvcXT1q9TlcZ98GdS5NwMZ0oCADvQsZ8lQ7L",
+	"vpfkKge1Cfn0vH6AKTIDmVOEIJu7oGLaed9AP7fNoAZ3ANACtC3MldkQFhpR3UcgokaTj+kdGg7TH4s4",
+	"CuNjc4uqZhpF5uAcJtTe3EyOfz5D1R6ksgg4Gr4YHvn7YOmMDX4cfD88Gn5vRbW1Jg+deDIfXFsC3BLT",
+	"4fwsxcIHpvSxfwhXoWaCK7thL4+OrNLPtVO86Mw2e2WCH/5TWSRZhCxDl5sDp7MrbThd/nOQDKZAU7D9",
+	"XH89cOGxg7M33aGzIMvF5DlJOmJ8gttdAdUkdjO58sa8WT/xWCqjm0wSd0WoMlQoVAR1VnVwCxuUwb7X",
+	"Ip2vG212Kou4itDwQP2ztWcv1j15bL9OyvTyLW1aMvhhjdRoGzJG1vWapsTt444I8sTrZp4qjXQpGfnw",
+	"s/vrLP2zEqpLeoU7c8i9aSPOVJZ9+lxChQSbZ5BaNrCWUp3k35jZQpKvkd4PEeNMEL9jW6WVHzZPK+8F",
+	"JpwUfMtc8L83v7ITwccZG+2KBSyVBSxQevxrhYpCNvL6jKyOnnJ/Bd1JtEfbkJcf/nO7RPJ1kn+NSv4K",
+	"ukkiJrvIHdzOqUtz0Aayf3weMIQAdSOfov7joBSmg+bBGkKy3JP4O2p2EcKzkcyt6Ah2qn46wldJ81+r",
+	"evCN8LOl37bi42Oph7bl9sGtOrD3+wc2Tcd1Ciaz174WNJshDC9cTMFm9dZuIRoS70Wx1X9cmPpSjq+b",
+	"PEY78JCENzbY7N6qIzFaoSPBb8GmQ/OoF87ddCBxye2capNV/IpQkjOlcBrzHFOEkh+Ojqw/rnXCWTvx",
+	"b+rYYicu/v4obLcWJ/9c0K6f6OvRcz8+i++wvso0fa8H+DNpbv8HnpV73NAYxrZlgSOw4SCJglvz6a14",
+	"CmxM0DZ2dy9vt2SOiXxGpbuSJZs7aeJvVzV7UYqFpsAaUTU9GGfirlNSnYj8xqYz25Fq1GqmmPm+hET4",
+	"tGcJupBcOaFilJ3GxSlAR1NssjAkrq2hGc90RnA56FRdm7qglJibJUxaZ0OZNr+aH2YgmUjZqEr3fGYS",
+	"1oO1JKUehm8plrOMyrIqQhPbRS8hN8z/5REqJPmjoFKDNFyrQd7STD23R5G3UGdUaZR8uCjLxgGihuS1",
+	"89WERm3j1pPmfbctoY2vVlK7LZ6Z9KUePF1RMPvGhf1EMqK8n6zsilpEm5ukdO4PGgWSgXqFi8yF0uT7",
+	"v/zFItbSBgLQJRq12Bxon7i442RqM0QbUSyB1NwlrpGWB48C4+s8Mkq62x8W2zksyiayxHQPrUzR+rng",
+	"RN+BqjrvR0+HY5drWBdNHQrls9fHl6fXJ79cXJy+P/mtukrL3VxRijJzNtTT9kyMlxyvJNMalwfsSNn8",
+	"Fri4gek9L2/PveR1FA6aOGZ1RWqGlBtsrekCXr4scn9d9GRSL7WzDFjqOtIW2unSEIX7EcwwjEmOw9eI",
+	"i1lpcUdlqixji7GvsDMFucRmseHXKRuPQQLX5keS45l7CzKjsyE5NV3HNJ0g12dM2cmesPTxfUYigqd1",
+	"c1pEGnS1sOtSx3w+xyM0jAWAlFWWSyDRYnU4NimYao1j9mJpO2KpdDyZYlp7L+AM6Zi2zE+829gUSPSQ",
+	"SipwmTV8UTHxZKY0Y8cEVdIyUy0YNvCIU2l3zWl5ZbG/+8XYvFxU8NRmLesIRKGfuIByfWf2AmpVOM6t",
+	"48/1OcIt8cQjHEl1TZ+xnOna5FW7zaPQo3h0tESz3KjUDLsb7aXmlgwzS0LYusW2hOly2YXUf6Cqkp8v",
+	"zj6LlS5940ZaC5QLkZVVfgyUK+UzMGRwC5k/DKcMJJWj6Zw8e0H+D8Fz1f3Os/nzITnFLcN+GXoKvIou",
+	"hV0y0NmaZbaNDJgrzajt7YbeUvAVgUZXR2pCyoPU3phdTYcFKkPyd5cLwXy34DKuJWEmJE5mUIx/3Pnm",
+	"HP6WtAg+U5jpaScql+/wRoVlhIr3MnP7BrCKaJ3uSXJTUaCVpi5EsjDl9LV7ZuMBs6eZcOpRFOSWNtL3",
+	"wvRuU2vsGhyXV6cIWUvfa+fq2URCi4UNZZ7YwXeTnOoWts9N3UFuaiMa6hs3xaTA4Wf7RytdNZZYGhDr",
+	"Pq90Z4k4Pv3S7e6ipMquDTvaApvvUyo3k1IZbPvy/EnP3BtNn9zCGbab5MmnQ9z73MmvIney5F08getl",
+	"m52q+En1WE9fZfP6Pkyc7DAunTF87V+Ju+fGNFOQtK+13mxyhNVWnqaBEOzdkvqzk6pccxPi0Q+/GyX/",
+	"pFIp92r+9tX8ukZf0eShKiYTUGXpdtQ7ekH5JxW612zKJOGU3QJ5TeegSC5SyEgGVHJIq+aS/qV/NQLl",
+	"CSmMAxSfuRMY9HYeumBm29UAQ1rsX2VqtXWCYe8qG5Pigphyc9urigtePmmHnZo2vMCJwn9s/CPmaL20",
+	"eFgkQuMut/Byy27FKRIsiQ3X6KPxGEUs+bxqaOXfkwXJ2y93GWnxwuOyJNYnJ+sdaCGXWFtaN6rLQ+b7",
+	"7P6eLykBPS+baPsXah208ZJBkOa+XaZ85WfVoVoC7gAylE3/Jc+uLo4vf7q+OL06fX919uH98yE5aTB3",
+	"rZYCPVRhH2hRaMVSCAEQ0r7XzDy2cWLNssx2dA7vH9TCdPZPyIhy5xPzZf4RBrW2bHBGLlRwTq/oxEoh",
+	"yglQmeHeSqDpkFwZlNiNH1OWuRX/8OJl1V++RDOKD99VUTE+gsD7bsmsYq2z8cE51aPpQrL5fe8S2Zfa",
+	"rm9hL15ufmE/SxgJbhvQG46BnfuzRkFLli6PVk2d3vDB1OcoOnU39DWoxohDTVw3kLLfmRs4MbjykmUx",
+	"spK9fFiX4yykr+Wus+oU36jz7Os4+zZn2O7G8/eNiYC9e/ELVH++LS2hdKF2Oj1Cu+vQOTQXxTR/4e6h",
+	"p6ZT7Dni8dTi93a3x340d+S47Z43SWVTlqbAW162uan4cCnbWL5GONw13G43hba3t9tCWFejae5+8qmT",
+	"6FyrW/HWgB+zDL8qeAqyPi2yuzfn3fQzIU1im81sYZJkVOlrQCogBdcsq/rJ451fpn0p5a6W140bcwMc",
+	"7xnxK2XE4wgbLpLbOUh3H90T4FTrobNZnAHDJYHfLAmcavOk8oybTTSMljTcZ4nPykGWAn4LmZgBoUqx",
+	"Cc/NHZN1TbFKWNZU4nvlD/Y2Q3tBIdPmsjrBgaRUU5PbHICM6cluWiF9gtB3KpyWKbwvA1JSm+07m6Vq",
+	"7AimjfUgeNTXfo47t6Vol5lrVzaBmfwCFLrX96kBewH5OAFpqInQBrv7Q1NwqMtLK1gOzAneHdrDX80p",
+	"bCtyquowId0xbw9pLZltv9uddmAnNCNu4VgOpnvagf9AwPdNAQjWtlnxGEy007SAcMH7BIEdJgiE1Not",
+	"Tg4RF4YY49rQReGqRc3TtvilpRsFl3+VEq0eaIyXzv/d3L2dyvm1LPjHpKyVqe5cVeXQd5Jpl2aQR60J",
+	"XEdcfi10sf4s4ZbBXXRO217ATtlVqGOBf3IpVA4FBitPQ22pE6uHEoILzgLrdgHBfsb/emWud4jgfciW",
+	"P5WoX11GLQ0ALtzJoy2fZHu/wWYjdk3aWO4bsIJhG4G7XWh1O42JPTFe2Ju/X0dmfKeq6l1k3eauqe/2",
+	"2uZ3qnKqjag03nWmlelLYlTWZ0Ia3dX0OXnue2CWTjYO966udkiObykzl2MZN7h1nzFlK8Vdka0gYypJ",
+	"znjhHIV6aupwjV8NUvdAvRlKvAMmrV8/iyl2VQpd1b4AEZ3R0SdTss4b/QmWNB04dYg5d/1+v40GxJtU",
+	"sesY3YvD7SkHtp2uY/RanWvu9iKUHYeWIbuNW+vqzwWHucuyifG+FxIxYWMWM4VSdhAOE6pN7MOKmNxM",
+	"wTS5oaNPUbvVwOhJakPahB/eTrZtHWLPLzsJwpm9dtStReib8eTr+MWdJgeS6kX+ZfzVHGV/FAIPJTqh",
+	"jCvHlIUUP5q/qhZR5f2b4a2vpkmZ4PaNhFBMcr/JGN5Th00r8NHTk9dDcinMwXczL99MiOnkUt192XZf",
+	"n7qVGFD7HXXB/aYVZvvdqtndrssgcqcNw0IIvtRWhuFu7htzbTvmUdMxVUxSHLJ8JqQ+HKnb7iP2eDSC",
+	"mXPpnp68JhLGgAznSZRxcnL5N/IMxcH4XsJ4OFK3CSk/HmBaC373/EdCyRuqgdi7wMlYZJm4szJC8PLr",
+	"WdVBfj4kp/lM25j5+8NjMoLM93L6xGYz273V6NA0k0DTOVFaSKdvI8Smo34punCY1GncEmYZHcXrW84M",
+	"XmrC6ETdLnNE/41mzIyOE49ZBpUbWgvpK+wM1tbvjO5SOTTclxu8gH62qk0EeLWY3ofltyoeLNIbAqLM",
+	"UkcmR45GCl4kNe7zrL/UQHGA8AJqNNXEbVny6/m7QJYcmI7vw/s8a0mU+zx7vjXu/zXPvkjuD8nX7dde",
+	"COyFQD8hgKxYCYFWz+O29l725t0Y1TyZHq9tdcvhZ0lSiVnAhnwEZuzdpI7YZe2zRXaRLTJzuC95tHdG",
+	"iHm8un5qQWoIoa1mEFx0tyrfQGKIobCfHajfWkqIWfxTzQR5x/ineA4I0Y5GVI08P5v/l7RZONOqPhaV",
+	"QD7BTAepSmacruu0K0m7TxfZfbqIE1LdCSId23W0+SNqnwayoTSQcs+XJ344ibC2zI/mynCa8MxzdVFo",
+	"Ato4sz8E29LEhro3rrftJjnkyTDFPh/k68gHaSuj5Wm/SpHY+uTBKhViRiw4SeDDXlZe1Eu5KhnSu47r",
+	"auprK0t1u6zkCNpDhdVbndVaG5dFO6nTqmbeO4L2UmmtRVqWX+MVWmWVZ6s4q+3fKkuxN15XVZvpafq7",
+	"Gt3lljm+aivakPCqzbEbR1h9mXuH2C4cYnXKjLL5oSx4DwcZzp0WGUjC8OYgRjVkc+/c0lMpisn0Y1Jr",
+	"pOhvO6LaxprwlMdWDJBiZ0hmUl6x9ykplC3angAHE8MqZiORt1woFFFplBGWR9NmLgrekksLnWOvsTFE",
+	"DGSXXUKemfbSit3C8xXuC3foeDLJJwFS+BP0mpldSAsIqDXcEy7u4oQbL55qXHNTIyAXGrVE6FO0cJTS",
+	"pdblQmvL7L0rbfeutKZ46/apLdm/o+2densf24Z8bG1i2HqVVUO/ceEWE4PCQyOUauZYNL2HbYEEcC+W",
+	"5qCH5BI0+WhevMY49Ed8nWZKkMK6F/QCuYZoDKeq52660zh2flrXxdbV0934+yIg7K3tvUBarw8wpn83",
+	"bvFv29ZX+MBmr1V/mna0wcwS4xlblW5GJl3RyW4M5Ss62ZvHOzGPyzvtkfIOP2s6WWJOXEBe3nmgfTvj",
+	"lvO8y4jwtLs3HXZvOpit77YXolt1tGmW39sGG7IN3G4vNwiMCNho14WNHmC7UaWfCDXvleYvmlNtakrt",
+	"VA6M3MUac/hgr1LJ9n1Ti+6YOlreFCBeulgz03dZQxkBpG8ppdJU6qcBihZrgOMty3AHbuaEjka2dUba",
+	"NaF74pqlg5WvOeuateqT2Tmtf2St87rQb+ek5ve1zlhe6v/MNRD4r+Lo6PsROXpuL5c3DUhqvwE5et65",
+	"+fNZfeeBIz/+Y+CnMe/hmIPfVyIDVORxjoSwCbf1SSOqAPuNz4Bql/viRAo64sbVClH3pxkOoV4RBcYq",
+	"uM5F6vxrcD/LRApei4gui05qqzLXBkbEZbkkKiU1reCVnmf4Be7RoL1Acy9Hndts/jqfk2cuh9tsBHaD",
+	"cSk+uI5O/LuVxXPBB5Tjs35P7CeaZf32osiyAyzPJAqoHE1t/n3wjBqSU2Nl4e2JJC+UJjkuzxTdUzKT",
+	"MGb3Jg0Jd+6AcQVcMexdgUFK69dTZYN57D+PB9qYSaVdR1nzbiGVkK8I0NHUXN9Y67/z0cJ2LSn/9DG4",
+	"c6aBpj9q+Mnp/TvgEz2tjhH/+UUPxJwYiGwsEs/VGZ0wTr2NGZveHC9rkJJuZpauNO/K0mOTYc9AK9iX",
+	"3G/dl1drgLnEp1c9uynTqJphRz6+2nWbe1/fDnx9zQtPgy/UYVrYtS/oGfNXKYqZihyoZXm11WQSI64V",
+	"oZrkQmnyMaVz9ZHQGZX2ygTFcpbR5vl25UrHSAYTVU+kEeNqjuZVDE1tOmVjU0iuvXarzJwpUzlTyiTd",
+	"MmkzbjkqL2SCy4pXf79lPH3jEbPYxqqj6h2V5tbZqn8O4oDcgL4D4ETfCVIhvLP0jM5VXNf4PjDOvn8R",
+	"2GZHffTTDzybo39VMTz3d26hLQXnS215U1KOYZz9CbxFoYeMSzL2CbJ5xWiRntQdIvBQghKZvXssnhb4",
+	"0RQwfDQatSIf8b9rln5MyEjMmIuPOPmTBDcGNgtn4V6D5DRDNZNp0xVSVddH2fTk+j1RNE2Vu7kJbZVa",
+	"5YGpYyUfnaj7SCTkgPLHJVu4a6HrZZRc6EAUESWqqyXwJyccbXuwaMqhxVSXlNyELlPO5eZ2dLNtp28b",
+	"jH3yxFfhBw5ImPAd+4Rt2QJawJapCbUsaVSKSmVrCzO4nwmpO3W5Sy2B5rG6J+NW8C1qrKMHtbEsxRUZ",
+	"h4GtYLInK2GKaPopvPTuo/3lY+UySsiYZuaOOuyb6QuobFsgYrFq5JhpCXhy+bchOebkw9tfidJUg7na",
+	"amrcHIrxSQa1JoK0vG3LrhjSmox7Ragdyv5qng1+JoyXLiw3qvF2LGjCe2oGWkUZ/FDoWaEdwl4Z145k",
+	"Kag2Fjo1LfNq1Pc3Mr3IeGpoPxmI8X2Hwyl6PbCdffHVtV+za3/vT/9KvNur6fH3BzxtHxatjW68g7y1",
+	"5IXeHfb2CsLGj04rqBsar8LzJSH/cfnhPXnHOCgk1Q9vf42coLa1XWgCxFrDrXIQdLeFG0mgrZYpa21J",
+	"0+Ljn+y56zv62n6bU5Gl/uwPlYJFMhR/u7bvx0HqLUiXguQ68luR0iliza+LIbLPrAWm4PFODFWPLEFU",
+	"MPVjYaO8asRTnhAI0NIzIgLiUlDe0TnSsQMlrTq7LqSaiF5TIeO333777eD8/ODNmyCsVvvyzZvD8/ND",
+	"/GqQDM7PD9+88R/evBmenw/LD/iG+dAvLgqZsalZznSFXuTX7g12D3csZSTynAar8J8V5GwkMrvb9Abl",
+	"B5v1i96+gRHLaUYUoMjRQtoiQncxhTt0l2yBHeK6HKKLKoUOYLef7Aq6DuIvqAHtvt/k7vpN1o5m223S",
+	"dJu9ofxTZft1Hs2HI5rrbhfda1sM9F7LOYHMDIUeeKOV1k3EFG6YJsB1eZG7v/hhSE7dt3dTocBCVnWq",
+	"vaNV7ZGFqdlw1lsEQZtqG7KXQLz67CcWY8K4ljSlc98jr7slbah3nCAenrDu8YW2pd2LhiclGjg5u/xA",
+	"Xh4dvXxJkPOHR//+PWrv7u+XQX/amLBw1tviFtXoKXoxvCfPLv96/u65cUq9xI+/4iccXzUlwtuzq7M3",
+	"DxME/Zj7w/j+6+Dt5fbznru/Ze5G3hOS/N/Q7xth58/BpyXFSudVqVL1Ttm/S1I1TYgWEzBhtjKTwUbd",
+	"MpiQO/RHM+PjNrkLYuzTKMYgh+RM+/4dElyz+YJrlvlXCjmpNfSSgHuLIMxAMpGSZ1cXx5c/XV+cXp2+",
+	"vzr78P55TCK4yqlahtBCaXB65euyKCdAZYYbhqLJ+u0dAZAxZZnL3/jhxUu71iaq0Otue+amRDE+giDr",
+	"r+lKPhsfmITLheTw+776a9vhpGTww4uXm1/ZzxJGgqcMPxragt1XuIUJTwsq3RrJd5tPxuxz3CAXRzoq",
+	"mBiVJnj5JSLaZy1XYycGb54ZFyMu2bPU2urr6rTWo84uPMU2W2/3FR0dG02M3VHt4LcoF/YZKns14omr",
+	"EWULk8V50w1z5HDKlBZy3pl4g2mgPu0G1ypTSK2ZDonrbZS4hL7E2xW2F3FhMoEijN3OzXFAEFHojEVs",
+	"oIQo4awUpVmWBVflWnOF2uk6LqEORNZPbrnbEZB+tn3ngG3e0ewuhSqJarxzfWcpFzrG6ddgfN2qWLyP",
+	"KPir3yoXgnEyOFAtj95NRQaViyGeb4uPP02jZc90a2gC4ER+TZsukyuN1yo4hsYOvsWdAcxTmyaTMcgn",
+	"2lWrRMGfyYJYoSLURQKFNWeUKOQo8NzzlFA8qtPqETx4XV1o+dzi3v8B69soINybLMa02mOb5ZZU+XU4",
+	"s72qShHKaTbXbBQNDQbVhGOQTpRtxGIag9xhHeEY5L6IcKtp6OIW3OXzYQ2bI3nVEEjuOB6D7OWgvxF6",
+	"2rorrBR2Q1IdncB8w35yIw07lIot8hRmly9xoXu22Pug+dPwz45d7r8hAsyAaNz1ttRzG93Ro63Im736",
+	"s0lvqkF6b9PCCJvN+1Gf1rH6dZL53jn4dfT+7Snfneqgpkt8ZlbfzqBZ4uW63pjkZQYqsU0HJIyA62xe",
+	"vmLcY0kV5tcsB9voBtWJO/SC3YAL2nf7vdR0w4eNmsb2dMcGVGB4/vnn/x8AvZ61FBh6AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,21 @@
+package budgets
+
+import "time"
+
+// Budget is the monthly spending limit of a category. A budget on a parent
+// category covers the spending of all its subcategories.
+type Budget struct {
+	ID          int64
+	CategoryID  int64
+	AmountCents int64
+	CreatedAt   time.Time
+}
+
+type CreateInput struct {
+	CategoryID  int64
+	AmountCents int64
+}
+
+type UpdateInput struct {
+	AmountCents int64
+}
@@ -0,0 +1,68 @@
+package budgets
+
+import "zankowitch.com/go-db-app/internal/categories"
+
+// Line compares one budget with what was spent in its category, including
+// all subcategories. PercentUsed is nil for a zero budget.
+type Line struct {
+	Budget         Budget
+	SpentCents     int64
+	RemainingCents int64
+	PercentUsed    *float64
+}
+
+// Report holds one Line per budget plus overall totals. Budgets nested under
+// another budgeted category are left out of the totals, since their spending
+// is already counted by the ancestor.
+type Report struct {
+	Lines          []Line
+	BudgetedCents  int64
+	SpentCents     int64
+	RemainingCents int64
+}
+
+// Compare builds the report from the month's spending per category. spent
+// holds positive amounts keyed by category id.
+func Compare(list []Budget, tree categories.Tree, spent map[int64]int64) Report {
+	budgeted := make(map[int64]bool, len(list))
+	for _, b := range list {
+		budgeted[b.CategoryID] = true
+	}
+
+	report := Report{Lines: make([]Line, 0, len(list))}
+	for _, b := range list {
+		total := spent[b.CategoryID]
+		for _, id := range tree.Descendants(b.CategoryID) {
+			total += spent[id]
+		}
+
+		line := Line{
+			Budget:         b,
+			SpentCents:     total,
+			RemainingCents: b.AmountCents - total,
+		}
+		if b.AmountCents > 0 {
+			percent := float64(total) * 100 / float64(b.AmountCents)
+			line.PercentUsed = &percent
+		}
+		report.Lines = append(report.Lines, line)
+
+		if hasBudgetedAncestor(tree, budgeted, b.CategoryID) {
+			continue
+		}
+		report.BudgetedCents += b.AmountCents
+		report.SpentCents += total
+	}
+	report.RemainingCents = report.BudgetedCents - report.SpentCents
+
+	return report
+}
+
+func hasBudgetedAncestor(tree categories.Tree, budgeted map[int64]bool, id int64) bool {
+	for _, ancestor := range tree.Ancestors(id)[1:] {
+		if budgeted[ancestor] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package budgets
+
+import (
+	"testing"
+
+	"zankowitch.com/go-db-app/internal/categories"
+)
+
+func TestCompare(t *testing.T) {
+	food := int64(1)
+	tree := categories.NewTree([]categories.Category{
+		{ID: 1, Name: "Food"},
+		{ID: 2, Name: "Groceries", ParentID: &food},
+		{ID: 3, Name: "Rent"},
+		{ID: 4, Name: "Gifts"},
+	})
+	list := []Budget{
+		{ID: 10, CategoryID: 1, AmountCents: 40000},
+		{ID: 11, CategoryID: 2, AmountCents: 20000},
+		{ID: 12, CategoryID: 3, AmountCents: 90000},
+		{ID: 13, CategoryID: 4, AmountCents: 0},
+	}
+	spent := map[int64]int64{1: 5000, 2: 25000, 3: 95000}
+
+	report := Compare(list, tree, spent)
+	if len(report.Lines) != 4 {
+		t.Fatalf("lines = %d, want 4", len(report.Lines))
+	}
+
+	foodLine := report.Lines[0]
+	if foodLine.SpentCents != 30000 || foodLine.RemainingCents != 10000 {
+		t.Fatalf("food line = %+v", foodLine)
+	}
+	if foodLine.PercentUsed == nil || *foodLine.PercentUsed != 75 {
+		t.Fatalf("food percent = %v, want 75", foodLine.PercentUsed)
+	}
+
+	groceries := report.Lines[1]
+	if groceries.SpentCents != 25000 || groceries.RemainingCents != -5000 {
+		t.Fatalf("groceries line = %+v", groceries)
+	}
+
+	gifts := report.Lines[3]
+	if gifts.SpentCents != 0 || gifts.PercentUsed != nil {
+		t.Fatalf("gifts line = %+v", gifts)
+	}
+
+	// Groceries is covered by the Food budget and must not be counted twice.
+	if report.BudgetedCents != 130000 || report.SpentCents != 125000 || report.RemainingCents != 5000 {
+		t.Fatalf("totals = %d/%d/%d, want 130000/125000/5000", report.BudgetedCents, report.SpentCents, report.RemainingCents)
+	}
+}
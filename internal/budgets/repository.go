@@ -0,0 +1,134 @@
+package budgets
+
+import (
+	"context"
+	"database/sql"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Repository stores budgets, whose amounts are in the base currency.
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// budgetColumns reads budgets with the base currency in placeholder
+// baseParam.
+func budgetColumns(baseParam int) string {
+	return `id, category_id, (amount * ` + transactions.ScaleOfParam(baseParam) + `)::bigint, created_at`
+}
+
+// liveCategory keeps budgets whose category is in the trash out of reads and
+// writes, as if they were gone.
+const liveCategory = `category_id IN (SELECT id FROM categories WHERE deleted_at IS NULL)`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBudget(row rowScanner) (Budget, error) {
+	var b Budget
+	err := row.Scan(
+		&b.ID,
+		&b.CategoryID,
+		&b.AmountCents,
+		&b.CreatedAt,
+	)
+	if err != nil {
+		return Budget{}, err
+	}
+
+	return b, nil
+}
+
+// Create adds a budget. Callers check that the category is live first; see
+// transactions.Repository.CheckCategory.
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Budget, error) {
+	query := `
+		INSERT INTO budgets (category_id, amount)
+		VALUES ($1, $2::numeric / ` + transactions.ScaleOfParam(3) + `)
+		RETURNING ` + budgetColumns(3)
+
+	return scanBudget(r.db.QueryRowContext(ctx, query, in.CategoryID, in.AmountCents, r.baseCurrency))
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Budget, error) {
+	query := `
+		SELECT ` + budgetColumns(2) + `
+		FROM budgets
+		WHERE id = $1 AND ` + liveCategory
+
+	return scanBudget(r.db.QueryRowContext(ctx, query, id, r.baseCurrency))
+}
+
+// List returns the budgets of every category that is not in the trash.
+func (r *Repository) List(ctx context.Context) ([]Budget, error) {
+	query := `
+		SELECT ` + budgetColumns(1) + `
+		FROM budgets
+		WHERE ` + liveCategory + `
+		ORDER BY category_id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	budgets := make([]Budget, 0)
+	for rows.Next() {
+		b, err := scanBudget(rows)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Budget, error) {
+	query := `
+		UPDATE budgets
+		SET amount = $1::numeric / ` + transactions.ScaleOfParam(3) + `
+		WHERE id = $2 AND ` + liveCategory + `
+		RETURNING ` + budgetColumns(3)
+
+	return scanBudget(r.db.QueryRowContext(ctx, query, in.AmountCents, id, r.baseCurrency))
+}
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM budgets WHERE id = $1 AND ` + liveCategory
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
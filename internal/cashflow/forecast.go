@@ -0,0 +1,64 @@
+package cashflow
+
+import (
+	"sort"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// build assembles the forecast. opening is the known balance at the start of
+// q.From; actual holds the transactions dated within the range and projected
+// every projection after q.AsOf through q.To. Projections before q.From are
+// folded into the opening balance.
+func build(q Query, opening int64, actual []transactions.Transaction, projected []Projected) Forecast {
+	sort.SliceStable(projected, func(i, j int) bool { return projected[i].Date.Before(projected[j].Date) })
+
+	days := make([]Day, daysBetween(q.From, q.To)+1)
+	for i := range days {
+		days[i].Date = q.From.AddDate(0, 0, i)
+	}
+
+	for _, t := range actual {
+		i := daysBetween(q.From, t.TransactionDate)
+		if i < 0 || i >= len(days) {
+			continue
+		}
+		days[i].ActualCents += t.AmountCents
+	}
+
+	upcoming := make([]Projected, 0)
+	for _, p := range projected {
+		i := daysBetween(q.From, p.Date)
+		if i < 0 {
+			opening += p.AmountCents
+			continue
+		}
+		if i >= len(days) {
+			continue
+		}
+		days[i].ProjectedCents += p.AmountCents
+		upcoming = append(upcoming, p)
+	}
+
+	f := Forecast{
+		From:                q.From,
+		To:                  q.To,
+		AsOf:                q.AsOf,
+		OpeningBalanceCents: opening,
+		Days:                days,
+		Upcoming:            upcoming,
+	}
+
+	balance := opening
+	for i := range days {
+		balance += days[i].ActualCents + days[i].ProjectedCents
+		days[i].BalanceCents = balance
+		if i == 0 || balance < f.LowestBalanceCents {
+			f.LowestBalanceCents = balance
+			f.LowestBalanceDate = days[i].Date
+		}
+	}
+	f.ClosingBalanceCents = balance
+
+	return f
+}
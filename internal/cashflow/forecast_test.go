@@ -0,0 +1,44 @@
+package cashflow
+
+import (
+	"testing"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func TestBuild(t *testing.T) {
+	q := Query{
+		From: date(2026, time.March, 1),
+		To:   date(2026, time.March, 5),
+		AsOf: date(2026, time.February, 25),
+	}
+	actual := []transactions.Transaction{
+		tx(date(2026, time.March, 2), -3000, "lunch"),
+	}
+	projected := []Projected{
+		{Date: date(2026, time.March, 4), AmountCents: -120000},
+		{Date: date(2026, time.February, 27), AmountCents: 50000},
+		{Date: date(2026, time.March, 5), AmountCents: 250000},
+	}
+
+	f := build(q, 100000, actual, projected)
+	if f.OpeningBalanceCents != 150000 {
+		t.Fatalf("opening = %d, want 150000", f.OpeningBalanceCents)
+	}
+	if len(f.Days) != 5 {
+		t.Fatalf("days = %d, want 5", len(f.Days))
+	}
+	if f.Days[1].ActualCents != -3000 || f.Days[1].BalanceCents != 147000 {
+		t.Fatalf("day 2 = %+v", f.Days[1])
+	}
+	if f.LowestBalanceCents != 27000 || !f.LowestBalanceDate.Equal(date(2026, time.March, 4)) {
+		t.Fatalf("lowest = %d on %s", f.LowestBalanceCents, f.LowestBalanceDate.Format(time.DateOnly))
+	}
+	if f.ClosingBalanceCents != 277000 {
+		t.Fatalf("closing = %d, want 277000", f.ClosingBalanceCents)
+	}
+	if len(f.Upcoming) != 2 || !f.Upcoming[0].Date.Equal(date(2026, time.March, 4)) {
+		t.Fatalf("upcoming = %+v", f.Upcoming)
+	}
+}
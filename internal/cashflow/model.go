@@ -0,0 +1,66 @@
+package cashflow
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxDays is how far after From a forecast may end.
+const MaxDays = 366
+
+var (
+	ErrInvalidRange = errors.New("from must not be after to")
+	ErrRangeTooLong = errors.New("to must be at most 366 days after from")
+)
+
+// Source tells where a projected transaction comes from.
+type Source string
+
+const (
+	SourcePattern       Source = "pattern"
+	SourceRecurringRule Source = "recurring_rule"
+)
+
+// Query selects the forecast. Transactions up to AsOf are known history;
+// projections start the day after.
+type Query struct {
+	From      time.Time
+	To        time.Time
+	AsOf      time.Time
+	AccountID *int64
+}
+
+// Projected is a transaction expected after the as-of date. RecurringRuleID
+// is set when Source is SourceRecurringRule.
+type Projected struct {
+	Date            time.Time
+	AmountCents     int64
+	Description     *string
+	AccountID       *int64
+	CategoryID      *int64
+	Source          Source
+	RecurringRuleID *int64
+}
+
+// Day is one day of the series. BalanceCents is the balance at the end of it.
+type Day struct {
+	Date           time.Time
+	ActualCents    int64
+	ProjectedCents int64
+	BalanceCents   int64
+}
+
+// Forecast is the daily balance from From through To. OpeningBalanceCents is
+// the balance at the start of From, including projections between AsOf and
+// From. Upcoming lists the projections within the range.
+type Forecast struct {
+	From                time.Time
+	To                  time.Time
+	AsOf                time.Time
+	OpeningBalanceCents int64
+	ClosingBalanceCents int64
+	LowestBalanceCents  int64
+	LowestBalanceDate   time.Time
+	Days                []Day
+	Upcoming            []Projected
+}
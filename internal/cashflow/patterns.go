@@ -0,0 +1,199 @@
+package cashflow
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+const (
+	// lookbackDays is how much history before the as-of date is searched for
+	// patterns. It is long enough to see quarterly payments repeat.
+	lookbackDays = 400
+	// minOccurrences is how many times a transaction has to repeat before it
+	// is projected.
+	minOccurrences = 3
+	// amountTolerancePercent is how far an occurrence may stray from the
+	// median amount and still count as the same bill.
+	amountTolerancePercent = 10
+	// irregularGapsPercent is the share of gaps that may fall outside the
+	// cadence, e.g. a bill paid late once or a skipped month.
+	irregularGapsPercent = 25
+)
+
+// cadence is an interval a pattern may repeat at, with the range of day gaps
+// accepted between two occurrences.
+type cadence struct {
+	frequency recurring.Frequency
+	every     int
+	minDays   int
+	maxDays   int
+}
+
+var cadences = []cadence{
+	{frequency: recurring.FrequencyWeekly, every: 1, minDays: 6, maxDays: 8},
+	{frequency: recurring.FrequencyWeekly, every: 2, minDays: 12, maxDays: 16},
+	{frequency: recurring.FrequencyMonthly, every: 1, minDays: 25, maxDays: 35},
+	{frequency: recurring.FrequencyMonthly, every: 3, minDays: 84, maxDays: 98},
+}
+
+// pattern is a detected periodic transaction. last is its latest occurrence
+// up to the as-of date; booked is the latest one overall, which may be a
+// future-dated transaction already entered by hand.
+type pattern struct {
+	cadence cadence
+	last    transactions.Transaction
+	booked  time.Time
+}
+
+type patternKey struct {
+	accountID   int64
+	payeeID     int64
+	description string
+	income      bool
+}
+
+// keyOf groups transactions by account, payee and sign, or by normalised
+// description instead of payee when the transaction has none, so a
+// merchant's varying reference numbers still make one pattern. Transactions
+// with neither, and those booked by a recurring rule (which are projected
+// from the rule itself), are not grouped.
+func keyOf(t transactions.Transaction) (patternKey, bool) {
+	if t.RecurringRuleID != nil {
+		return patternKey{}, false
+	}
+
+	key := patternKey{income: t.AmountCents > 0}
+	if t.AccountID != nil {
+		key.accountID = *t.AccountID
+	}
+	if t.PayeeID != nil {
+		key.payeeID = *t.PayeeID
+		return key, true
+	}
+	if t.Description == nil {
+		return patternKey{}, false
+	}
+	key.description = strings.ToLower(strings.Join(strings.Fields(*t.Description), " "))
+	if key.description == "" {
+		return patternKey{}, false
+	}
+	return key, true
+}
+
+// detectPatterns finds the transactions in history (oldest first) that repeat
+// at a regular cadence with a stable amount. Only transactions up to asOf are
+// used to fit a pattern.
+func detectPatterns(history []transactions.Transaction, asOf time.Time) []pattern {
+	groups := make(map[patternKey][]transactions.Transaction)
+	booked := make(map[patternKey]time.Time)
+	order := make([]patternKey, 0)
+	for _, t := range history {
+		key, ok := keyOf(t)
+		if !ok {
+			continue
+		}
+		booked[key] = t.TransactionDate
+		if t.TransactionDate.After(asOf) {
+			continue
+		}
+		if _, seen := groups[key]; !seen {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], t)
+	}
+
+	patterns := make([]pattern, 0)
+	for _, key := range order {
+		series := groups[key]
+		c, ok := fitCadence(series, asOf)
+		if !ok || !stableAmount(series) {
+			continue
+		}
+		patterns = append(patterns, pattern{cadence: c, last: series[len(series)-1], booked: booked[key]})
+	}
+	return patterns
+}
+
+// fitCadence returns the cadence the gaps of series fall into, allowing
+// irregularGapsPercent of them outside it. A pattern whose next occurrence is
+// overdue at asOf has stopped and is not returned.
+func fitCadence(series []transactions.Transaction, asOf time.Time) (cadence, bool) {
+	if len(series) < minOccurrences {
+		return cadence{}, false
+	}
+
+	gaps := len(series) - 1
+	for _, c := range cadences {
+		irregular := 0
+		for i := 1; i < len(series); i++ {
+			gap := daysBetween(series[i-1].TransactionDate, series[i].TransactionDate)
+			if gap < c.minDays || gap > c.maxDays {
+				irregular++
+			}
+		}
+		if irregular*100 > gaps*irregularGapsPercent {
+			continue
+		}
+		if daysBetween(series[len(series)-1].TransactionDate, asOf) > c.maxDays {
+			return cadence{}, false
+		}
+		return c, true
+	}
+	return cadence{}, false
+}
+
+func stableAmount(series []transactions.Transaction) bool {
+	amounts := make([]int64, len(series))
+	for i, t := range series {
+		amounts[i] = abs(t.AmountCents)
+	}
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i] < amounts[j] })
+	median := amounts[len(amounts)/2]
+
+	for _, a := range amounts {
+		if abs(a-median)*100 > median*amountTolerancePercent {
+			return false
+		}
+	}
+	return true
+}
+
+// project returns the occurrences of the pattern after asOf through through,
+// skipping dates already covered by a booked transaction. The amount,
+// account and category are those of the latest occurrence.
+func (p pattern) project(asOf, through time.Time) []Projected {
+	after := asOf
+	if p.booked.After(after) {
+		after = p.booked
+	}
+
+	dates := recurring.Occurrences(p.cadence.frequency, p.cadence.every, p.last.TransactionDate, after, through, nil)
+	projected := make([]Projected, 0, len(dates))
+	for _, d := range dates {
+		projected = append(projected, Projected{
+			Date:        d,
+			AmountCents: p.last.AmountCents,
+			Description: p.last.Description,
+			AccountID:   p.last.AccountID,
+			CategoryID:  p.last.CategoryID,
+			Source:      SourcePattern,
+		})
+	}
+	return projected
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
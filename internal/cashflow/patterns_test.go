@@ -0,0 +1,111 @@
+package cashflow
+
+import (
+	"testing"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func tx(d time.Time, cents int64, description string) transactions.Transaction {
+	return transactions.Transaction{TransactionDate: d, AmountCents: cents, Description: &description}
+}
+
+func TestDetectPatternsMonthly(t *testing.T) {
+	history := []transactions.Transaction{
+		tx(date(2026, time.January, 1), -120000, "Rent"),
+		tx(date(2026, time.January, 3), -4200, "Coffee"),
+		tx(date(2026, time.February, 2), -120000, "rent "),
+		tx(date(2026, time.February, 20), -9900, "Coffee"),
+		tx(date(2026, time.March, 1), -120000, "RENT"),
+		tx(date(2026, time.March, 4), -1500, "Coffee"),
+	}
+
+	patterns := detectPatterns(history, date(2026, time.March, 15))
+	if len(patterns) != 1 {
+		t.Fatalf("patterns = %d, want 1", len(patterns))
+	}
+	if c := patterns[0].cadence; c.frequency != recurring.FrequencyMonthly || c.every != 1 {
+		t.Fatalf("cadence = %+v, want monthly", c)
+	}
+
+	projected := patterns[0].project(date(2026, time.March, 15), date(2026, time.May, 31))
+	if len(projected) != 2 || !projected[0].Date.Equal(date(2026, time.April, 1)) || !projected[1].Date.Equal(date(2026, time.May, 1)) {
+		t.Fatalf("projected = %+v", projected)
+	}
+	if projected[0].AmountCents != -120000 || projected[0].Source != SourcePattern {
+		t.Fatalf("projected = %+v", projected[0])
+	}
+}
+
+func TestDetectPatternsSkipsUnstableAndStopped(t *testing.T) {
+	history := []transactions.Transaction{
+		tx(date(2026, time.January, 2), -5000, "Groceries"),
+		tx(date(2026, time.January, 9), -12000, "Groceries"),
+		tx(date(2026, time.January, 16), -7000, "Groceries"),
+		tx(date(2026, time.January, 5), -999, "Streaming"),
+		tx(date(2026, time.February, 5), -999, "Streaming"),
+		tx(date(2026, time.March, 5), -999, "Streaming"),
+	}
+
+	if patterns := detectPatterns(history, date(2026, time.March, 10)); len(patterns) != 1 {
+		t.Fatalf("patterns = %d, want only the subscription", len(patterns))
+	}
+	if patterns := detectPatterns(history, date(2026, time.May, 1)); len(patterns) != 0 {
+		t.Fatalf("patterns = %d, want none once the subscription stopped", len(patterns))
+	}
+}
+
+func TestProjectSkipsBookedOccurrences(t *testing.T) {
+	history := []transactions.Transaction{
+		tx(date(2026, time.January, 2), 250000, "Salary"),
+		tx(date(2026, time.January, 16), 250000, "Salary"),
+		tx(date(2026, time.January, 30), 250000, "Salary"),
+		tx(date(2026, time.February, 13), 250000, "Salary"),
+	}
+
+	asOf := date(2026, time.February, 1)
+	patterns := detectPatterns(history, asOf)
+	if len(patterns) != 1 || patterns[0].cadence.every != 2 {
+		t.Fatalf("patterns = %+v, want one biweekly pattern", patterns)
+	}
+
+	projected := patterns[0].project(asOf, date(2026, time.March, 1))
+	if len(projected) != 1 || !projected[0].Date.Equal(date(2026, time.February, 27)) {
+		t.Fatalf("projected = %+v, want only 2026-02-27", projected)
+	}
+}
+
+func TestDetectPatternsGroupsByPayeeAndToleratesAnIrregularGap(t *testing.T) {
+	gym := int64(7)
+	withPayee := func(d time.Time, description string) transactions.Transaction {
+		row := tx(d, -3000, description)
+		row.PayeeID = &gym
+		return row
+	}
+	history := []transactions.Transaction{
+		withPayee(date(2026, time.January, 1), "GYM 0001"),
+		withPayee(date(2026, time.February, 1), "GYM 0002"),
+		withPayee(date(2026, time.March, 1), "GYM 0003"),
+		withPayee(date(2026, time.April, 15), "GYM 0004"),
+		withPayee(date(2026, time.May, 15), "GYM 0005"),
+		withPayee(date(2026, time.June, 15), "GYM 0006"),
+	}
+
+	patterns := detectPatterns(history, date(2026, time.June, 20))
+	if len(patterns) != 1 {
+		t.Fatalf("patterns = %d, want the gym payee despite one late payment", len(patterns))
+	}
+	if c := patterns[0].cadence; c.frequency != recurring.FrequencyMonthly || c.every != 1 {
+		t.Fatalf("cadence = %+v, want monthly", c)
+	}
+
+	if patterns := detectPatterns(history[:4], date(2026, time.April, 20)); len(patterns) != 0 {
+		t.Fatalf("patterns = %d, want none with one irregular gap out of three", len(patterns))
+	}
+}
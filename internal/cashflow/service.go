@@ -0,0 +1,134 @@
+package cashflow
+
+import (
+	"context"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/accounts"
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service projects balances from account opening balances, transactions,
+// recurring rules and the periodic patterns found in past transactions.
+type Service struct {
+	transactions *transactions.Repository
+	accounts     *accounts.Repository
+	recurring    *recurring.Repository
+}
+
+func NewService(transactions *transactions.Repository, accounts *accounts.Repository, recurring *recurring.Repository) *Service {
+	return &Service{transactions: transactions, accounts: accounts, recurring: recurring}
+}
+
+// Forecast returns the daily balance for q, in the base currency. It returns
+// sql.ErrNoRows when q.AccountID does not exist and
+// transactions.ErrMissingRate when a transaction cannot be converted.
+func (s *Service) Forecast(ctx context.Context, q Query) (Forecast, error) {
+	if q.To.Before(q.From) {
+		return Forecast{}, ErrInvalidRange
+	}
+	if q.To.After(q.From.AddDate(0, 0, MaxDays)) {
+		return Forecast{}, ErrRangeTooLong
+	}
+
+	opening, err := s.openingBalance(ctx, q)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	actual, err := s.transactions.ListRangeInBase(ctx, transactions.ListFilter{
+		FromDate:  &q.From,
+		ToDate:    &q.To,
+		AccountID: q.AccountID,
+	})
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	// History runs past the as-of date so patterns skip occurrences that were
+	// already entered ahead of time.
+	historyFrom := q.AsOf.AddDate(0, 0, -lookbackDays)
+	historyTo := q.To
+	if q.AsOf.After(historyTo) {
+		historyTo = q.AsOf
+	}
+	history, err := s.transactions.ListRangeInBase(ctx, transactions.ListFilter{
+		FromDate:  &historyFrom,
+		ToDate:    &historyTo,
+		AccountID: q.AccountID,
+	})
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	projected := make([]Projected, 0)
+	for _, p := range detectPatterns(history, q.AsOf) {
+		projected = append(projected, p.project(q.AsOf, q.To)...)
+	}
+
+	rules, err := s.recurring.List(ctx)
+	if err != nil {
+		return Forecast{}, err
+	}
+	for _, r := range rules {
+		if q.AccountID != nil && (r.AccountID == nil || *r.AccountID != *q.AccountID) {
+			continue
+		}
+		projected = append(projected, projectRule(r, q.AsOf, q.To)...)
+	}
+
+	return build(q, opening, actual, projected), nil
+}
+
+// openingBalance is the balance at the start of q.From without projections:
+// the accounts' opening balances plus every earlier transaction.
+func (s *Service) openingBalance(ctx context.Context, q Query) (int64, error) {
+	var opening int64
+	if q.AccountID != nil {
+		account, err := s.accounts.Get(ctx, *q.AccountID)
+		if err != nil {
+			return 0, err
+		}
+		opening = account.OpeningBalanceCents
+	} else {
+		list, err := s.accounts.List(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, a := range list {
+			opening += a.OpeningBalanceCents
+		}
+	}
+
+	before, err := s.transactions.SumBefore(ctx, q.From, q.AccountID)
+	if err != nil {
+		return 0, err
+	}
+
+	return opening + before, nil
+}
+
+// projectRule returns the occurrences of r after asOf through through that
+// the rule has not booked yet.
+func projectRule(r recurring.Rule, asOf, through time.Time) []Projected {
+	after := asOf
+	if dayBeforeNext := r.NextDate.AddDate(0, 0, -1); dayBeforeNext.After(after) {
+		after = dayBeforeNext
+	}
+
+	dates := recurring.Occurrences(r.Frequency, r.Every, r.StartDate, after, through, r.EndDate)
+	projected := make([]Projected, 0, len(dates))
+	for _, d := range dates {
+		projected = append(projected, Projected{
+			Date:            d,
+			AmountCents:     r.AmountCents,
+			Description:     r.Description,
+			AccountID:       r.AccountID,
+			CategoryID:      r.CategoryID,
+			Source:          SourceRecurringRule,
+			RecurringRuleID: &r.ID,
+		})
+	}
+	return projected
+}
@@ -1,17 +1,59 @@
 package categories
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
+// ErrCycle is returned when a parent assignment would make a category its own
+// ancestor.
+var ErrCycle = errors.New("category cannot be nested under itself or its descendants")
+
+// ErrVersionMismatch is returned when a category was changed since the version
+// the caller read.
+var ErrVersionMismatch = errors.New("category was changed since it was read")
+
+// ParentID is nil for top-level categories. ArchivedAt is set once the
+// category has been retired: it keeps its history but takes no new
+// transactions. DeletedAt is set while the category is in the trash. Version
+// goes up with every update of the row and backs the API's ETag.
 type Category struct {
-	ID        int64
-	Name      string
-	CreatedAt time.Time
+	ID         int64
+	Name       string
+	ParentID   *int64
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	DeletedAt  *time.Time
+	Version    int64
+}
+
+// CheckVersion returns ErrVersionMismatch when version is set and is not the
+// category's current one.
+func (c Category) CheckVersion(version *int64) error {
+	if version != nil && *version != c.Version {
+		return ErrVersionMismatch
+	}
+	return nil
 }
 
 type CreateInput struct {
-	Name string
+	Name     string
+	ParentID *int64
 }
 
 type UpdateInput struct {
-	Name string
+	Name     string
+	ParentID *int64
+}
+
+// MergeResult reports what a merge moved into Target.
+type MergeResult struct {
+	Target                   Category
+	TransactionsMoved        int64
+	SplitLinesMoved          int64
+	SubcategoriesMoved       int64
+	RulesMoved               int64
+	RecurringRulesMoved      int64
+	BudgetsMoved             int64
+	EnvelopeAssignmentsMoved int64
 }
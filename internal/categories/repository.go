@@ -3,63 +3,128 @@ package categories
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
 )
 
+// ErrInUse is returned when deleting a category that still has child
+// categories or split lines outside the trash.
+var ErrInUse = errors.New("category is still in use")
+
+// ErrParentNotFound is returned when a category is nested under a parent that
+// does not exist or is in the trash.
+var ErrParentNotFound = errors.New("parent category not found")
+
 type Repository struct {
-	db *sql.DB
+	db db.DBTX
 }
 
 func NewRepository(db *sql.DB) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) Create(ctx context.Context, in CreateInput) (Category, error) {
-	const query = `
-		INSERT INTO categories (name)
-		VALUES ($1)
-		RETURNING id, name, created_at
-	`
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx}
+}
+
+const categoryColumns = `id, name, parent_id, archived_at, created_at, deleted_at, version`
 
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCategory(row rowScanner) (Category, error) {
 	var c Category
-	err := r.db.QueryRowContext(ctx, query, in.Name).Scan(
+	var parentID sql.NullInt64
+	var archivedAt sql.NullTime
+	err := row.Scan(
 		&c.ID,
 		&c.Name,
+		&parentID,
+		&archivedAt,
 		&c.CreatedAt,
+		&c.DeletedAt,
+		&c.Version,
 	)
 	if err != nil {
 		return Category{}, err
 	}
 
+	if parentID.Valid {
+		c.ParentID = &parentID.Int64
+	}
+	if archivedAt.Valid {
+		c.ArchivedAt = &archivedAt.Time
+	}
+
 	return c, nil
 }
 
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Category, error) {
+	if err := r.checkParent(ctx, in.ParentID); err != nil {
+		return Category{}, err
+	}
+
+	const query = `
+		INSERT INTO categories (name, parent_id)
+		VALUES ($1, $2)
+		RETURNING ` + categoryColumns
+
+	return r.write(ctx, query, in.Name, in.ParentID)
+}
+
 func (r *Repository) Get(ctx context.Context, id int64) (Category, error) {
 	const query = `
-		SELECT id, name, created_at
+		SELECT ` + categoryColumns + `
 		FROM categories
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	var c Category
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&c.ID,
-		&c.Name,
-		&c.CreatedAt,
-	)
-	if err != nil {
-		return Category{}, err
-	}
+	return scanCategory(r.db.QueryRowContext(ctx, query, id))
+}
 
-	return c, nil
+// GetForUpdate is Get with a row lock, for use inside a transaction.
+func (r *Repository) GetForUpdate(ctx context.Context, id int64) (Category, error) {
+	const query = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+
+	return scanCategory(r.db.QueryRowContext(ctx, query, id))
 }
 
+// List returns every category outside the trash, archived ones included, so
+// historical reports keep their rows.
 func (r *Repository) List(ctx context.Context) ([]Category, error) {
 	const query = `
-		SELECT id, name, created_at
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE deleted_at IS NULL
+		ORDER BY name ASC, id ASC
+	`
+
+	return r.list(ctx, query)
+}
+
+// ListActive returns the categories that have been neither archived nor
+// deleted.
+func (r *Repository) ListActive(ctx context.Context) ([]Category, error) {
+	const query = `
+		SELECT ` + categoryColumns + `
 		FROM categories
+		WHERE archived_at IS NULL AND deleted_at IS NULL
 		ORDER BY name ASC, id ASC
 	`
 
+	return r.list(ctx, query)
+}
+
+func (r *Repository) list(ctx context.Context, query string) ([]Category, error) {
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -68,8 +133,8 @@ func (r *Repository) List(ctx context.Context) ([]Category, error) {
 
 	categories := make([]Category, 0)
 	for rows.Next() {
-		var c Category
-		if err := rows.Scan(&c.ID, &c.Name, &c.CreatedAt); err != nil {
+		c, err := scanCategory(rows)
+		if err != nil {
 			return nil, err
 		}
 		categories = append(categories, c)
@@ -82,42 +147,264 @@ func (r *Repository) List(ctx context.Context) ([]Category, error) {
 	return categories, nil
 }
 
-func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Category, error) {
+// Update renames the category and moves it under in.ParentID. It returns
+// ErrCycle when the new parent is the category itself or one of its
+// descendants, and ErrParentNotFound when it is missing or in the trash.
+// When ifVersion is set, it returns ErrVersionMismatch unless the category is
+// still at that version.
+func (r *Repository) Update(ctx context.Context, id int64, ifVersion *int64, in UpdateInput) (Category, error) {
+	if err := r.checkParent(ctx, in.ParentID); err != nil {
+		return Category{}, err
+	}
+	if in.ParentID != nil {
+		cycle, err := r.isSelfOrDescendant(ctx, id, *in.ParentID)
+		if err != nil {
+			return Category{}, err
+		}
+		if cycle {
+			return Category{}, ErrCycle
+		}
+	}
+
 	const query = `
 		UPDATE categories
-		SET name = $1
-		WHERE id = $2
-		RETURNING id, name, created_at
-	`
+		SET name = $1,
+			parent_id = $2
+		WHERE id = $3 AND deleted_at IS NULL
+		RETURNING ` + categoryColumns
 
-	var c Category
-	err := r.db.QueryRowContext(ctx, query, in.Name, id).Scan(
-		&c.ID,
-		&c.Name,
-		&c.CreatedAt,
-	)
+	var updated Category
+	err := db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		current, err := (&Repository{db: tx}).GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := current.CheckVersion(ifVersion); err != nil {
+			return err
+		}
+
+		updated, err = scanCategory(tx.QueryRowContext(ctx, query, in.Name, in.ParentID, id))
+		return err
+	})
 	if err != nil {
 		return Category{}, err
 	}
 
-	return c, nil
+	return updated, nil
 }
 
-func (r *Repository) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM categories WHERE id = $1`
-
-	res, err := r.db.ExecContext(ctx, query, id)
-	if err != nil {
+// write runs a statement returning one category through db.Atomic.
+func (r *Repository) write(ctx context.Context, query string, args ...any) (Category, error) {
+	var written Category
+	err := db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		var err error
+		written, err = scanCategory(tx.QueryRowContext(ctx, query, args...))
 		return err
+	})
+	if err != nil {
+		return Category{}, err
 	}
 
-	affected, err := res.RowsAffected()
-	if err != nil {
+	return written, nil
+}
+
+// checkParent returns ErrParentNotFound unless parentID is nil or a category
+// outside the trash.
+func (r *Repository) checkParent(ctx context.Context, parentID *int64) error {
+	if parentID == nil {
+		return nil
+	}
+
+	const query = `SELECT EXISTS (SELECT 1 FROM categories WHERE id = $1 AND deleted_at IS NULL)`
+
+	var found bool
+	if err := r.db.QueryRowContext(ctx, query, *parentID).Scan(&found); err != nil {
 		return err
 	}
-	if affected == 0 {
-		return sql.ErrNoRows
+	if !found {
+		return ErrParentNotFound
 	}
-
 	return nil
 }
+
+// isSelfOrDescendant reports whether candidate is id or lies below it, by
+// walking up from candidate.
+func (r *Repository) isSelfOrDescendant(ctx context.Context, id, candidate int64) (bool, error) {
+	const query = `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id
+			FROM categories
+			WHERE id = $1
+			UNION
+			SELECT c.id, c.parent_id
+			FROM categories c
+			JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+	`
+
+	var found bool
+	if err := r.db.QueryRowContext(ctx, query, candidate, id).Scan(&found); err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// Archive marks the category as archived. Archiving an archived category
+// keeps its original timestamp.
+func (r *Repository) Archive(ctx context.Context, id int64) (Category, error) {
+	const query = `
+		UPDATE categories
+		SET archived_at = COALESCE(archived_at, now())
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING ` + categoryColumns
+
+	return r.write(ctx, query, id)
+}
+
+// Unarchive makes an archived category available for new transactions again.
+func (r *Repository) Unarchive(ctx context.Context, id int64) (Category, error) {
+	const query = `
+		UPDATE categories
+		SET archived_at = NULL
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING ` + categoryColumns
+
+	return r.write(ctx, query, id)
+}
+
+// reassign points everything that references sourceID at targetID instead.
+// The source's budget and envelope assignments are added to the target's when
+// both have one for the same month.
+func (r *Repository) reassign(ctx context.Context, sourceID, targetID int64) (MergeResult, error) {
+	var result MergeResult
+	statements := []struct {
+		query string
+		count *int64
+	}{
+		{`UPDATE transactions SET category_id = $2 WHERE category_id = $1`, &result.TransactionsMoved},
+		{`UPDATE transaction_splits SET category_id = $2 WHERE category_id = $1`, &result.SplitLinesMoved},
+		{`UPDATE categories SET parent_id = $2 WHERE parent_id = $1`, &result.SubcategoriesMoved},
+		{`UPDATE categorization_rules SET category_id = $2 WHERE category_id = $1`, &result.RulesMoved},
+		{`UPDATE recurring_rules SET category_id = $2 WHERE category_id = $1`, &result.RecurringRulesMoved},
+		{`
+			WITH source AS (
+				DELETE FROM budgets WHERE category_id = $1 RETURNING amount, created_at
+			)
+			INSERT INTO budgets (category_id, amount, created_at)
+			SELECT $2, amount, created_at FROM source
+			ON CONFLICT (category_id) DO UPDATE SET amount = budgets.amount + EXCLUDED.amount
+		`, &result.BudgetsMoved},
+		{`
+			WITH source AS (
+				DELETE FROM envelope_assignments WHERE category_id = $1 RETURNING month, amount
+			)
+			INSERT INTO envelope_assignments (category_id, month, amount)
+			SELECT $2, month, amount FROM source
+			ON CONFLICT (category_id, month) DO UPDATE
+			SET amount = envelope_assignments.amount + EXCLUDED.amount, updated_at = now()
+		`, &result.EnvelopeAssignmentsMoved},
+	}
+
+	for _, stmt := range statements {
+		res, err := r.db.ExecContext(ctx, stmt.query, sourceID, targetID)
+		if err != nil {
+			return MergeResult{}, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return MergeResult{}, err
+		}
+		*stmt.count = affected
+	}
+
+	return result, nil
+}
+
+// Delete moves the category to the trash. It returns ErrInUse while
+// categories or split lines outside the trash, or recurring rules that still
+// have occurrences to book, refer to it; the
+// transactions filed directly under it keep pointing at it until it is purged,
+// when they become uncategorized. When ifVersion is set, it returns
+// ErrVersionMismatch unless the category is still at that version.
+func (r *Repository) Delete(ctx context.Context, id int64, ifVersion *int64) error {
+	const inUseQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM categories WHERE parent_id = $1 AND deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1
+			FROM transaction_splits s
+			JOIN transactions t ON t.id = s.transaction_id
+			WHERE s.category_id = $1 AND t.deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1
+			FROM recurring_rules
+			WHERE category_id = $1 AND (end_date IS NULL OR next_date <= end_date)
+		)
+	`
+	const query = `UPDATE categories SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+
+	return db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		current, err := (&Repository{db: tx}).GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := current.CheckVersion(ifVersion); err != nil {
+			return err
+		}
+
+		var inUse bool
+		if err := tx.QueryRowContext(ctx, inUseQuery, id).Scan(&inUse); err != nil {
+			return err
+		}
+		if inUse {
+			return ErrInUse
+		}
+
+		_, err = tx.ExecContext(ctx, query, id)
+		return err
+	})
+}
+
+// ListDeleted returns the categories in the trash, most recently deleted
+// first.
+func (r *Repository) ListDeleted(ctx context.Context) ([]Category, error) {
+	const query = `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id DESC
+	`
+
+	return r.list(ctx, query)
+}
+
+// Purge permanently deletes the categories trashed before cutoff and returns
+// how many were removed. A category is kept while trashed split lines or
+// subcategories still refer to it; it goes once they have been purged.
+// Children are removed before their parents, so it runs level by level.
+func (r *Repository) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	const query = `
+		DELETE FROM categories
+		WHERE deleted_at < $1
+			AND NOT EXISTS (SELECT 1 FROM categories c WHERE c.parent_id = categories.id)
+			AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.category_id = categories.id)
+	`
+
+	var purged int64
+	for {
+		res, err := r.db.ExecContext(ctx, query, cutoff)
+		if err != nil {
+			return 0, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		if affected == 0 {
+			return purged, nil
+		}
+		purged += affected
+	}
+}
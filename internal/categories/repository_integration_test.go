@@ -72,7 +72,7 @@ func TestRepositoryCRUD(t *testing.T) {
 	})
 
 	t.Run("update category", func(t *testing.T) {
-		updated, err := repo.Update(ctx, created.ID, UpdateInput{Name: updatedName})
+		updated, err := repo.Update(ctx, created.ID, nil, UpdateInput{Name: updatedName})
 		if err != nil {
 			t.Fatalf("update: %v", err)
 		}
@@ -82,7 +82,7 @@ func TestRepositoryCRUD(t *testing.T) {
 	})
 
 	t.Run("delete category", func(t *testing.T) {
-		if err := repo.Delete(ctx, created.ID); err != nil {
+		if err := repo.Delete(ctx, created.ID, nil); err != nil {
 			t.Fatalf("delete: %v", err)
 		}
 	})
@@ -0,0 +1,76 @@
+package categories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+var (
+	ErrMergeIntoSelf  = errors.New("a category cannot be merged into itself")
+	ErrTargetNotFound = errors.New("target category not found")
+)
+
+// Service runs category operations that span several statements.
+type Service struct {
+	db   *sql.DB
+	repo *Repository
+}
+
+func NewService(db *sql.DB, repo *Repository) *Service {
+	return &Service{db: db, repo: repo}
+}
+
+// Merge moves every transaction, split line, subcategory, rule, budget and
+// envelope assignment of sourceID to targetID and deletes the source, all
+// inside one database transaction. The source row is locked first so no transaction can be
+// assigned to it while the merge runs and then be orphaned by the delete.
+func (s *Service) Merge(ctx context.Context, sourceID, targetID int64) (MergeResult, error) {
+	if sourceID == targetID {
+		return MergeResult{}, ErrMergeIntoSelf
+	}
+
+	var result MergeResult
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		repo := s.repo.WithTx(tx)
+
+		if _, err := repo.GetForUpdate(ctx, sourceID); err != nil {
+			return err
+		}
+		target, err := repo.GetForUpdate(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTargetNotFound
+			}
+			return err
+		}
+
+		// The source's children are re-parented under the target, so the
+		// target must not be one of them.
+		cycle, err := repo.isSelfOrDescendant(ctx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return ErrCycle
+		}
+
+		result, err = repo.reassign(ctx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if err := repo.Delete(ctx, sourceID, nil); err != nil {
+			return err
+		}
+
+		result.Target = target
+		return nil
+	})
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package categories
+
+// Tree answers hierarchy questions over a loaded list of categories without
+// further queries. Parents missing from the list are treated as roots.
+type Tree struct {
+	parents  map[int64]*int64
+	children map[int64][]int64
+}
+
+func NewTree(list []Category) Tree {
+	t := Tree{
+		parents:  make(map[int64]*int64, len(list)),
+		children: make(map[int64][]int64),
+	}
+	for _, c := range list {
+		t.parents[c.ID] = c.ParentID
+	}
+	for _, c := range list {
+		if c.ParentID == nil {
+			continue
+		}
+		if _, ok := t.parents[*c.ParentID]; ok {
+			t.children[*c.ParentID] = append(t.children[*c.ParentID], c.ID)
+		}
+	}
+	return t
+}
+
+// Ancestors returns id followed by its parent, grandparent and so on up to
+// the root.
+func (t Tree) Ancestors(id int64) []int64 {
+	chain := []int64{id}
+	seen := map[int64]bool{id: true}
+	for {
+		parent := t.parents[id]
+		if parent == nil {
+			return chain
+		}
+		if _, ok := t.parents[*parent]; !ok || seen[*parent] {
+			return chain
+		}
+		id = *parent
+		seen[id] = true
+		chain = append(chain, id)
+	}
+}
+
+// Level is 1 for top-level categories, 2 for their children, and so on.
+func (t Tree) Level(id int64) int {
+	return len(t.Ancestors(id))
+}
+
+// Descendants returns every category below id, excluding id itself.
+func (t Tree) Descendants(id int64) []int64 {
+	out := make([]int64, 0)
+	seen := map[int64]bool{id: true}
+	queue := []int64{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range t.children[current] {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			out = append(out, child)
+			queue = append(queue, child)
+		}
+	}
+	return out
+}
@@ -0,0 +1,41 @@
+package categories
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	food, groceries, organic, travel := int64(1), int64(2), int64(3), int64(4)
+	tree := NewTree([]Category{
+		{ID: food},
+		{ID: groceries, ParentID: &food},
+		{ID: organic, ParentID: &groceries},
+		{ID: travel},
+	})
+
+	if got := tree.Ancestors(organic); !slices.Equal(got, []int64{organic, groceries, food}) {
+		t.Fatalf("ancestors = %v", got)
+	}
+	if tree.Level(food) != 1 || tree.Level(organic) != 3 {
+		t.Fatalf("levels = %d/%d, want 1/3", tree.Level(food), tree.Level(organic))
+	}
+	if got := tree.Descendants(food); !slices.Equal(got, []int64{groceries, organic}) {
+		t.Fatalf("descendants = %v", got)
+	}
+	if got := tree.Descendants(travel); len(got) != 0 {
+		t.Fatalf("travel descendants = %v", got)
+	}
+}
+
+func TestTreeToleratesCycles(t *testing.T) {
+	a, b := int64(1), int64(2)
+	tree := NewTree([]Category{{ID: a, ParentID: &b}, {ID: b, ParentID: &a}})
+
+	if got := tree.Ancestors(a); !slices.Equal(got, []int64{a, b}) {
+		t.Fatalf("ancestors = %v", got)
+	}
+	if got := tree.Descendants(a); !slices.Equal(got, []int64{b}) {
+		t.Fatalf("descendants = %v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package categorization
+
+import (
+	"regexp"
+	"strings"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Matcher evaluates a fixed list of rules. Build one per request or import
+// so the rules are read and compiled once.
+type Matcher struct {
+	rules        []compiledRule
+	baseCurrency string
+}
+
+type compiledRule struct {
+	Rule
+	contains string
+	pattern  *regexp.Regexp
+}
+
+// NewMatcher compiles rules, which must already be in priority order. Their
+// amount bounds are in baseCurrency.
+func NewMatcher(rules []Rule, baseCurrency string) (*Matcher, error) {
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, r := range rules {
+		c := compiledRule{Rule: r}
+		if r.DescriptionContains != nil {
+			c.contains = strings.ToLower(*r.DescriptionContains)
+		}
+		if r.DescriptionPattern != nil {
+			pattern, err := regexp.Compile(*r.DescriptionPattern)
+			if err != nil {
+				return nil, ErrInvalidPattern
+			}
+			c.pattern = pattern
+		}
+		compiled = append(compiled, c)
+	}
+
+	return &Matcher{rules: compiled, baseCurrency: baseCurrency}, nil
+}
+
+// Match returns the first rule whose conditions all hold. amountCents is in
+// minor units of currency, where empty is the base currency; a rule with
+// amount bounds never matches a transaction in another currency.
+func (m *Matcher) Match(description *string, amountCents int64, currency string, accountID *int64) (Rule, bool) {
+	inBase := currency == "" || currency == m.baseCurrency
+	for _, r := range m.rules {
+		if r.matches(description, amountCents, inBase, accountID) {
+			return r.Rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// Categorize sets in.CategoryID from the first matching rule and reports
+// whether it did. Transactions that already have a category or split lines,
+// and transfer legs, are left alone.
+func (m *Matcher) Categorize(in *transactions.CreateInput) bool {
+	if in.CategoryID != nil || len(in.Splits) > 0 || in.TransferID != nil {
+		return false
+	}
+
+	rule, ok := m.Match(in.Description, in.AmountCents, in.Currency, in.AccountID)
+	if !ok {
+		return false
+	}
+	categoryID := rule.CategoryID
+	in.CategoryID = &categoryID
+	return true
+}
+
+func (r compiledRule) matches(description *string, amountCents int64, inBase bool, accountID *int64) bool {
+	if r.DescriptionContains != nil {
+		if description == nil || !strings.Contains(strings.ToLower(*description), r.contains) {
+			return false
+		}
+	}
+	if r.pattern != nil {
+		if description == nil || !r.pattern.MatchString(*description) {
+			return false
+		}
+	}
+	if (r.MinAmountCents != nil || r.MaxAmountCents != nil) && !inBase {
+		return false
+	}
+	if r.MinAmountCents != nil && amountCents < *r.MinAmountCents {
+		return false
+	}
+	if r.MaxAmountCents != nil && amountCents > *r.MaxAmountCents {
+		return false
+	}
+	if r.AccountID != nil && (accountID == nil || *accountID != *r.AccountID) {
+		return false
+	}
+	return true
+}
+
+func validate(in CreateInput) error {
+	if in.DescriptionContains == nil && in.DescriptionPattern == nil &&
+		in.MinAmountCents == nil && in.MaxAmountCents == nil && in.AccountID == nil {
+		return ErrNoConditions
+	}
+	if in.DescriptionPattern != nil {
+		if _, err := regexp.Compile(*in.DescriptionPattern); err != nil {
+			return ErrInvalidPattern
+		}
+	}
+	if in.MinAmountCents != nil && in.MaxAmountCents != nil && *in.MinAmountCents > *in.MaxAmountCents {
+		return ErrInvalidAmountRange
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package categorization
+
+import (
+	"errors"
+	"testing"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMatcherFirstMatchWins(t *testing.T) {
+	m, err := NewMatcher([]Rule{
+		{ID: 1, CategoryID: 10, DescriptionPattern: ptr(`^CARD \d+ BAKERY`)},
+		{ID: 2, CategoryID: 20, DescriptionContains: ptr("bakery"), MaxAmountCents: ptr(int64(-1))},
+		{ID: 3, CategoryID: 30, AccountID: ptr(int64(7)), MinAmountCents: ptr(int64(100000))},
+	}, "EUR")
+	if err != nil {
+		t.Fatalf("new matcher: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		description *string
+		amountCents int64
+		currency    string
+		accountID   *int64
+		wantRule    int64
+	}{
+		{"pattern", ptr("CARD 4411 BAKERY PARIS"), -450, "EUR", nil, 1},
+		{"contains is case-insensitive", ptr("The Corner Bakery"), -450, "", nil, 2},
+		{"amount bound", ptr("Bakery refund"), 450, "EUR", nil, 0},
+		{"account and amount", ptr("salary"), 250000, "EUR", ptr(int64(7)), 3},
+		{"amount bound in another currency", ptr("salary"), 250000, "JPY", ptr(int64(7)), 0},
+		{"pattern in another currency", ptr("CARD 4411 BAKERY PARIS"), -450, "JPY", nil, 1},
+		{"other account", ptr("salary"), 250000, "EUR", ptr(int64(8)), 0},
+		{"no description", nil, -450, "EUR", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, ok := m.Match(tt.description, tt.amountCents, tt.currency, tt.accountID)
+			if tt.wantRule == 0 {
+				if ok {
+					t.Fatalf("matched rule %d, want none", rule.ID)
+				}
+				return
+			}
+			if !ok || rule.ID != tt.wantRule {
+				t.Fatalf("matched rule %d (%v), want %d", rule.ID, ok, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestCategorizeKeepsExistingCategory(t *testing.T) {
+	m, err := NewMatcher([]Rule{{ID: 1, CategoryID: 10, DescriptionContains: ptr("rent")}}, "EUR")
+	if err != nil {
+		t.Fatalf("new matcher: %v", err)
+	}
+
+	in := transactions.CreateInput{Description: ptr("rent"), CategoryID: ptr(int64(5))}
+	if m.Categorize(&in) || *in.CategoryID != 5 {
+		t.Fatalf("category = %d, want 5 kept", *in.CategoryID)
+	}
+
+	in = transactions.CreateInput{Description: ptr("rent"), Splits: []transactions.Split{{CategoryID: 5}}}
+	if m.Categorize(&in) || in.CategoryID != nil {
+		t.Fatal("split transaction was categorized")
+	}
+
+	in = transactions.CreateInput{Description: ptr("rent")}
+	if !m.Categorize(&in) || *in.CategoryID != 10 {
+		t.Fatal("uncategorized transaction was not categorized")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validate(CreateInput{CategoryID: 1}); !errors.Is(err, ErrNoConditions) {
+		t.Fatalf("err = %v, want ErrNoConditions", err)
+	}
+	if err := validate(CreateInput{CategoryID: 1, DescriptionPattern: ptr("(")}); !errors.Is(err, ErrInvalidPattern) {
+		t.Fatalf("err = %v, want ErrInvalidPattern", err)
+	}
+	if err := validate(CreateInput{CategoryID: 1, MinAmountCents: ptr(int64(5)), MaxAmountCents: ptr(int64(1))}); !errors.Is(err, ErrInvalidAmountRange) {
+		t.Fatalf("err = %v, want ErrInvalidAmountRange", err)
+	}
+}
@@ -0,0 +1,67 @@
+package categorization
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoConditions       = errors.New("a rule needs at least one condition")
+	ErrInvalidPattern     = errors.New("description_pattern is not a valid regular expression")
+	ErrInvalidAmountRange = errors.New("min_amount_cents must not be greater than max_amount_cents")
+)
+
+// Rule assigns CategoryID to an uncategorized transaction that meets every
+// condition it sets. DescriptionContains is matched case-insensitively,
+// DescriptionPattern is a Go regular expression, and the amount bounds are
+// inclusive and signed, so spending rules use negative amounts. The bounds
+// are in minor units of the base currency and only hold for transactions in
+// it. Rules are tried by ascending Priority, then by ID; the first match wins.
+type Rule struct {
+	ID                  int64
+	Priority            int
+	CategoryID          int64
+	DescriptionContains *string
+	DescriptionPattern  *string
+	MinAmountCents      *int64
+	MaxAmountCents      *int64
+	AccountID           *int64
+	CreatedAt           time.Time
+}
+
+type CreateInput struct {
+	Priority            int
+	CategoryID          int64
+	DescriptionContains *string
+	DescriptionPattern  *string
+	MinAmountCents      *int64
+	MaxAmountCents      *int64
+	AccountID           *int64
+}
+
+// UpdateInput replaces every field of the rule.
+type UpdateInput struct {
+	Priority            int
+	CategoryID          int64
+	DescriptionContains *string
+	DescriptionPattern  *string
+	MinAmountCents      *int64
+	MaxAmountCents      *int64
+	AccountID           *int64
+}
+
+// Change is an uncategorized transaction that a rule assigns a category to.
+type Change struct {
+	TransactionID int64
+	Description   *string
+	AmountCents   int64
+	RuleID        int64
+	CategoryID    int64
+}
+
+// ApplyResult lists the transactions categorized by a run over existing rows.
+// In dry-run mode nothing is written.
+type ApplyResult struct {
+	DryRun  bool
+	Changes []Change
+}
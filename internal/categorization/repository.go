@@ -0,0 +1,192 @@
+package categorization
+
+import (
+	"context"
+	"database/sql"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Repository stores categorization rules, whose amount bounds are in the base
+// currency.
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// ruleColumns reads rules with the base currency in placeholder baseParam.
+func ruleColumns(baseParam int) string {
+	scale := transactions.ScaleOfParam(baseParam)
+	return `id, priority, category_id, description_contains, description_pattern, (min_amount * ` + scale + `)::bigint, (max_amount * ` + scale + `)::bigint, account_id, created_at`
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRule(row rowScanner) (Rule, error) {
+	var r Rule
+	var minAmount, maxAmount, accountID sql.NullInt64
+	err := row.Scan(
+		&r.ID,
+		&r.Priority,
+		&r.CategoryID,
+		&r.DescriptionContains,
+		&r.DescriptionPattern,
+		&minAmount,
+		&maxAmount,
+		&accountID,
+		&r.CreatedAt,
+	)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	if minAmount.Valid {
+		r.MinAmountCents = &minAmount.Int64
+	}
+	if maxAmount.Valid {
+		r.MaxAmountCents = &maxAmount.Int64
+	}
+	if accountID.Valid {
+		r.AccountID = &accountID.Int64
+	}
+
+	return r, nil
+}
+
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Rule, error) {
+	query := `
+		INSERT INTO categorization_rules (priority, category_id, description_contains, description_pattern, min_amount, max_amount, account_id)
+		VALUES ($1, $2, $3, $4, $5::numeric / ` + transactions.ScaleOfParam(8) + `, $6::numeric / ` + transactions.ScaleOfParam(8) + `, $7)
+		RETURNING ` + ruleColumns(8)
+
+	return scanRule(r.db.QueryRowContext(
+		ctx,
+		query,
+		in.Priority,
+		in.CategoryID,
+		in.DescriptionContains,
+		in.DescriptionPattern,
+		in.MinAmountCents,
+		in.MaxAmountCents,
+		in.AccountID,
+		r.baseCurrency,
+	))
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(2) + `
+		FROM categorization_rules
+		WHERE id = $1
+	`
+
+	return scanRule(r.db.QueryRowContext(ctx, query, id, r.baseCurrency))
+}
+
+// List returns every rule in the order they are tried.
+func (r *Repository) List(ctx context.Context) ([]Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(1) + `
+		FROM categorization_rules
+		ORDER BY priority ASC, id ASC
+	`
+
+	return r.list(ctx, query)
+}
+
+// ListApplicable is List without the rules pointing at archived or trashed
+// categories, which can no longer be assigned.
+func (r *Repository) ListApplicable(ctx context.Context) ([]Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(1) + `
+		FROM categorization_rules
+		WHERE category_id IN (SELECT id FROM categories WHERE archived_at IS NULL AND deleted_at IS NULL)
+		ORDER BY priority ASC, id ASC
+	`
+
+	return r.list(ctx, query)
+}
+
+// list runs a query reading rules with the base currency in $1.
+func (r *Repository) list(ctx context.Context, query string) ([]Rule, error) {
+	rows, err := r.db.QueryContext(ctx, query, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]Rule, 0)
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Rule, error) {
+	query := `
+		UPDATE categorization_rules
+		SET priority = $1,
+			category_id = $2,
+			description_contains = $3,
+			description_pattern = $4,
+			min_amount = $5::numeric / ` + transactions.ScaleOfParam(9) + `,
+			max_amount = $6::numeric / ` + transactions.ScaleOfParam(9) + `,
+			account_id = $7
+		WHERE id = $8
+		RETURNING ` + ruleColumns(9)
+
+	return scanRule(r.db.QueryRowContext(
+		ctx,
+		query,
+		in.Priority,
+		in.CategoryID,
+		in.DescriptionContains,
+		in.DescriptionPattern,
+		in.MinAmountCents,
+		in.MaxAmountCents,
+		in.AccountID,
+		id,
+		r.baseCurrency,
+	))
+}
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM categorization_rules WHERE id = $1`
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
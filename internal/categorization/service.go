@@ -0,0 +1,118 @@
+package categorization
+
+import (
+	"context"
+	"database/sql"
+
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service manages categorization rules and applies them to new and existing
+// transactions.
+type Service struct {
+	db           *sql.DB
+	repo         *Repository
+	transactions *transactions.Repository
+}
+
+func NewService(db *sql.DB, repo *Repository, transactions *transactions.Repository) *Service {
+	return &Service{db: db, repo: repo, transactions: transactions}
+}
+
+func (s *Service) Create(ctx context.Context, in CreateInput) (Rule, error) {
+	if err := validate(in); err != nil {
+		return Rule{}, err
+	}
+
+	return s.repo.Create(ctx, in)
+}
+
+func (s *Service) Get(ctx context.Context, id int64) (Rule, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context) ([]Rule, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *Service) Update(ctx context.Context, id int64, in UpdateInput) (Rule, error) {
+	if err := validate(CreateInput(in)); err != nil {
+		return Rule{}, err
+	}
+
+	return s.repo.Update(ctx, id, in)
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Categorize fills in.CategoryID from the rules when the transaction has no
+// category; see Matcher.Categorize.
+func (s *Service) Categorize(ctx context.Context, in *transactions.CreateInput) error {
+	if in.CategoryID != nil || len(in.Splits) > 0 || in.TransferID != nil {
+		return nil
+	}
+
+	rules, err := s.repo.ListApplicable(ctx)
+	if err != nil {
+		return err
+	}
+	matcher, err := NewMatcher(rules, s.transactions.BaseCurrency())
+	if err != nil {
+		return err
+	}
+
+	matcher.Categorize(in)
+	return nil
+}
+
+// Apply runs the rules over the existing uncategorized transactions. With
+// dryRun it only reports what would change.
+func (s *Service) Apply(ctx context.Context, dryRun bool) (ApplyResult, error) {
+	result := ApplyResult{DryRun: dryRun, Changes: make([]Change, 0)}
+
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		rules, err := s.repo.WithTx(tx).ListApplicable(ctx)
+		if err != nil {
+			return err
+		}
+		matcher, err := NewMatcher(rules, s.transactions.BaseCurrency())
+		if err != nil {
+			return err
+		}
+
+		txRepo := s.transactions.WithTx(tx)
+		candidates, err := txRepo.ListUncategorized(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range candidates {
+			rule, ok := matcher.Match(t.Description, t.AmountCents, t.Currency, t.AccountID)
+			if !ok {
+				continue
+			}
+			if !dryRun {
+				if err := txRepo.SetCategory(ctx, t.ID, rule.CategoryID); err != nil {
+					return err
+				}
+			}
+			result.Changes = append(result.Changes, Change{
+				TransactionID: t.ID,
+				Description:   t.Description,
+				AmountCents:   t.AmountCents,
+				RuleID:        rule.ID,
+				CategoryID:    rule.CategoryID,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	return result, nil
+}
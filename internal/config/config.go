@@ -3,20 +3,31 @@ package config
 import (
 	"errors"
 	"os"
+	"regexp"
 	"time"
 )
 
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
 type Config struct {
-	DatabaseURL   string
-	HTTPAddr      string
-	HealthTimeout time.Duration
+	DatabaseURL       string
+	HTTPAddr          string
+	HealthTimeout     time.Duration
+	RecurringInterval time.Duration
+	BaseCurrency      string
+	TrashRetention    time.Duration
+	PurgeInterval     time.Duration
 }
 
 func Load() (Config, error) {
 	cfg := Config{
-		DatabaseURL:   os.Getenv("DATABASE_URL"),
-		HTTPAddr:      ":8080",
-		HealthTimeout: 2 * time.Second,
+		DatabaseURL:       os.Getenv("DATABASE_URL"),
+		HTTPAddr:          ":8080",
+		HealthTimeout:     2 * time.Second,
+		RecurringInterval: time.Hour,
+		BaseCurrency:      "EUR",
+		TrashRetention:    30 * 24 * time.Hour,
+		PurgeInterval:     time.Hour,
 	}
 
 	if cfg.DatabaseURL == "" {
@@ -36,6 +47,42 @@ func Load() (Config, error) {
 		}
 		cfg.HealthTimeout = parsed
 	}
+	if interval := os.Getenv("RECURRING_INTERVAL"); interval != "" {
+		parsed, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, err
+		}
+		if parsed <= 0 {
+			return Config{}, errors.New("RECURRING_INTERVAL must be positive")
+		}
+		cfg.RecurringInterval = parsed
+	}
+	if currency := os.Getenv("BASE_CURRENCY"); currency != "" {
+		if !currencyCode.MatchString(currency) {
+			return Config{}, errors.New("BASE_CURRENCY must be a three-letter ISO 4217 code")
+		}
+		cfg.BaseCurrency = currency
+	}
+	if retention := os.Getenv("TRASH_RETENTION"); retention != "" {
+		parsed, err := time.ParseDuration(retention)
+		if err != nil {
+			return Config{}, err
+		}
+		if parsed < 0 {
+			return Config{}, errors.New("TRASH_RETENTION must not be negative")
+		}
+		cfg.TrashRetention = parsed
+	}
+	if interval := os.Getenv("PURGE_INTERVAL"); interval != "" {
+		parsed, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, err
+		}
+		if parsed <= 0 {
+			return Config{}, errors.New("PURGE_INTERVAL must be positive")
+		}
+		cfg.PurgeInterval = parsed
+	}
 
 	return cfg, nil
 }
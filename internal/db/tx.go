@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"zankowitch.com/go-db-app/internal/logging"
+)
+
+// DBTX is the subset of *sql.DB and *sql.Tx used by repositories, so the same
+// repository code can run standalone or inside a caller-owned transaction.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// InTx runs fn inside a database transaction, committing when fn returns nil
+// and rolling back otherwise. The request ID in ctx, if any, is set for the
+// transaction so that the audit_log triggers record it.
+func InTx(ctx context.Context, conn *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := setRequestID(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Atomic runs fn on conn when it already is a transaction, and inside a new
+// one otherwise, through InTx. Repositories use it for writes that may run
+// standalone.
+func Atomic(ctx context.Context, conn DBTX, fn func(tx DBTX) error) error {
+	standalone, ok := conn.(*sql.DB)
+	if !ok {
+		return fn(conn)
+	}
+
+	return InTx(ctx, standalone, func(tx *sql.Tx) error {
+		return fn(tx)
+	})
+}
+
+func setRequestID(ctx context.Context, tx *sql.Tx) error {
+	id, ok := logging.RequestIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `SELECT set_config('megabudget.request_id', $1, true)`, id)
+	return err
+}
@@ -0,0 +1,68 @@
+package duplicates
+
+import (
+	"errors"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+const (
+	// DefaultDays is the date window used when the caller does not set one.
+	DefaultDays = 3
+	// MaxDays bounds the date window so the self-join stays cheap.
+	MaxDays = 31
+)
+
+var (
+	ErrInvalidDays     = errors.New("days must be between 0 and 31")
+	ErrInvalidRange    = errors.New("from_date must not be after to_date")
+	ErrInvalidAction   = errors.New("action must be merge or dismiss")
+	ErrTooFew          = errors.New("at least two distinct transaction_ids are required")
+	ErrKeepRequired    = errors.New("keep_id is required to merge")
+	ErrKeepNotInGroup  = errors.New("keep_id must be one of transaction_ids")
+	ErrTransferLeg     = errors.New("transfer legs cannot be merged")
+	ErrNotFound        = errors.New("transaction not found")
+	ErrAmountsMismatch = errors.New("only transactions with the same amount and currency can be merged")
+)
+
+// Action is what Resolve does with a group of transactions.
+type Action string
+
+const (
+	// ActionMerge keeps one transaction and deletes the others.
+	ActionMerge Action = "merge"
+	// ActionDismiss records that the transactions are not duplicates, so they
+	// are not suggested together again.
+	ActionDismiss Action = "dismiss"
+)
+
+// Query narrows the search. Days is the largest distance between the dates
+// of two duplicates; FromDate and ToDate bound the dates of the transactions
+// considered.
+type Query struct {
+	Days     int
+	FromDate *time.Time
+	ToDate   *time.Time
+}
+
+// Group is a set of transactions that are likely the same payment, oldest
+// first. Every transaction is linked to at least one other in the group by
+// the same amount, nearby dates and a similar description.
+type Group struct {
+	Transactions []transactions.Transaction
+}
+
+type ResolveInput struct {
+	Action         Action
+	TransactionIDs []int64
+	KeepID         *int64
+}
+
+// ResolveResult reports what Resolve did. Kept is set for merges, after the
+// details missing on it were filled from the deleted transactions.
+type ResolveResult struct {
+	Kept           *transactions.Transaction
+	DeletedIDs     []int64
+	DismissedPairs int
+}
@@ -0,0 +1,98 @@
+package duplicates
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+type Repository struct {
+	db db.DBTX
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx}
+}
+
+// Pair is two transactions with the same amount and currency and nearby
+// dates; A < B.
+type Pair struct {
+	A, B         int64
+	DescriptionA *string
+	DescriptionB *string
+}
+
+// CandidatePairs returns the pairs of transactions with the same amount and
+// currency whose dates are at most days apart. Transfer legs, occurrences of the same
+// recurring rule, transactions on different accounts and dismissed pairs are
+// left out.
+func (r *Repository) CandidatePairs(ctx context.Context, days int, from, to *time.Time) ([]Pair, error) {
+	const query = `
+		SELECT a.id, b.id, a.description, b.description
+		FROM transactions a
+		JOIN transactions b
+			ON b.amount = a.amount
+			AND b.currency = a.currency
+			AND b.id > a.id
+			AND b.transaction_date BETWEEN a.transaction_date - $1::int AND a.transaction_date + $1::int
+		WHERE a.transfer_id IS NULL
+			AND b.transfer_id IS NULL
+			AND a.deleted_at IS NULL
+			AND b.deleted_at IS NULL
+			AND (a.account_id IS NULL OR b.account_id IS NULL OR a.account_id = b.account_id)
+			AND (a.recurring_rule_id IS NULL OR b.recurring_rule_id IS NULL OR a.recurring_rule_id <> b.recurring_rule_id)
+			AND ($2::date IS NULL OR (a.transaction_date >= $2 AND b.transaction_date >= $2))
+			AND ($3::date IS NULL OR (a.transaction_date <= $3 AND b.transaction_date <= $3))
+			AND NOT EXISTS (
+				SELECT 1
+				FROM dismissed_duplicates d
+				WHERE d.transaction_id = a.id AND d.other_id = b.id
+			)
+		ORDER BY a.id ASC, b.id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, days, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pairs := make([]Pair, 0)
+	for rows.Next() {
+		var p Pair
+		if err := rows.Scan(&p.A, &p.B, &p.DescriptionA, &p.DescriptionB); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// Dismiss remembers that two transactions are not duplicates. Dismissing a
+// pair twice is a no-op.
+func (r *Repository) Dismiss(ctx context.Context, a, b int64) error {
+	if a > b {
+		a, b = b, a
+	}
+
+	const query = `
+		INSERT INTO dismissed_duplicates (transaction_id, other_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(ctx, query, a, b)
+	return err
+}
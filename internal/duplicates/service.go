@@ -0,0 +1,194 @@
+package duplicates
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service finds likely duplicate transactions and merges or dismisses them.
+type Service struct {
+	db           *sql.DB
+	repo         *Repository
+	transactions *transactions.Repository
+}
+
+func NewService(db *sql.DB, repo *Repository, transactions *transactions.Repository) *Service {
+	return &Service{db: db, repo: repo, transactions: transactions}
+}
+
+// Find returns the groups of likely duplicates, ordered by their oldest
+// transaction id.
+func (s *Service) Find(ctx context.Context, q Query) ([]Group, error) {
+	if q.Days < 0 || q.Days > MaxDays {
+		return nil, ErrInvalidDays
+	}
+	if q.FromDate != nil && q.ToDate != nil && q.FromDate.After(*q.ToDate) {
+		return nil, ErrInvalidRange
+	}
+
+	pairs, err := s.repo.CandidatePairs(ctx, q.Days, q.FromDate, q.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	idGroups := group(pairs)
+	ids := make([]int64, 0)
+	for _, g := range idGroups {
+		ids = append(ids, g...)
+	}
+	if len(ids) == 0 {
+		return []Group{}, nil
+	}
+
+	list, err := s.transactions.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]transactions.Transaction, len(list))
+	for _, t := range list {
+		byID[t.ID] = t
+	}
+
+	groups := make([]Group, 0, len(idGroups))
+	for _, g := range idGroups {
+		members := make([]transactions.Transaction, 0, len(g))
+		for _, id := range g {
+			if t, ok := byID[id]; ok {
+				members = append(members, t)
+			}
+		}
+		if len(members) > 1 {
+			groups = append(groups, Group{Transactions: members})
+		}
+	}
+
+	return groups, nil
+}
+
+// Resolve merges or dismisses a group of transactions in one database
+// transaction. A merge keeps KeepID, fills the details it lacks from the
+// others in id order, and deletes them. A dismiss remembers every pair in
+// the group so it is not suggested again.
+func (s *Service) Resolve(ctx context.Context, in ResolveInput) (ResolveResult, error) {
+	ids := distinct(in.TransactionIDs)
+	if len(ids) < 2 {
+		return ResolveResult{}, ErrTooFew
+	}
+
+	switch in.Action {
+	case ActionMerge:
+		if in.KeepID == nil {
+			return ResolveResult{}, ErrKeepRequired
+		}
+		if !contains(ids, *in.KeepID) {
+			return ResolveResult{}, ErrKeepNotInGroup
+		}
+	case ActionDismiss:
+	default:
+		return ResolveResult{}, ErrInvalidAction
+	}
+
+	result := ResolveResult{DeletedIDs: make([]int64, 0)}
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		txRepo := s.transactions.WithTx(tx)
+		locked := make([]transactions.Transaction, 0, len(ids))
+		for _, id := range ids {
+			t, err := txRepo.GetForUpdate(ctx, id)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return ErrNotFound
+				}
+				return err
+			}
+			locked = append(locked, t)
+		}
+
+		if in.Action == ActionDismiss {
+			repo := s.repo.WithTx(tx)
+			for i := range ids {
+				for j := i + 1; j < len(ids); j++ {
+					if err := repo.Dismiss(ctx, ids[i], ids[j]); err != nil {
+						return err
+					}
+					result.DismissedPairs++
+				}
+			}
+			return nil
+		}
+
+		var others []transactions.Transaction
+		for _, t := range locked {
+			if t.TransferID != nil {
+				return ErrTransferLeg
+			}
+			if t.AmountCents != locked[0].AmountCents || t.Currency != locked[0].Currency {
+				return ErrAmountsMismatch
+			}
+			if t.ID != *in.KeepID {
+				others = append(others, t)
+			}
+		}
+
+		var kept transactions.Transaction
+		for _, t := range locked {
+			if t.ID == *in.KeepID {
+				kept = t
+			}
+		}
+		for _, t := range others {
+			// Trashed rows stay in the external id index, so the row whose
+			// external id the kept one takes over has to give it up first.
+			if kept.ExternalID == nil && t.ExternalID != nil {
+				if err := txRepo.ClearExternalID(ctx, t.ID); err != nil {
+					return err
+				}
+			}
+			if err := txRepo.Delete(ctx, t.ID); err != nil {
+				return err
+			}
+			result.DeletedIDs = append(result.DeletedIDs, t.ID)
+
+			var err error
+			kept, err = txRepo.FillMissing(ctx, kept.ID, t)
+			if err != nil {
+				return err
+			}
+		}
+		result.Kept = &kept
+		return nil
+	})
+	if err != nil {
+		return ResolveResult{}, err
+	}
+
+	return result, nil
+}
+
+func distinct(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	// Rows are locked in id order so concurrent resolves cannot deadlock.
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
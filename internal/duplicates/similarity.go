@@ -0,0 +1,110 @@
+package duplicates
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minSimilarity is the smallest share of common words for two descriptions
+// to count as the same payment.
+const minSimilarity = 0.5
+
+// similar reports whether two descriptions likely name the same payment. The
+// descriptions are compared as sets of lower-cased words, ignoring numbers
+// such as card or reference numbers, by the Jaccard index. A missing or empty
+// description matches anything, since bank imports and manual entries often
+// leave it out.
+func similar(a, b *string) bool {
+	wa, wb := words(a), words(b)
+	if len(wa) == 0 || len(wb) == 0 {
+		return true
+	}
+
+	common := 0
+	for w := range wa {
+		if _, ok := wb[w]; ok {
+			common++
+		}
+	}
+
+	union := len(wa) + len(wb) - common
+	return float64(common)/float64(union) >= minSimilarity
+}
+
+func words(s *string) map[string]struct{} {
+	set := make(map[string]struct{})
+	if s == nil {
+		return set
+	}
+
+	fields := strings.FieldsFunc(strings.ToLower(*s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, f := range fields {
+		if strings.IndexFunc(f, unicode.IsLetter) < 0 {
+			continue
+		}
+		set[f] = struct{}{}
+	}
+	return set
+}
+
+// group joins the similar pairs into connected groups of transaction ids,
+// each sorted ascending, ordered by their smallest id.
+func group(pairs []Pair) [][]int64 {
+	parent := make(map[int64]int64)
+	var find func(int64) int64
+	find = func(id int64) int64 {
+		p, ok := parent[id]
+		if !ok {
+			parent[id] = id
+			return id
+		}
+		if p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+
+	order := make([]int64, 0)
+	for _, p := range pairs {
+		if !similar(p.DescriptionA, p.DescriptionB) {
+			continue
+		}
+		for _, id := range []int64{p.A, p.B} {
+			if _, ok := parent[id]; !ok {
+				order = append(order, id)
+			}
+		}
+		ra, rb := find(p.A), find(p.B)
+		if ra == rb {
+			continue
+		}
+		if ra < rb {
+			parent[rb] = ra
+		} else {
+			parent[ra] = rb
+		}
+	}
+
+	index := make(map[int64]int)
+	groups := make([][]int64, 0)
+	for _, id := range order {
+		root := find(id)
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], id)
+	}
+	for _, g := range groups {
+		sort.Slice(g, func(i, j int) bool { return g[i] < g[j] })
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0] < groups[j][0] })
+	return groups
+}
@@ -0,0 +1,54 @@
+package duplicates
+
+import (
+	"reflect"
+	"testing"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestSimilar(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *string
+		want bool
+	}{
+		{"same words any case", ptr("Corner Bakery"), ptr("CORNER BAKERY"), true},
+		{"reference numbers ignored", ptr("CARD 4411 Corner Bakery"), ptr("Corner Bakery 0921"), true},
+		{"half the words shared", ptr("corner bakery paris"), ptr("corner bakery"), true},
+		{"different payee", ptr("Corner Bakery"), ptr("Fuel Station"), false},
+		{"one word in common", ptr("Corner Bakery Paris"), ptr("Corner Shop Lyon"), false},
+		{"missing description", nil, ptr("Corner Bakery"), true},
+		{"numbers only", ptr("123456"), ptr("Corner Bakery"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := similar(tt.a, tt.b); got != tt.want {
+				t.Fatalf("similar(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupJoinsConnectedPairs(t *testing.T) {
+	pairs := []Pair{
+		{A: 5, B: 9, DescriptionA: ptr("rent"), DescriptionB: ptr("Rent March")},
+		{A: 1, B: 9, DescriptionA: ptr("rent march"), DescriptionB: ptr("Rent March")},
+		{A: 2, B: 3, DescriptionA: ptr("coffee"), DescriptionB: nil},
+		{A: 4, B: 6, DescriptionA: ptr("coffee"), DescriptionB: ptr("fuel")},
+	}
+
+	got := group(pairs)
+	want := [][]int64{{1, 5, 9}, {2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("group = %v, want %v", got, want)
+	}
+}
+
+func TestGroupNoPairs(t *testing.T) {
+	if got := group(nil); len(got) != 0 {
+		t.Fatalf("group(nil) = %v, want none", got)
+	}
+}
@@ -0,0 +1,114 @@
+package envelopes
+
+import "sort"
+
+// monthIndex numbers months consecutively so they can be compared across
+// years.
+func monthIndex(year, month int) int {
+	return year*12 + month - 1
+}
+
+// ledger collects assigned and spent amounts per category and month, and
+// income per month, keyed by monthIndex.
+type ledger struct {
+	assigned map[int64]map[int]int64
+	spent    map[int64]map[int]int64
+	income   map[int]int64
+}
+
+func newLedger() *ledger {
+	return &ledger{
+		assigned: make(map[int64]map[int]int64),
+		spent:    make(map[int64]map[int]int64),
+		income:   make(map[int]int64),
+	}
+}
+
+func (l *ledger) addAssigned(categoryID int64, month int, cents int64) {
+	addTo(l.assigned, categoryID, month, cents)
+}
+
+func (l *ledger) addSpent(categoryID int64, month int, cents int64) {
+	addTo(l.spent, categoryID, month, cents)
+}
+
+func (l *ledger) addIncome(month int, cents int64) {
+	l.income[month] += cents
+}
+
+func addTo(m map[int64]map[int]int64, categoryID int64, month int, cents int64) {
+	if m[categoryID] == nil {
+		m[categoryID] = make(map[int]int64)
+	}
+	m[categoryID][month] += cents
+}
+
+// summarize builds the envelope view of one month. Whatever is left in an
+// envelope at the end of a month, positive or negative, carries into the
+// next one.
+func (l *ledger) summarize(year, month int) MonthSummary {
+	target := monthIndex(year, month)
+	summary := MonthSummary{Year: year, Month: month, Envelopes: make([]Envelope, 0)}
+
+	var totalAssigned int64
+	for _, id := range l.categoriesThrough(target) {
+		env := Envelope{CategoryID: id}
+		for m, cents := range l.assigned[id] {
+			switch {
+			case m < target:
+				env.CarriedOverCents += cents
+			case m == target:
+				env.AssignedCents += cents
+			}
+			if m <= target {
+				totalAssigned += cents
+			}
+		}
+		for m, cents := range l.spent[id] {
+			switch {
+			case m < target:
+				env.CarriedOverCents -= cents
+			case m == target:
+				env.ActivityCents -= cents
+			}
+		}
+		env.AvailableCents = env.CarriedOverCents + env.AssignedCents + env.ActivityCents
+
+		summary.AssignedCents += env.AssignedCents
+		summary.Envelopes = append(summary.Envelopes, env)
+	}
+
+	var totalIncome int64
+	for m, cents := range l.income {
+		if m <= target {
+			totalIncome += cents
+		}
+	}
+	summary.IncomeCents = l.income[target]
+	summary.AvailableToBudgetCents = totalIncome - totalAssigned
+
+	return summary
+}
+
+// categoriesThrough returns, in id order, the categories with an assignment
+// or spending up to and including month target.
+func (l *ledger) categoriesThrough(target int) []int64 {
+	seen := make(map[int64]bool)
+	for _, m := range []map[int64]map[int]int64{l.assigned, l.spent} {
+		for id, months := range m {
+			for month := range months {
+				if month <= target {
+					seen[id] = true
+					break
+				}
+			}
+		}
+	}
+
+	ids := make([]int64, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
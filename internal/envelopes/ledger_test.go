@@ -0,0 +1,59 @@
+package envelopes
+
+import "testing"
+
+func TestLedgerSummarize(t *testing.T) {
+	l := newLedger()
+	// January: earn 3000, assign 1000 to groceries (1) and 500 to fun (2).
+	l.addIncome(monthIndex(2026, 1), 3000)
+	l.addAssigned(1, monthIndex(2026, 1), 1000)
+	l.addAssigned(2, monthIndex(2026, 1), 500)
+	l.addSpent(1, monthIndex(2026, 1), 800)
+	l.addSpent(2, monthIndex(2026, 1), 700)
+	// February: more income, top up groceries, spend on an unbudgeted category (3).
+	l.addIncome(monthIndex(2026, 2), 2000)
+	l.addAssigned(1, monthIndex(2026, 2), 900)
+	l.addSpent(1, monthIndex(2026, 2), 1000)
+	l.addSpent(3, monthIndex(2026, 2), 50)
+	// March data must not leak into February.
+	l.addIncome(monthIndex(2026, 3), 9999)
+	l.addAssigned(1, monthIndex(2026, 3), 9999)
+
+	summary := l.summarize(2026, 2)
+
+	if summary.IncomeCents != 2000 || summary.AssignedCents != 900 {
+		t.Fatalf("income/assigned = %d/%d, want 2000/900", summary.IncomeCents, summary.AssignedCents)
+	}
+	// 5000 earned so far, 2400 assigned so far.
+	if summary.AvailableToBudgetCents != 2600 {
+		t.Fatalf("available to budget = %d, want 2600", summary.AvailableToBudgetCents)
+	}
+	if len(summary.Envelopes) != 3 {
+		t.Fatalf("envelopes = %d, want 3", len(summary.Envelopes))
+	}
+
+	want := []Envelope{
+		{CategoryID: 1, CarriedOverCents: 200, AssignedCents: 900, ActivityCents: -1000, AvailableCents: 100},
+		{CategoryID: 2, CarriedOverCents: -200, AssignedCents: 0, ActivityCents: 0, AvailableCents: -200},
+		{CategoryID: 3, CarriedOverCents: 0, AssignedCents: 0, ActivityCents: -50, AvailableCents: -50},
+	}
+	for i, env := range summary.Envelopes {
+		if env != want[i] {
+			t.Fatalf("envelope %d = %+v, want %+v", i, env, want[i])
+		}
+	}
+}
+
+func TestLedgerAcrossYears(t *testing.T) {
+	l := newLedger()
+	l.addIncome(monthIndex(2025, 12), 1000)
+	l.addAssigned(1, monthIndex(2025, 12), 400)
+
+	summary := l.summarize(2026, 1)
+	if summary.AvailableToBudgetCents != 600 {
+		t.Fatalf("available to budget = %d, want 600", summary.AvailableToBudgetCents)
+	}
+	if len(summary.Envelopes) != 1 || summary.Envelopes[0].CarriedOverCents != 400 {
+		t.Fatalf("envelopes = %+v", summary.Envelopes)
+	}
+}
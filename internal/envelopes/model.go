@@ -0,0 +1,51 @@
+package envelopes
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNegativeAssignment is returned when taking more money out of an envelope
+// than was assigned to it that month.
+var ErrNegativeAssignment = errors.New("cannot unassign more than was assigned to the category this month")
+
+// Assignment is the money put into a category's envelope for one month. Month
+// is always the first day of the month.
+type Assignment struct {
+	CategoryID  int64
+	Month       time.Time
+	AmountCents int64
+}
+
+// AssignInput moves AmountCents from "available to budget" into the category's
+// envelope for the month; a negative amount moves it back.
+type AssignInput struct {
+	CategoryID  int64
+	Year        int
+	Month       int
+	AmountCents int64
+}
+
+// Envelope is the state of one category in a month. CarriedOverCents is what
+// was left (or overspent) at the end of the previous month, ActivityCents is
+// the month's spending as a negative amount, and AvailableCents is the sum of
+// the three.
+type Envelope struct {
+	CategoryID       int64
+	CarriedOverCents int64
+	AssignedCents    int64
+	ActivityCents    int64
+	AvailableCents   int64
+}
+
+// MonthSummary is the envelope view of one month. AvailableToBudgetCents is
+// all income up to the end of the month minus everything assigned up to it;
+// it is negative when more was assigned than earned.
+type MonthSummary struct {
+	Year                   int
+	Month                  int
+	IncomeCents            int64
+	AssignedCents          int64
+	AvailableToBudgetCents int64
+	Envelopes              []Envelope
+}
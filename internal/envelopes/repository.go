@@ -0,0 +1,105 @@
+package envelopes
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Repository stores envelope assignments, whose amounts are in the base
+// currency.
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// assignmentColumns reads assignments with the base currency in placeholder
+// baseParam.
+func assignmentColumns(baseParam int) string {
+	return `category_id, month, (amount * ` + transactions.ScaleOfParam(baseParam) + `)::bigint`
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAssignment(row rowScanner) (Assignment, error) {
+	var a Assignment
+	err := row.Scan(
+		&a.CategoryID,
+		&a.Month,
+		&a.AmountCents,
+	)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	return a, nil
+}
+
+// Add adds amountCents to the category's assignment for month, creating it
+// when needed. It returns ErrNegativeAssignment when the result would drop
+// below zero.
+func (r *Repository) Add(ctx context.Context, categoryID int64, month time.Time, amountCents int64) (Assignment, error) {
+	query := `
+		INSERT INTO envelope_assignments (category_id, month, amount)
+		VALUES ($1, $2, $3::numeric / ` + transactions.ScaleOfParam(4) + `)
+		ON CONFLICT (category_id, month) DO UPDATE
+		SET amount = envelope_assignments.amount + EXCLUDED.amount,
+			updated_at = now()
+		RETURNING ` + assignmentColumns(4)
+
+	a, err := scanAssignment(r.db.QueryRowContext(ctx, query, categoryID, month, amountCents, r.baseCurrency))
+	if err != nil {
+		if db.IsCheckViolation(err) {
+			return Assignment{}, ErrNegativeAssignment
+		}
+		return Assignment{}, err
+	}
+
+	return a, nil
+}
+
+// ListThrough returns every assignment up to and including month.
+func (r *Repository) ListThrough(ctx context.Context, month time.Time) ([]Assignment, error) {
+	query := `
+		SELECT ` + assignmentColumns(2) + `
+		FROM envelope_assignments
+		WHERE month <= $1
+		ORDER BY month ASC, category_id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, month, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]Assignment, 0)
+	for rows.Next() {
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
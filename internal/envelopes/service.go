@@ -0,0 +1,83 @@
+package envelopes
+
+import (
+	"context"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service derives the envelope view from assignments and the transaction
+// analytics. Spending comes from ListMonthlySpendingByCategory and income from
+// ListMonthlyIncomeByCategory, accumulated over every year with activity.
+type Service struct {
+	repo         *Repository
+	transactions *transactions.Repository
+}
+
+func NewService(repo *Repository, transactions *transactions.Repository) *Service {
+	return &Service{repo: repo, transactions: transactions}
+}
+
+// Assign moves money between "available to budget" and a category's envelope
+// and returns the updated month. The month is computed first, so nothing is
+// assigned when it cannot be, for example when an exchange rate is missing.
+func (s *Service) Assign(ctx context.Context, in AssignInput) (MonthSummary, error) {
+	if _, err := s.Month(ctx, in.Year, in.Month); err != nil {
+		return MonthSummary{}, err
+	}
+	if _, err := s.repo.Add(ctx, in.CategoryID, firstOfMonth(in.Year, in.Month), in.AmountCents); err != nil {
+		return MonthSummary{}, err
+	}
+
+	return s.Month(ctx, in.Year, in.Month)
+}
+
+// Month returns the envelope view of the given month.
+func (s *Service) Month(ctx context.Context, year, month int) (MonthSummary, error) {
+	assignments, err := s.repo.ListThrough(ctx, firstOfMonth(year, month))
+	if err != nil {
+		return MonthSummary{}, err
+	}
+
+	l := newLedger()
+	startYear := year
+	for _, a := range assignments {
+		l.addAssigned(a.CategoryID, monthIndex(a.Month.Year(), int(a.Month.Month())), a.AmountCents)
+		if a.Month.Year() < startYear {
+			startYear = a.Month.Year()
+		}
+	}
+
+	firstYear, err := s.transactions.FirstYear(ctx)
+	if err != nil {
+		return MonthSummary{}, err
+	}
+	if firstYear != nil && *firstYear < startYear {
+		startYear = *firstYear
+	}
+
+	for y := startYear; y <= year; y++ {
+		spending, err := s.transactions.ListMonthlySpendingByCategory(ctx, y, nil)
+		if err != nil {
+			return MonthSummary{}, err
+		}
+		for _, row := range spending {
+			l.addSpent(row.CategoryID, monthIndex(y, row.Month), row.AmountCents)
+		}
+
+		income, err := s.transactions.ListMonthlyIncomeByCategory(ctx, y, nil)
+		if err != nil {
+			return MonthSummary{}, err
+		}
+		for _, row := range income {
+			l.addIncome(monthIndex(y, row.Month), row.AmountCents)
+		}
+	}
+
+	return l.summarize(year, month), nil
+}
+
+func firstOfMonth(year, month int) time.Time {
+	return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+}
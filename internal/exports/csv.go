@@ -0,0 +1,85 @@
+package exports
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+var csvHeader = []string{
+	"id",
+	"transaction_date",
+	"amount",
+	"amount_cents",
+	"account_id",
+	"category_id",
+	"category_name",
+	"description",
+	"external_id",
+	"created_at",
+	"currency",
+	"currency_exponent",
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.w.Write(csvHeader)
+}
+
+func (c *csvWriter) Write(row transactions.ExportRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	return c.w.Write([]string{
+		strconv.FormatInt(row.ID, 10),
+		row.TransactionDate.Format("2006-01-02"),
+		formatMinor(row.AmountCents, row.CurrencyExponent),
+		strconv.FormatInt(row.AmountCents, 10),
+		int64Value(row.AccountID),
+		int64Value(row.CategoryID),
+		stringValue(row.CategoryName),
+		stringValue(row.Description),
+		stringValue(row.ExternalID),
+		row.CreatedAt.UTC().Format(time.RFC3339),
+		row.Currency,
+		strconv.Itoa(row.CurrencyExponent),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func int64Value(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
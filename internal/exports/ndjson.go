@@ -0,0 +1,54 @@
+package exports
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// ndjsonRow mirrors the Transaction API schema plus the category name.
+type ndjsonRow struct {
+	ID               int64     `json:"id"`
+	TransactionDate  string    `json:"transaction_date"`
+	AmountCents      int64     `json:"amount_cents"`
+	Currency         string    `json:"currency"`
+	CurrencyExponent int       `json:"currency_exponent"`
+	AccountID        *int64    `json:"account_id"`
+	CategoryID       *int64    `json:"category_id"`
+	CategoryName     *string   `json:"category_name"`
+	Description      *string   `json:"description"`
+	ExternalID       *string   `json:"external_id"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &ndjsonWriter{enc: enc}
+}
+
+func (n *ndjsonWriter) Write(row transactions.ExportRow) error {
+	return n.enc.Encode(ndjsonRow{
+		ID:               row.ID,
+		TransactionDate:  row.TransactionDate.Format("2006-01-02"),
+		AmountCents:      row.AmountCents,
+		Currency:         row.Currency,
+		CurrencyExponent: row.CurrencyExponent,
+		AccountID:        row.AccountID,
+		CategoryID:       row.CategoryID,
+		CategoryName:     row.CategoryName,
+		Description:      row.Description,
+		ExternalID:       row.ExternalID,
+		CreatedAt:        row.CreatedAt.UTC(),
+	})
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
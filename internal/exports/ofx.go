@@ -0,0 +1,146 @@
+package exports
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+const (
+	ofxDateLayout = "20060102"
+	// ofxCurrency is the currency of a statement without rows.
+	ofxCurrency         = "EUR"
+	ofxCurrencyExponent = 2
+	// ofxNameMaxLen is the NAME length limit from the OFX specification.
+	ofxNameMaxLen = 32
+)
+
+// ErrMixedCurrencies is returned by the OFX writer for a row whose currency
+// is not the statement's. Callers check the exported currencies beforehand,
+// since the statement is already partly written by then.
+var ErrMixedCurrencies = errors.New("an OFX statement holds a single currency; export transactions in several currencies as CSV or JSON Lines")
+
+// ofxWriter emits an OFX 2.x bank statement. The header is written with the
+// first row so that an open-ended period can start at the oldest exported
+// date and the statement currency, which OFX allows only one of, is the first
+// row's; the ledger balance is the sum of the exported amounts.
+type ofxWriter struct {
+	w           *bufio.Writer
+	period      Period
+	now         func() time.Time
+	wroteHeader bool
+	totalCents  int64
+	// currency and exponent are the statement currency and its decimals.
+	currency string
+	exponent int
+}
+
+func newOFXWriter(w io.Writer, period Period) *ofxWriter {
+	return &ofxWriter{w: bufio.NewWriter(w), period: period, now: time.Now}
+}
+
+func (o *ofxWriter) writeHeader(firstDate time.Time, currency string, exponent int) error {
+	if o.wroteHeader {
+		return nil
+	}
+	o.wroteHeader = true
+
+	now := o.now().UTC()
+	start := o.period.From
+	if start.IsZero() {
+		start = firstDate
+	}
+	if start.IsZero() {
+		start = now
+	}
+	end := o.period.To
+	if end.IsZero() {
+		end = now
+	}
+	if currency == "" {
+		currency = ofxCurrency
+		exponent = ofxCurrencyExponent
+	}
+	o.currency = currency
+	o.exponent = exponent
+
+	_, err := fmt.Fprintf(o.w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS><DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1><STMTTRNRS><TRNUID>0</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<STMTRS><CURDEF>%s</CURDEF>
+<BANKACCTFROM><BANKID>MEGABUDGET</BANKID><ACCTID>ALL</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>
+<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>
+`, now.Format("20060102150405"), currency, start.Format(ofxDateLayout), end.Format(ofxDateLayout))
+	return err
+}
+
+func (o *ofxWriter) Write(row transactions.ExportRow) error {
+	if err := o.writeHeader(row.TransactionDate, row.Currency, row.CurrencyExponent); err != nil {
+		return err
+	}
+	if row.Currency != o.currency {
+		return fmt.Errorf("%w: %s after %s", ErrMixedCurrencies, row.Currency, o.currency)
+	}
+	o.totalCents += row.AmountCents
+
+	trnType := "CREDIT"
+	if row.AmountCents < 0 {
+		trnType = "DEBIT"
+	}
+	fitID := "MB" + strconv.FormatInt(row.ID, 10)
+	if row.ExternalID != nil && *row.ExternalID != "" {
+		fitID = *row.ExternalID
+	}
+
+	fmt.Fprintf(o.w, "<STMTTRN><TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%s</TRNAMT><FITID>",
+		trnType, row.TransactionDate.Format(ofxDateLayout), formatMinor(row.AmountCents, row.CurrencyExponent))
+	o.escape(fitID)
+	o.w.WriteString("</FITID>")
+	if row.Description != nil && *row.Description != "" {
+		o.w.WriteString("<NAME>")
+		o.escape(truncateRunes(*row.Description, ofxNameMaxLen))
+		o.w.WriteString("</NAME>")
+	}
+	if row.CategoryName != nil {
+		o.w.WriteString("<MEMO>")
+		o.escape(*row.CategoryName)
+		o.w.WriteString("</MEMO>")
+	}
+	_, err := o.w.WriteString("</STMTTRN>\n")
+	return err
+}
+
+func (o *ofxWriter) Close() error {
+	if err := o.writeHeader(time.Time{}, "", 0); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.w, `</BANKTRANLIST>
+<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`, formatMinor(o.totalCents, o.exponent), o.now().UTC().Format(ofxDateLayout))
+	return o.w.Flush()
+}
+
+// escape writes s with XML special characters escaped. Errors are left to
+// the buffered writer and surface on the next write or flush.
+func (o *ofxWriter) escape(s string) {
+	_ = xml.EscapeText(o.w, []byte(s))
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:n])
+}
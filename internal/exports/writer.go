@@ -0,0 +1,94 @@
+package exports
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+	FormatOFX    Format = "ofx"
+)
+
+// ContentType returns the media type served for the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatOFX:
+		return "application/x-ofx"
+	default:
+		return "text/csv"
+	}
+}
+
+// FormatFromAccept picks the first supported media type listed in an Accept
+// header. It reports false when none of the listed types is supported so the
+// caller can fall back to its default.
+func FormatFromAccept(accept string) (Format, bool) {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "text/csv":
+			return FormatCSV, true
+		case "application/x-ndjson", "application/jsonl", "application/json":
+			return FormatNDJSON, true
+		case "application/x-ofx", "application/ofx":
+			return FormatOFX, true
+		}
+	}
+	return "", false
+}
+
+// Writer encodes export rows one at a time. Close flushes buffered output and
+// writes any trailer; it must be called once after the last row.
+type Writer interface {
+	Write(row transactions.ExportRow) error
+	Close() error
+}
+
+// Period is the date range covered by an export. Zero values mean the range
+// is open on that side; OFX falls back to the dates of the exported rows.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewWriter returns a Writer encoding rows to w in the given format.
+func NewWriter(format Format, w io.Writer, period Period) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	case FormatOFX:
+		return newOFXWriter(w, period), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// formatMinor renders an amount of minor units with exponent decimals as a
+// plain decimal amount with a dot separator, e.g. -1250 with 2 becomes
+// "-12.50" and 1500 with 0 stays "1500".
+func formatMinor(amount int64, exponent int) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if exponent <= 0 {
+		return sign + strconv.FormatInt(amount, 10)
+	}
+	scale := int64(math.Pow10(exponent))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, exponent, amount%scale)
+}
@@ -0,0 +1,192 @@
+package exports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/imports"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func testRows() []transactions.ExportRow {
+	categoryID := int64(7)
+	categoryName := "Groceries"
+	description := `Bakery "Le Pain" & Co`
+	externalID := "F-1"
+	createdAt := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	return []transactions.ExportRow{
+		{
+			Transaction: transactions.Transaction{
+				ID:               1,
+				TransactionDate:  time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC),
+				CategoryID:       &categoryID,
+				AmountCents:      -1250,
+				Currency:         "USD",
+				CurrencyExponent: 2,
+				Description:      &description,
+				ExternalID:       &externalID,
+				CreatedAt:        createdAt,
+			},
+			CategoryName: &categoryName,
+		},
+		{
+			Transaction: transactions.Transaction{
+				ID:               2,
+				TransactionDate:  time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+				AmountCents:      250000,
+				Currency:         "USD",
+				CurrencyExponent: 2,
+				CreatedAt:        createdAt,
+			},
+		},
+	}
+}
+
+func writeAll(t *testing.T, format Format, period Period, rows []transactions.ExportRow) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf, period)
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return buf.String()
+}
+
+func TestCSVWriter(t *testing.T) {
+	out := writeAll(t, FormatCSV, Period{}, testRows())
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("records = %d, want 3", len(records))
+	}
+	want := []string{"1", "2026-02-03", "-12.50", "-1250", "", "7", "Groceries", `Bakery "Le Pain" & Co`, "F-1", "2026-03-01T09:30:00Z", "USD", "2"}
+	for i, v := range want {
+		if records[1][i] != v {
+			t.Fatalf("column %s = %q, want %q", records[0][i], records[1][i], v)
+		}
+	}
+	if records[2][5] != "" || records[2][6] != "" {
+		t.Fatalf("expected empty category columns, got %v", records[2])
+	}
+}
+
+func TestCSVWriterWritesHeaderWithoutRows(t *testing.T) {
+	out := writeAll(t, FormatCSV, Period{}, nil)
+	if out != strings.Join(csvHeader, ",")+"\n" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	out := writeAll(t, FormatNDJSON, Period{}, testRows())
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("lines = %d, want 2", len(lines))
+	}
+
+	var first ndjsonRow
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if first.AmountCents != -1250 || first.Currency != "USD" || first.CategoryName == nil || *first.CategoryName != "Groceries" {
+		t.Fatalf("unexpected first row: %+v", first)
+	}
+}
+
+func TestOFXWriterRoundTrip(t *testing.T) {
+	period := Period{From: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)}
+	out := writeAll(t, FormatOFX, period, testRows())
+
+	if !strings.Contains(out, "<DTSTART>20260201</DTSTART><DTEND>20260228</DTEND>") {
+		t.Fatalf("missing statement period in %s", out)
+	}
+	if !strings.Contains(out, "<BALAMT>2487.50</BALAMT>") {
+		t.Fatalf("missing ledger balance in %s", out)
+	}
+
+	entries, err := imports.ParseOFX(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("parse exported ofx: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+	if entries[0].Input.AmountCents != -1250 || entries[0].Input.Currency != "USD" || *entries[0].Input.ExternalID != "F-1" {
+		t.Fatalf("unexpected first entry: %+v", entries[0].Input)
+	}
+	if *entries[1].Input.ExternalID != "MB2" {
+		t.Fatalf("second fitid = %q, want MB2", *entries[1].Input.ExternalID)
+	}
+}
+
+func TestOFXWriterRejectsMixedCurrencies(t *testing.T) {
+	rows := testRows()
+	rows[1].Currency = "JPY"
+	rows[1].CurrencyExponent = 0
+
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatOFX, &buf, Period{})
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+	if err := w.Write(rows[0]); err != nil {
+		t.Fatalf("write first row: %v", err)
+	}
+	if err := w.Write(rows[1]); !errors.Is(err, ErrMixedCurrencies) {
+		t.Fatalf("err = %v, want ErrMixedCurrencies", err)
+	}
+}
+
+func TestFormatFromAccept(t *testing.T) {
+	cases := map[string]Format{
+		"application/x-ofx":                    FormatOFX,
+		"text/html, application/x-ndjson;q=.9": FormatNDJSON,
+		"text/csv; charset=utf-8":              FormatCSV,
+	}
+	for accept, want := range cases {
+		got, ok := FormatFromAccept(accept)
+		if !ok || got != want {
+			t.Fatalf("FormatFromAccept(%q) = %q, %v; want %q", accept, got, ok, want)
+		}
+	}
+	if _, ok := FormatFromAccept("*/*"); ok {
+		t.Fatalf("expected */* to be unsupported")
+	}
+}
+
+func TestFormatMinor(t *testing.T) {
+	cases := []struct {
+		amount   int64
+		exponent int
+		want     string
+	}{
+		{amount: -1250, exponent: 2, want: "-12.50"},
+		{amount: 5, exponent: 2, want: "0.05"},
+		{amount: 1500, exponent: 0, want: "1500"},
+		{amount: -1234, exponent: 3, want: "-1.234"},
+	}
+	for _, c := range cases {
+		if got := formatMinor(c.amount, c.exponent); got != c.want {
+			t.Fatalf("formatMinor(%d, %d) = %q, want %q", c.amount, c.exponent, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type accountResponse struct {
+	ID                  int64  `json:"id"`
+	Name                string `json:"name"`
+	Type                string `json:"type"`
+	OpeningBalanceCents int64  `json:"opening_balance_cents"`
+	BalanceCents        int64  `json:"balance_cents"`
+}
+
+func TestAccountsHTTPCRUD(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	name := "Checking-" + time.Now().UTC().Format("150405.000000000")
+	var created accountResponse
+
+	t.Run("create account", func(t *testing.T) {
+		body := []byte(`{"name":"` + name + `","type":"checking","opening_balance_cents":10000}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/accounts", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("status = %d, want 201", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if created.BalanceCents != 10000 {
+			t.Fatalf("balance = %d, want 10000", created.BalanceCents)
+		}
+	})
+
+	t.Run("duplicate name is rejected", func(t *testing.T) {
+		body := []byte(`{"name":"` + name + `","type":"cash"}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/accounts", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("balance includes account transactions", func(t *testing.T) {
+		category := createTestCategory(t, "Accounts-Fuel")
+		createTestAccountTransaction(t, created.ID, category.ID, "2037-01-10", -2500)
+		createTestAccountTransaction(t, created.ID, category.ID, "2037-02-10", 1000)
+		createTestTransaction(t, category.ID, "2037-01-11", -9900, "other account")
+
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/accounts/"+itoa(created.ID), nil)
+		defer resp.Body.Close()
+
+		var got accountResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if got.BalanceCents != 8500 {
+			t.Fatalf("balance = %d, want 8500", got.BalanceCents)
+		}
+	})
+
+	t.Run("transactions and analytics filter by account", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions?from_date=2037-01-01&to_date=2037-12-31&account_id="+itoa(created.ID), nil)
+		defer resp.Body.Close()
+
+		var list transactionListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("decode list: %v", err)
+		}
+		if len(list.Items) != 2 {
+			t.Fatalf("expected 2 transactions, got %d", len(list.Items))
+		}
+		for _, item := range list.Items {
+			if item.AccountID == nil || *item.AccountID != created.ID {
+				t.Fatalf("unexpected account on %+v", item)
+			}
+		}
+
+		savingsResp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/monthly-savings?year=2037&account_id="+itoa(created.ID), nil)
+		defer savingsResp.Body.Close()
+
+		var savings monthlySavingsResponse
+		if err := json.NewDecoder(savingsResp.Body).Decode(&savings); err != nil {
+			t.Fatalf("decode savings: %v", err)
+		}
+		if savings.Total != -1500 {
+			t.Fatalf("savings total = %d, want -1500", savings.Total)
+		}
+	})
+
+	t.Run("delete account with transactions conflicts", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/accounts/"+itoa(created.ID), nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("status = %d, want 409", resp.StatusCode)
+		}
+	})
+}
+
+func TestDeleteAccountWithTrashedTransactions(t *testing.T) {
+	stamp := time.Now().UTC().Format("150405.000000000")
+
+	t.Run("all transactions trashed deletes the account", func(t *testing.T) {
+		account := createTestAccount(t, "Trashed-"+stamp, "cash")
+		tx := createTestTaggedTransaction(t, `{"transaction_date":"2060-01-10","amount_cents":-1200,"account_id":`+itoa(account.ID)+`}`)
+
+		trashResp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(tx.ID), nil)
+		trashResp.Body.Close()
+		if trashResp.StatusCode != http.StatusNoContent {
+			t.Fatalf("trash status = %d, want 204", trashResp.StatusCode)
+		}
+
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/accounts/"+itoa(account.ID), nil)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+
+		restoreResp := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(tx.ID)+"/restore", nil)
+		defer restoreResp.Body.Close()
+		if restoreResp.StatusCode != http.StatusNotFound {
+			t.Fatalf("restore status = %d, want 404", restoreResp.StatusCode)
+		}
+	})
+
+	t.Run("recurring rule is named as the blocker", func(t *testing.T) {
+		account := createTestAccount(t, "Recurring-"+stamp, "checking")
+		body := []byte(`{"frequency":"monthly","start_date":"2060-02-01","end_date":"2060-02-01","amount_cents":-500,"account_id":` + itoa(account.ID) + `}`)
+		ruleResp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		ruleResp.Body.Close()
+		if ruleResp.StatusCode != http.StatusCreated {
+			t.Fatalf("rule status = %d, want 201", ruleResp.StatusCode)
+		}
+
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/accounts/"+itoa(account.ID), nil)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("status = %d, want 409", resp.StatusCode)
+		}
+		var errBody struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&errBody); err != nil {
+			t.Fatalf("decode error: %v", err)
+		}
+		if errBody.Message != "account is still in use: recurring rules book into it" {
+			t.Fatalf("message = %q", errBody.Message)
+		}
+	})
+}
+
+func createTestAccountTransaction(t *testing.T, accountID, categoryID int64, date string, amountCents int64) {
+	t.Helper()
+
+	body := []byte(`{"transaction_date":"` + date + `","amount_cents":` + itoa(amountCents) + `,"account_id":` + itoa(accountID) + `,"category_id":` + itoa(categoryID) + `}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+}
@@ -0,0 +1,178 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/accounts"
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+type AccountsHandler struct {
+	repo   *accounts.Repository
+	logger *zap.Logger
+}
+
+func NewAccountsHandler(repo *accounts.Repository, logger *zap.Logger) *AccountsHandler {
+	return &AccountsHandler{repo: repo, logger: logger}
+}
+
+func (h *AccountsHandler) CreateAccount(ctx context.Context, request api.CreateAccountRequestObject) (api.CreateAccountResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create account: missing request body")
+		return api.CreateAccount400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateAccount400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	var openingBalance int64
+	if request.Body.OpeningBalanceCents != nil {
+		openingBalance = *request.Body.OpeningBalanceCents
+	}
+
+	created, err := h.repo.Create(ctx, accounts.CreateInput{
+		Name:                request.Body.Name,
+		Type:                accounts.Type(request.Body.Type),
+		OpeningBalanceCents: openingBalance,
+	})
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			return api.CreateAccount400JSONResponse{
+				Body:    api.Error{Message: "account name already exists"},
+				Headers: api.CreateAccount400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create account: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create account: created", zap.Int64("account_id", created.ID))
+
+	return api.CreateAccount201JSONResponse{
+		Body:    toAPIAccount(created),
+		Headers: api.CreateAccount201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AccountsHandler) DeleteAccount(ctx context.Context, request api.DeleteAccountRequestObject) (api.DeleteAccountResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.repo.Delete(ctx, request.AccountId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteAccount404JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.DeleteAccount404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, accounts.ErrInUse) {
+			return api.DeleteAccount409JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.DeleteAccount409ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete account: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete account: deleted", zap.Int64("account_id", request.AccountId))
+
+	return api.DeleteAccount204Response{
+		Headers: api.DeleteAccount204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AccountsHandler) GetAccount(ctx context.Context, request api.GetAccountRequestObject) (api.GetAccountResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	account, err := h.repo.Get(ctx, request.AccountId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetAccount404JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.GetAccount404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get account: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetAccount200JSONResponse{
+		Body:    toAPIAccount(account),
+		Headers: api.GetAccount200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AccountsHandler) ListAccounts(ctx context.Context, request api.ListAccountsRequestObject) (api.ListAccountsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.repo.List(ctx)
+	if err != nil {
+		h.logger.Error("list accounts: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.Account, 0, len(list))
+	for _, a := range list {
+		items = append(items, toAPIAccount(a))
+	}
+
+	return api.ListAccounts200JSONResponse{
+		Body:    api.AccountList{Items: items},
+		Headers: api.ListAccounts200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AccountsHandler) UpdateAccount(ctx context.Context, request api.UpdateAccountRequestObject) (api.UpdateAccountResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update account: missing request body")
+		return api.UpdateAccount400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateAccount400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, err := h.repo.Update(ctx, request.AccountId, accounts.UpdateInput{
+		Name:                request.Body.Name,
+		Type:                accounts.Type(request.Body.Type),
+		OpeningBalanceCents: request.Body.OpeningBalanceCents,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateAccount404JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.UpdateAccount404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsUniqueViolation(err) {
+			return api.UpdateAccount400JSONResponse{
+				Body:    api.Error{Message: "account name already exists"},
+				Headers: api.UpdateAccount400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update account: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdateAccount200JSONResponse{
+		Body:    toAPIAccount(updated),
+		Headers: api.UpdateAccount200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func toAPIAccount(a accounts.Account) api.Account {
+	return api.Account{
+		Id:                  a.ID,
+		Name:                a.Name,
+		Type:                api.AccountType(a.Type),
+		OpeningBalanceCents: a.OpeningBalanceCents,
+		BalanceCents:        a.BalanceCents,
+		CreatedAt:           a.CreatedAt,
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 type summaryRow struct {
 	CategoryID int64   `json:"category_id"`
+	ParentID   *int64  `json:"parent_id"`
 	Values     []int64 `json:"values"`
 	Total      int64   `json:"total"`
 	Average    int64   `json:"average"`
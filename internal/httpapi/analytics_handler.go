@@ -2,22 +2,34 @@ package httpapi
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
+	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/zap"
 
 	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/budgets"
+	"zankowitch.com/go-db-app/internal/cashflow"
 	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/payees"
+	"zankowitch.com/go-db-app/internal/tags"
 	"zankowitch.com/go-db-app/internal/transactions"
 )
 
 type AnalyticsHandler struct {
-	txRepo  *transactions.Repository
-	catRepo *categories.Repository
-	logger  *zap.Logger
+	txRepo     *transactions.Repository
+	catRepo    *categories.Repository
+	budgetRepo *budgets.Repository
+	cashFlow   *cashflow.Service
+	tagRepo    *tags.Repository
+	payeeRepo  *payees.Repository
+	logger     *zap.Logger
 }
 
-func NewAnalyticsHandler(txRepo *transactions.Repository, catRepo *categories.Repository, logger *zap.Logger) *AnalyticsHandler {
-	return &AnalyticsHandler{txRepo: txRepo, catRepo: catRepo, logger: logger}
+func NewAnalyticsHandler(txRepo *transactions.Repository, catRepo *categories.Repository, budgetRepo *budgets.Repository, cashFlow *cashflow.Service, tagRepo *tags.Repository, payeeRepo *payees.Repository, logger *zap.Logger) *AnalyticsHandler {
+	return &AnalyticsHandler{txRepo: txRepo, catRepo: catRepo, budgetRepo: budgetRepo, cashFlow: cashFlow, tagRepo: tagRepo, payeeRepo: payeeRepo, logger: logger}
 }
 
 func (h *AnalyticsHandler) GetTransactionsSummary(ctx context.Context, request api.GetTransactionsSummaryRequestObject) (api.GetTransactionsSummaryResponseObject, error) {
@@ -30,20 +42,43 @@ func (h *AnalyticsHandler) GetTransactionsSummary(ctx context.Context, request a
 		}, nil
 	}
 
+	depth := 0
+	if request.Params.Depth != nil {
+		depth = int(*request.Params.Depth)
+		if depth < 1 {
+			return api.GetTransactionsSummary400JSONResponse{
+				Body:    api.Error{Message: "depth must be a positive integer"},
+				Headers: api.GetTransactionsSummary400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+	}
+
 	categoriesList, err := h.catRepo.List(ctx)
 	if err != nil {
 		h.logger.Error("transactions summary: list categories failed", zap.Error(err))
 		return nil, err
 	}
 
-	spendingRows, err := h.txRepo.ListMonthlySpendingByCategory(ctx, year)
+	spendingRows, err := h.txRepo.ListMonthlySpendingByCategory(ctx, year, request.Params.AccountId)
 	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetTransactionsSummary400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetTransactionsSummary400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		h.logger.Error("transactions summary: spending query failed", zap.Error(err))
 		return nil, err
 	}
 
-	incomeRows, err := h.txRepo.ListMonthlyIncomeByCategory(ctx, year)
+	incomeRows, err := h.txRepo.ListMonthlyIncomeByCategory(ctx, year, request.Params.AccountId)
 	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetTransactionsSummary400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetTransactionsSummary400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		h.logger.Error("transactions summary: income query failed", zap.Error(err))
 		return nil, err
 	}
@@ -53,8 +88,8 @@ func (h *AnalyticsHandler) GetTransactionsSummary(ctx context.Context, request a
 		months[i] = int32(i + 1)
 	}
 
-	spending := buildSummarySection(categoriesList, spendingRows)
-	income := buildSummarySection(categoriesList, incomeRows)
+	spending := buildSummarySection(categoriesList, spendingRows, depth)
+	income := buildSummarySection(categoriesList, incomeRows, depth)
 
 	return api.GetTransactionsSummary200JSONResponse{
 		Body: api.TransactionsSummary{
@@ -77,8 +112,14 @@ func (h *AnalyticsHandler) GetMonthlySavings(ctx context.Context, request api.Ge
 		}, nil
 	}
 
-	netRows, err := h.txRepo.ListMonthlyNetTotals(ctx, year)
+	netRows, err := h.txRepo.ListMonthlyNetTotals(ctx, year, request.Params.AccountId)
 	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetMonthlySavings400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetMonthlySavings400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		h.logger.Error("monthly savings: query failed", zap.Error(err))
 		return nil, err
 	}
@@ -113,7 +154,152 @@ func (h *AnalyticsHandler) GetMonthlySavings(ctx context.Context, request api.Ge
 	}, nil
 }
 
-func buildSummarySection(categoriesList []categories.Category, rows []transactions.MonthlyCategoryTotal) api.TransactionsSummarySection {
+func (h *AnalyticsHandler) GetBudgetVsActual(ctx context.Context, request api.GetBudgetVsActualRequestObject) (api.GetBudgetVsActualResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	year := int(request.Params.Year)
+	month := int(request.Params.Month)
+	if year <= 0 || month < 1 || month > 12 {
+		return api.GetBudgetVsActual400JSONResponse{
+			Body:    api.Error{Message: "year must be a positive integer and month between 1 and 12"},
+			Headers: api.GetBudgetVsActual400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	budgetList, err := h.budgetRepo.List(ctx)
+	if err != nil {
+		h.logger.Error("budget vs actual: list budgets failed", zap.Error(err))
+		return nil, err
+	}
+
+	categoriesList, err := h.catRepo.List(ctx)
+	if err != nil {
+		h.logger.Error("budget vs actual: list categories failed", zap.Error(err))
+		return nil, err
+	}
+
+	spendingRows, err := h.txRepo.ListMonthlySpendingByCategory(ctx, year, request.Params.AccountId)
+	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetBudgetVsActual400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetBudgetVsActual400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("budget vs actual: spending query failed", zap.Error(err))
+		return nil, err
+	}
+
+	spent := make(map[int64]int64)
+	for _, row := range spendingRows {
+		if row.Month == month {
+			spent[row.CategoryID] = row.AmountCents
+		}
+	}
+
+	report := budgets.Compare(budgetList, categories.NewTree(categoriesList), spent)
+
+	rows := make([]api.BudgetVsActualRow, 0, len(report.Lines))
+	for _, line := range report.Lines {
+		rows = append(rows, api.BudgetVsActualRow{
+			BudgetId:       line.Budget.ID,
+			CategoryId:     line.Budget.CategoryID,
+			BudgetedCents:  line.Budget.AmountCents,
+			SpentCents:     line.SpentCents,
+			RemainingCents: line.RemainingCents,
+			PercentUsed:    line.PercentUsed,
+		})
+	}
+
+	return api.GetBudgetVsActual200JSONResponse{
+		Body: api.BudgetVsActual{
+			Year:           request.Params.Year,
+			Month:          request.Params.Month,
+			Rows:           rows,
+			BudgetedCents:  report.BudgetedCents,
+			SpentCents:     report.SpentCents,
+			RemainingCents: report.RemainingCents,
+		},
+		Headers: api.GetBudgetVsActual200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AnalyticsHandler) GetCashFlow(ctx context.Context, request api.GetCashFlowRequestObject) (api.GetCashFlowResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+
+	now := time.Now()
+	asOf := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if request.Params.AsOf != nil {
+		asOf = request.Params.AsOf.Time
+	}
+
+	forecast, err := h.cashFlow.Forecast(ctx, cashflow.Query{
+		From:      request.Params.From.Time,
+		To:        request.Params.To.Time,
+		AsOf:      asOf,
+		AccountID: request.Params.AccountId,
+	})
+	if err != nil {
+		if errors.Is(err, cashflow.ErrInvalidRange) || errors.Is(err, cashflow.ErrRangeTooLong) || errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetCashFlow400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetCashFlow400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetCashFlow400JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.GetCashFlow400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("cash flow: forecast failed", zap.Error(err))
+		return nil, err
+	}
+
+	days := make([]api.CashFlowDay, 0, len(forecast.Days))
+	for _, d := range forecast.Days {
+		days = append(days, api.CashFlowDay{
+			Date:           types.Date{Time: d.Date},
+			ActualCents:    d.ActualCents,
+			ProjectedCents: d.ProjectedCents,
+			BalanceCents:   d.BalanceCents,
+		})
+	}
+
+	upcoming := make([]api.ProjectedTransaction, 0, len(forecast.Upcoming))
+	for _, p := range forecast.Upcoming {
+		upcoming = append(upcoming, api.ProjectedTransaction{
+			Date:            types.Date{Time: p.Date},
+			AmountCents:     p.AmountCents,
+			Description:     p.Description,
+			AccountId:       p.AccountID,
+			CategoryId:      p.CategoryID,
+			Source:          api.CashFlowSource(p.Source),
+			RecurringRuleId: p.RecurringRuleID,
+		})
+	}
+
+	return api.GetCashFlow200JSONResponse{
+		Body: api.CashFlow{
+			From:                types.Date{Time: forecast.From},
+			To:                  types.Date{Time: forecast.To},
+			AsOf:                types.Date{Time: forecast.AsOf},
+			OpeningBalanceCents: forecast.OpeningBalanceCents,
+			ClosingBalanceCents: forecast.ClosingBalanceCents,
+			LowestBalanceCents:  forecast.LowestBalanceCents,
+			LowestBalanceDate:   types.Date{Time: forecast.LowestBalanceDate},
+			Days:                days,
+			Upcoming:            upcoming,
+		},
+		Headers: api.GetCashFlow200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// buildSummarySection lays out one section of the summary. With depth 0 every
+// category reports its own amounts. With a positive depth only categories down
+// to that level are returned, each including the amounts of all categories
+// below it, and the section totals are taken from the top-level rows so
+// nothing is counted twice.
+func buildSummarySection(categoriesList []categories.Category, rows []transactions.MonthlyCategoryTotal, depth int) api.TransactionsSummarySection {
 	valuesByCategory := make(map[int64][]int64, len(categoriesList))
 	for _, c := range categoriesList {
 		valuesByCategory[c.ID] = make([]int64, 12)
@@ -130,24 +316,51 @@ func buildSummarySection(categoriesList []categories.Category, rows []transactio
 		values[row.Month-1] = row.AmountCents
 	}
 
+	tree := categories.NewTree(categoriesList)
+	if depth > 0 {
+		rolled := make(map[int64][]int64, len(categoriesList))
+		for _, c := range categoriesList {
+			values := make([]int64, 12)
+			for _, id := range append([]int64{c.ID}, tree.Descendants(c.ID)...) {
+				for i, v := range valuesByCategory[id] {
+					values[i] += v
+				}
+			}
+			rolled[c.ID] = values
+		}
+		valuesByCategory = rolled
+	}
+
 	rowsOut := make([]api.TransactionsSummaryRow, 0, len(categoriesList))
 	columnTotals := make([]int64, 12)
 	var grandTotal int64
 
 	for _, c := range categoriesList {
+		level := tree.Level(c.ID)
+		if depth > 0 && level > depth {
+			continue
+		}
+
 		values := valuesByCategory[c.ID]
 		var total int64
-		for i, v := range values {
+		for _, v := range values {
 			total += v
-			columnTotals[i] += v
 		}
 		average := total / 12
 		rowsOut = append(rowsOut, api.TransactionsSummaryRow{
 			CategoryId: c.ID,
+			ParentId:   c.ParentID,
 			Values:     values,
 			Total:      total,
 			Average:    average,
 		})
+
+		if depth > 0 && level > 1 {
+			continue
+		}
+		for i, v := range values {
+			columnTotals[i] += v
+		}
 		grandTotal += total
 	}
 
@@ -157,3 +370,87 @@ func buildSummarySection(categoriesList []categories.Category, rows []transactio
 		Total:        grandTotal,
 	}
 }
+
+func (h *AnalyticsHandler) GetTagTotals(ctx context.Context, request api.GetTagTotalsRequestObject) (api.GetTagTotalsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	from := datePtrValue(request.Params.FromDate)
+	to := datePtrValue(request.Params.ToDate)
+	if from != nil && to != nil && from.After(*to) {
+		return api.GetTagTotals400JSONResponse{
+			Body:    api.Error{Message: "from_date must not be after to_date"},
+			Headers: api.GetTagTotals400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	totals, err := h.tagRepo.Totals(ctx, from, to)
+	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetTagTotals400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetTagTotals400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("tag totals: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.TagTotal, 0, len(totals))
+	for _, t := range totals {
+		items = append(items, api.TagTotal{
+			TagId:            t.TagID,
+			Name:             t.Name,
+			SpendingCents:    t.SpendingCents,
+			IncomeCents:      t.IncomeCents,
+			TransactionCount: t.TransactionCount,
+		})
+	}
+
+	return api.GetTagTotals200JSONResponse{
+		Body:    api.TagTotalList{Items: items},
+		Headers: api.GetTagTotals200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *AnalyticsHandler) GetTopPayees(ctx context.Context, request api.GetTopPayeesRequestObject) (api.GetTopPayeesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	from := datePtrValue(request.Params.FromDate)
+	to := datePtrValue(request.Params.ToDate)
+	if from != nil && to != nil && from.After(*to) {
+		return api.GetTopPayees400JSONResponse{
+			Body:    api.Error{Message: "from_date must not be after to_date"},
+			Headers: api.GetTopPayees400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	limit := 10
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	top, err := h.payeeRepo.TopPayees(ctx, from, to, limit)
+	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetTopPayees400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetTopPayees400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("top payees: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.TopPayee, 0, len(top))
+	for _, p := range top {
+		items = append(items, api.TopPayee{
+			PayeeId:          p.PayeeID,
+			Name:             p.Name,
+			SpendingCents:    p.SpendingCents,
+			TransactionCount: p.TransactionCount,
+		})
+	}
+
+	return api.GetTopPayees200JSONResponse{
+		Body:    api.TopPayeeList{Items: items},
+		Headers: api.GetTopPayees200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
@@ -0,0 +1,164 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type transactionChangeResponse struct {
+	ID        int64          `json:"id"`
+	Action    string         `json:"action"`
+	RequestID *string        `json:"request_id"`
+	ChangedAt string         `json:"changed_at"`
+	Before    map[string]any `json:"before"`
+	After     map[string]any `json:"after"`
+}
+
+type transactionHistoryResponse struct {
+	Items []transactionChangeResponse `json:"items"`
+}
+
+func TestTransactionHistory(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "Audit-2056")
+	var created transactionResponse
+	var createRequestID string
+
+	t.Run("create is recorded with its request ID", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2056-01-10","amount_cents":-1200,"category_id":` + itoa(category.ID) + `}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("status = %d, want 201", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode transaction: %v", err)
+		}
+		createRequestID = resp.Header.Get("X-Request-ID")
+
+		history := getTestTransactionHistory(t, created.ID)
+		if len(history.Items) != 1 {
+			t.Fatalf("history has %d entries, want 1", len(history.Items))
+		}
+		entry := history.Items[0]
+		if entry.Action != "create" || entry.Before != nil || entry.After == nil {
+			t.Fatalf("unexpected create entry: %+v", entry)
+		}
+		if entry.RequestID == nil || *entry.RequestID != createRequestID {
+			t.Fatalf("request_id = %v, want %q", entry.RequestID, createRequestID)
+		}
+	})
+
+	t.Run("update records the amount before and after", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2056-01-10","amount_cents":-4200,"category_id":` + itoa(category.ID) + `}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.ID), body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("update status = %d, want 200", resp.StatusCode)
+		}
+
+		history := getTestTransactionHistory(t, created.ID)
+		if len(history.Items) != 2 {
+			t.Fatalf("history has %d entries, want 2", len(history.Items))
+		}
+		entry := history.Items[1]
+		if entry.Action != "update" {
+			t.Fatalf("action = %q, want update", entry.Action)
+		}
+		if entry.Before["amount_cents"] != float64(-1200) || entry.After["amount_cents"] != float64(-4200) {
+			t.Fatalf("amount_cents went from %v to %v, want -1200 to -4200", entry.Before["amount_cents"], entry.After["amount_cents"])
+		}
+		if entry.RequestID == nil || *entry.RequestID == createRequestID {
+			t.Fatalf("update request_id = %v, want the update's own", entry.RequestID)
+		}
+	})
+
+	t.Run("delete and restore are recorded", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(created.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete status = %d, want 204", resp.StatusCode)
+		}
+
+		// The history stays available while the transaction is in the trash.
+		history := getTestTransactionHistory(t, created.ID)
+		if len(history.Items) != 3 || history.Items[2].Action != "delete" {
+			t.Fatalf("history after delete: %+v", history.Items)
+		}
+
+		restore := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(created.ID)+"/restore", nil)
+		restore.Body.Close()
+		if restore.StatusCode != http.StatusOK {
+			t.Fatalf("restore status = %d, want 200", restore.StatusCode)
+		}
+
+		history = getTestTransactionHistory(t, created.ID)
+		if len(history.Items) != 4 || history.Items[3].Action != "restore" {
+			t.Fatalf("history after restore: %+v", history.Items)
+		}
+	})
+
+	t.Run("split lines and tags are recorded", func(t *testing.T) {
+		other := createTestCategory(t, "Audit-2056-Other")
+		createTestTag(t, "Audit-Tag-2056")
+		body := []byte(`{"transaction_date":"2056-01-10","amount_cents":-4200,"tags":["Audit-Tag-2056"],` +
+			`"splits":[{"category_id":` + itoa(category.ID) + `,"amount_cents":-3000},{"category_id":` + itoa(other.ID) + `,"amount_cents":-1200}]}`)
+		for range 2 {
+			resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.ID), body)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("update status = %d, want 200", resp.StatusCode)
+			}
+		}
+
+		// Repeating the same update rewrites the lines but changes nothing.
+		history := getTestTransactionHistory(t, created.ID)
+		if len(history.Items) != 5 {
+			t.Fatalf("history has %d entries, want 5", len(history.Items))
+		}
+		entry := history.Items[4]
+		if entry.Action != "update" {
+			t.Fatalf("action = %q, want update", entry.Action)
+		}
+		if before, ok := entry.Before["splits"].([]any); !ok || len(before) != 0 {
+			t.Fatalf("splits before = %v, want none", entry.Before["splits"])
+		}
+		lines, ok := entry.After["splits"].([]any)
+		if !ok || len(lines) != 2 {
+			t.Fatalf("splits after = %v, want 2 lines", entry.After["splits"])
+		}
+		if first := lines[0].(map[string]any); first["amount_cents"] != float64(-3000) {
+			t.Fatalf("first line = %v, want amount_cents -3000", first)
+		}
+		if tags, ok := entry.After["tags"].([]any); !ok || len(tags) != 1 || tags[0] != "Audit-Tag-2056" {
+			t.Fatalf("tags after = %v, want [Audit-Tag-2056]", entry.After["tags"])
+		}
+	})
+
+	t.Run("unknown transaction", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/999999999/history", nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("status = %d, want 404", resp.StatusCode)
+		}
+	})
+}
+
+func getTestTransactionHistory(t *testing.T, id int64) transactionHistoryResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/"+itoa(id)+"/history", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("history status = %d, want 200", resp.StatusCode)
+	}
+	var history transactionHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
+		t.Fatalf("decode history: %v", err)
+	}
+
+	return history
+}
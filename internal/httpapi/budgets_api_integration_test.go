@@ -0,0 +1,192 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type budgetResponse struct {
+	ID          int64 `json:"id"`
+	CategoryID  int64 `json:"category_id"`
+	AmountCents int64 `json:"amount_cents"`
+}
+
+type budgetVsActualRow struct {
+	BudgetID       int64    `json:"budget_id"`
+	CategoryID     int64    `json:"category_id"`
+	BudgetedCents  int64    `json:"budgeted_cents"`
+	SpentCents     int64    `json:"spent_cents"`
+	RemainingCents int64    `json:"remaining_cents"`
+	PercentUsed    *float64 `json:"percent_used"`
+}
+
+type budgetVsActualResponse struct {
+	Year           int32               `json:"year"`
+	Month          int32               `json:"month"`
+	Rows           []budgetVsActualRow `json:"rows"`
+	BudgetedCents  int64               `json:"budgeted_cents"`
+	SpentCents     int64               `json:"spent_cents"`
+	RemainingCents int64               `json:"remaining_cents"`
+}
+
+func TestBudgetsHTTP(t *testing.T) {
+	food := createTestCategory(t, "Budget-Food")
+	groceries := createTestSubcategory(t, "Budget-Groceries", food.ID)
+	fun := createTestCategory(t, "Budget-Fun")
+
+	foodBudget := createTestBudget(t, food.ID, 40000)
+	funBudget := createTestBudget(t, fun.ID, 10000)
+
+	createTestTransaction(t, groceries.ID, "2043-05-03", -25000, "supermarket")
+	createTestTransaction(t, food.ID, "2043-05-10", -5000, "bakery")
+	createTestTransaction(t, fun.ID, "2043-05-12", -12000, "concert")
+	createTestTransaction(t, fun.ID, "2043-06-01", -1000, "next month")
+	createTestTransaction(t, fun.ID, "2043-05-20", 3000, "refund counted as income")
+
+	t.Run("duplicate budget is rejected", func(t *testing.T) {
+		body := []byte(`{"category_id":` + itoa(food.ID) + `,"amount_cents":100}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/budgets", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		body := []byte(`{"amount_cents":12000}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/budgets/"+itoa(funBudget.ID), body)
+		defer resp.Body.Close()
+
+		var updated budgetResponse
+		if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || updated.AmountCents != 12000 {
+			t.Fatalf("status = %d, amount = %d", resp.StatusCode, updated.AmountCents)
+		}
+	})
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/budget-vs-actual?year=2043&month=5", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var report budgetVsActualResponse
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	foodRow, ok := findBudgetRow(report.Rows, foodBudget.ID)
+	if !ok || foodRow.SpentCents != 30000 || foodRow.RemainingCents != 10000 {
+		t.Fatalf("food row: %+v", foodRow)
+	}
+	if foodRow.PercentUsed == nil || *foodRow.PercentUsed != 75 {
+		t.Fatalf("food percent = %v, want 75", foodRow.PercentUsed)
+	}
+
+	funRow, ok := findBudgetRow(report.Rows, funBudget.ID)
+	if !ok || funRow.BudgetedCents != 12000 || funRow.SpentCents != 12000 || funRow.RemainingCents != 0 {
+		t.Fatalf("fun row: %+v", funRow)
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/budgets/"+itoa(funBudget.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+
+		resp = doRequest(t, http.MethodGet, testServer.URL+"/budgets/"+itoa(funBudget.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("status = %d, want 404", resp.StatusCode)
+		}
+	})
+}
+
+func TestBudgetsHideTrashedCategories(t *testing.T) {
+	travel := createTestCategory(t, "Budget-Travel")
+	budget := createTestBudget(t, travel.ID, 50000)
+
+	t.Run("archived category is rejected", func(t *testing.T) {
+		archived := createTestCategory(t, "Budget-Archived")
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(archived.ID)+"/archive", nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("archive status = %d, want 200", resp.StatusCode)
+		}
+
+		body := []byte(`{"category_id":` + itoa(archived.ID) + `,"amount_cents":100}`)
+		resp = doRequest(t, http.MethodPost, testServer.URL+"/budgets", body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	resp := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(travel.ID), nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("trash status = %d, want 204", resp.StatusCode)
+	}
+
+	t.Run("trashed category is rejected", func(t *testing.T) {
+		body := []byte(`{"category_id":` + itoa(travel.ID) + `,"amount_cents":100}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/budgets", body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("budget of a trashed category is gone", func(t *testing.T) {
+		get := doRequest(t, http.MethodGet, testServer.URL+"/budgets/"+itoa(budget.ID), nil)
+		get.Body.Close()
+		if get.StatusCode != http.StatusNotFound {
+			t.Fatalf("get status = %d, want 404", get.StatusCode)
+		}
+
+		update := doRequest(t, http.MethodPut, testServer.URL+"/budgets/"+itoa(budget.ID), []byte(`{"amount_cents":100}`))
+		update.Body.Close()
+		if update.StatusCode != http.StatusNotFound {
+			t.Fatalf("update status = %d, want 404", update.StatusCode)
+		}
+
+		del := doRequest(t, http.MethodDelete, testServer.URL+"/budgets/"+itoa(budget.ID), nil)
+		del.Body.Close()
+		if del.StatusCode != http.StatusNotFound {
+			t.Fatalf("delete status = %d, want 404", del.StatusCode)
+		}
+	})
+}
+
+func createTestBudget(t *testing.T, categoryID, amountCents int64) budgetResponse {
+	t.Helper()
+
+	body := []byte(`{"category_id":` + itoa(categoryID) + `,"amount_cents":` + itoa(amountCents) + `}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/budgets", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created budgetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode budget: %v", err)
+	}
+	return created
+}
+
+func findBudgetRow(rows []budgetVsActualRow, budgetID int64) (budgetVsActualRow, bool) {
+	for _, row := range rows {
+		if row.BudgetID == budgetID {
+			return row, true
+		}
+	}
+	return budgetVsActualRow{}, false
+}
@@ -0,0 +1,173 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/budgets"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type BudgetsHandler struct {
+	repo         *budgets.Repository
+	transactions *transactions.Repository
+	logger       *zap.Logger
+}
+
+func NewBudgetsHandler(repo *budgets.Repository, transactions *transactions.Repository, logger *zap.Logger) *BudgetsHandler {
+	return &BudgetsHandler{repo: repo, transactions: transactions, logger: logger}
+}
+
+func (h *BudgetsHandler) CreateBudget(ctx context.Context, request api.CreateBudgetRequestObject) (api.CreateBudgetResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create budget: missing request body")
+		return api.CreateBudget400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateBudget400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	if err := h.transactions.CheckCategory(ctx, &request.Body.CategoryId); err != nil {
+		if errors.Is(err, transactions.ErrArchivedCategory) || errors.Is(err, transactions.ErrDeletedCategory) {
+			return api.CreateBudget400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.CreateBudget400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create budget: db error", zap.Error(err))
+		return nil, err
+	}
+
+	created, err := h.repo.Create(ctx, budgets.CreateInput{
+		CategoryID:  request.Body.CategoryId,
+		AmountCents: request.Body.AmountCents,
+	})
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			return api.CreateBudget400JSONResponse{
+				Body:    api.Error{Message: "category already has a budget"},
+				Headers: api.CreateBudget400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.CreateBudget400JSONResponse{
+				Body:    api.Error{Message: "category not found"},
+				Headers: api.CreateBudget400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create budget: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create budget: created", zap.Int64("budget_id", created.ID))
+
+	return api.CreateBudget201JSONResponse{
+		Body:    toAPIBudget(created),
+		Headers: api.CreateBudget201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *BudgetsHandler) DeleteBudget(ctx context.Context, request api.DeleteBudgetRequestObject) (api.DeleteBudgetResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.repo.Delete(ctx, request.BudgetId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteBudget404JSONResponse{
+				Body:    api.Error{Message: "budget not found"},
+				Headers: api.DeleteBudget404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete budget: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete budget: deleted", zap.Int64("budget_id", request.BudgetId))
+
+	return api.DeleteBudget204Response{
+		Headers: api.DeleteBudget204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *BudgetsHandler) GetBudget(ctx context.Context, request api.GetBudgetRequestObject) (api.GetBudgetResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	budget, err := h.repo.Get(ctx, request.BudgetId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetBudget404JSONResponse{
+				Body:    api.Error{Message: "budget not found"},
+				Headers: api.GetBudget404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get budget: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetBudget200JSONResponse{
+		Body:    toAPIBudget(budget),
+		Headers: api.GetBudget200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *BudgetsHandler) ListBudgets(ctx context.Context, request api.ListBudgetsRequestObject) (api.ListBudgetsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.repo.List(ctx)
+	if err != nil {
+		h.logger.Error("list budgets: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.Budget, 0, len(list))
+	for _, b := range list {
+		items = append(items, toAPIBudget(b))
+	}
+
+	return api.ListBudgets200JSONResponse{
+		Body:    api.BudgetList{Items: items},
+		Headers: api.ListBudgets200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *BudgetsHandler) UpdateBudget(ctx context.Context, request api.UpdateBudgetRequestObject) (api.UpdateBudgetResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update budget: missing request body")
+		return api.UpdateBudget400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateBudget400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, err := h.repo.Update(ctx, request.BudgetId, budgets.UpdateInput{AmountCents: request.Body.AmountCents})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateBudget404JSONResponse{
+				Body:    api.Error{Message: "budget not found"},
+				Headers: api.UpdateBudget404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update budget: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdateBudget200JSONResponse{
+		Body:    toAPIBudget(updated),
+		Headers: api.UpdateBudget200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func toAPIBudget(b budgets.Budget) api.Budget {
+	return api.Budget{
+		Id:          b.ID,
+		CategoryId:  b.CategoryID,
+		AmountCents: b.AmountCents,
+		CreatedAt:   b.CreatedAt,
+	}
+}
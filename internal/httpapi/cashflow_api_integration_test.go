@@ -0,0 +1,124 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type cashFlowDayResponse struct {
+	Date           string `json:"date"`
+	ActualCents    int64  `json:"actual_cents"`
+	ProjectedCents int64  `json:"projected_cents"`
+	BalanceCents   int64  `json:"balance_cents"`
+}
+
+type projectedTransactionResponse struct {
+	Date            string  `json:"date"`
+	AmountCents     int64   `json:"amount_cents"`
+	Description     *string `json:"description"`
+	Source          string  `json:"source"`
+	RecurringRuleID *int64  `json:"recurring_rule_id"`
+}
+
+type cashFlowResponse struct {
+	OpeningBalanceCents int64                          `json:"opening_balance_cents"`
+	ClosingBalanceCents int64                          `json:"closing_balance_cents"`
+	LowestBalanceCents  int64                          `json:"lowest_balance_cents"`
+	LowestBalanceDate   string                         `json:"lowest_balance_date"`
+	Days                []cashFlowDayResponse          `json:"days"`
+	Upcoming            []projectedTransactionResponse `json:"upcoming"`
+}
+
+func TestCashFlowProjectsPatternsAndRules(t *testing.T) {
+	account := createTestAccount(t, "CashFlow-Checking", "checking")
+	accountID := itoa(account.ID)
+
+	for _, month := range []string{"01", "02", "03"} {
+		createTestDescribedTransaction(t, account.ID, "2046-"+month+"-01", 300000, "Salary")
+		createTestDescribedTransaction(t, account.ID, "2046-"+month+"-05", -200000, "Rent")
+	}
+
+	body := []byte(`{"frequency":"monthly","start_date":"2046-04-03","amount_cents":-450000,"account_id":` + accountID + `,"description":"insurance"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create rule status = %d, want 201", resp.StatusCode)
+	}
+
+	flow := getTestCashFlow(t, "?from=2046-03-01&to=2046-04-30&as_of=2046-03-10&account_id="+accountID)
+	if flow.OpeningBalanceCents != 200000 {
+		t.Fatalf("opening = %d, want 200000", flow.OpeningBalanceCents)
+	}
+	if len(flow.Days) != 61 {
+		t.Fatalf("days = %d, want 61", len(flow.Days))
+	}
+	if day := flow.Days[4]; day.Date != "2046-03-05" || day.ActualCents != -200000 || day.BalanceCents != 300000 {
+		t.Fatalf("2046-03-05 = %+v", day)
+	}
+
+	wantUpcoming := []struct {
+		date   string
+		cents  int64
+		source string
+	}{
+		{"2046-04-01", 300000, "pattern"},
+		{"2046-04-03", -450000, "recurring_rule"},
+		{"2046-04-05", -200000, "pattern"},
+	}
+	if len(flow.Upcoming) != len(wantUpcoming) {
+		t.Fatalf("upcoming = %+v", flow.Upcoming)
+	}
+	for i, w := range wantUpcoming {
+		got := flow.Upcoming[i]
+		if got.Date != w.date || got.AmountCents != w.cents || got.Source != w.source {
+			t.Fatalf("upcoming %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	if flow.LowestBalanceCents != -50000 || flow.LowestBalanceDate != "2046-04-05" {
+		t.Fatalf("lowest = %d on %s, want -50000 on 2046-04-05", flow.LowestBalanceCents, flow.LowestBalanceDate)
+	}
+	if flow.ClosingBalanceCents != -50000 {
+		t.Fatalf("closing = %d, want -50000", flow.ClosingBalanceCents)
+	}
+
+	t.Run("rejects an inverted range", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/cash-flow?from=2046-04-30&to=2046-03-01", nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+}
+
+func createTestDescribedTransaction(t *testing.T, accountID int64, date string, amountCents int64, description string) {
+	t.Helper()
+
+	body := []byte(`{"transaction_date":"` + date + `","amount_cents":` + itoa(amountCents) + `,"account_id":` + itoa(accountID) + `,"description":"` + description + `"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+}
+
+func getTestCashFlow(t *testing.T, query string) cashFlowResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/cash-flow"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var flow cashFlowResponse
+	if err := json.NewDecoder(resp.Body).Decode(&flow); err != nil {
+		t.Fatalf("decode cash flow: %v", err)
+	}
+	return flow
+}
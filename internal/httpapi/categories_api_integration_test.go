@@ -10,6 +10,7 @@ import (
 type categoryResponse struct {
 	ID        int64  `json:"id"`
 	Name      string `json:"name"`
+	ParentID  *int64 `json:"parent_id"`
 	CreatedAt string `json:"created_at"`
 }
 
@@ -9,15 +9,19 @@ import (
 
 	"zankowitch.com/go-db-app/internal/api"
 	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/suggestions"
 )
 
 type CategoriesHandler struct {
-	repo   *categories.Repository
-	logger *zap.Logger
+	repo        *categories.Repository
+	service     *categories.Service
+	suggestions *suggestions.Service
+	logger      *zap.Logger
 }
 
-func NewCategoriesHandler(repo *categories.Repository, logger *zap.Logger) *CategoriesHandler {
-	return &CategoriesHandler{repo: repo, logger: logger}
+func NewCategoriesHandler(repo *categories.Repository, service *categories.Service, suggestions *suggestions.Service, logger *zap.Logger) *CategoriesHandler {
+	return &CategoriesHandler{repo: repo, service: service, suggestions: suggestions, logger: logger}
 }
 
 func (h *CategoriesHandler) CreateCategory(ctx context.Context, request api.CreateCategoryRequestObject) (api.CreateCategoryResponseObject, error) {
@@ -31,8 +35,17 @@ func (h *CategoriesHandler) CreateCategory(ctx context.Context, request api.Crea
 		}, nil
 	}
 
-	created, err := h.repo.Create(ctx, categories.CreateInput{Name: request.Body.Name})
+	created, err := h.repo.Create(ctx, categories.CreateInput{
+		Name:     request.Body.Name,
+		ParentID: request.Body.ParentId,
+	})
 	if err != nil {
+		if errors.Is(err, categories.ErrParentNotFound) || db.IsForeignKeyViolation(err) {
+			return api.CreateCategory400JSONResponse{
+				Body:    api.Error{Message: "parent category not found"},
+				Headers: api.CreateCategory400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		logger.Error("create category: db error", zap.Error(err))
 		return nil, err
 	}
@@ -40,18 +53,14 @@ func (h *CategoriesHandler) CreateCategory(ctx context.Context, request api.Crea
 	logger.Info("create category: created", zap.Int64("category_id", created.ID))
 
 	return api.CreateCategory201JSONResponse{
-		Body: api.Category{
-			Id:        created.ID,
-			Name:      created.Name,
-			CreatedAt: created.CreatedAt,
-		},
+		Body:    toAPICategory(created),
 		Headers: api.CreateCategory201ResponseHeaders{XRequestID: requestID},
 	}, nil
 }
 
 func (h *CategoriesHandler) DeleteCategory(ctx context.Context, request api.DeleteCategoryRequestObject) (api.DeleteCategoryResponseObject, error) {
 	requestID := requestIDFromContext(ctx)
-	err := h.repo.Delete(ctx, request.CategoryId)
+	err := h.repo.Delete(ctx, request.CategoryId, ifMatchVersion(request.Params.IfMatch))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return api.DeleteCategory404JSONResponse{
@@ -59,6 +68,18 @@ func (h *CategoriesHandler) DeleteCategory(ctx context.Context, request api.Dele
 				Headers: api.DeleteCategory404ResponseHeaders{XRequestID: requestID},
 			}, nil
 		}
+		if errors.Is(err, categories.ErrVersionMismatch) {
+			return api.DeleteCategory412JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.DeleteCategory412ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, categories.ErrInUse) {
+			return api.DeleteCategory409JSONResponse{
+				Body:    api.Error{Message: "category has subcategories or split lines"},
+				Headers: api.DeleteCategory409ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		h.logger.Error("delete category: db error", zap.Error(err))
 		return nil, err
 	}
@@ -85,18 +106,19 @@ func (h *CategoriesHandler) GetCategory(ctx context.Context, request api.GetCate
 	}
 
 	return api.GetCategory200JSONResponse{
-		Body: api.Category{
-			Id:        cat.ID,
-			Name:      cat.Name,
-			CreatedAt: cat.CreatedAt,
-		},
-		Headers: api.GetCategory200ResponseHeaders{XRequestID: requestID},
+		Body:    toAPICategory(cat),
+		Headers: api.GetCategory200ResponseHeaders{ETag: etag(cat.Version), XRequestID: requestID},
 	}, nil
 }
 
 func (h *CategoriesHandler) ListCategories(ctx context.Context, request api.ListCategoriesRequestObject) (api.ListCategoriesResponseObject, error) {
 	requestID := requestIDFromContext(ctx)
-	cats, err := h.repo.List(ctx)
+	list := h.repo.ListActive
+	if request.Params.IncludeArchived != nil && *request.Params.IncludeArchived {
+		list = h.repo.List
+	}
+
+	cats, err := list(ctx)
 	if err != nil {
 		h.logger.Error("list categories: db error", zap.Error(err))
 		return nil, err
@@ -104,11 +126,7 @@ func (h *CategoriesHandler) ListCategories(ctx context.Context, request api.List
 
 	items := make([]api.Category, 0, len(cats))
 	for _, c := range cats {
-		items = append(items, api.Category{
-			Id:        c.ID,
-			Name:      c.Name,
-			CreatedAt: c.CreatedAt,
-		})
+		items = append(items, toAPICategory(c))
 	}
 
 	return api.ListCategories200JSONResponse{
@@ -128,7 +146,10 @@ func (h *CategoriesHandler) UpdateCategory(ctx context.Context, request api.Upda
 		}, nil
 	}
 
-	updated, err := h.repo.Update(ctx, request.CategoryId, categories.UpdateInput{Name: request.Body.Name})
+	updated, err := h.repo.Update(ctx, request.CategoryId, ifMatchVersion(request.Params.IfMatch), categories.UpdateInput{
+		Name:     request.Body.Name,
+		ParentID: request.Body.ParentId,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return api.UpdateCategory404JSONResponse{
@@ -136,16 +157,162 @@ func (h *CategoriesHandler) UpdateCategory(ctx context.Context, request api.Upda
 				Headers: api.UpdateCategory404ResponseHeaders{XRequestID: requestID},
 			}, nil
 		}
+		if errors.Is(err, categories.ErrVersionMismatch) {
+			return api.UpdateCategory412JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.UpdateCategory412ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, categories.ErrCycle) {
+			return api.UpdateCategory400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.UpdateCategory400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, categories.ErrParentNotFound) || db.IsForeignKeyViolation(err) {
+			return api.UpdateCategory400JSONResponse{
+				Body:    api.Error{Message: "parent category not found"},
+				Headers: api.UpdateCategory400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		logger.Error("update category: db error", zap.Error(err))
 		return nil, err
 	}
 
 	return api.UpdateCategory200JSONResponse{
-		Body: api.Category{
-			Id:        updated.ID,
-			Name:      updated.Name,
-			CreatedAt: updated.CreatedAt,
+		Body:    toAPICategory(updated),
+		Headers: api.UpdateCategory200ResponseHeaders{ETag: etag(updated.Version), XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategoriesHandler) MergeCategory(ctx context.Context, request api.MergeCategoryRequestObject) (api.MergeCategoryResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("merge category: missing request body")
+		return api.MergeCategory400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.MergeCategory400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	result, err := h.service.Merge(ctx, request.CategoryId, request.Body.TargetCategoryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.MergeCategory404JSONResponse{
+				Body:    api.Error{Message: "category not found"},
+				Headers: api.MergeCategory404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, categories.ErrMergeIntoSelf) || errors.Is(err, categories.ErrTargetNotFound) || errors.Is(err, categories.ErrCycle) {
+			return api.MergeCategory400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.MergeCategory400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("merge category: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.suggestions.Invalidate()
+
+	logger.Info(
+		"merge category: merged",
+		zap.Int64("category_id", request.CategoryId),
+		zap.Int64("target_category_id", result.Target.ID),
+		zap.Int64("transactions_moved", result.TransactionsMoved),
+	)
+
+	return api.MergeCategory200JSONResponse{
+		Body: api.CategoryMergeResult{
+			Target:                   toAPICategory(result.Target),
+			TransactionsMoved:        result.TransactionsMoved,
+			SplitLinesMoved:          result.SplitLinesMoved,
+			SubcategoriesMoved:       result.SubcategoriesMoved,
+			RulesMoved:               result.RulesMoved,
+			RecurringRulesMoved:      result.RecurringRulesMoved,
+			BudgetsMoved:             result.BudgetsMoved,
+			EnvelopeAssignmentsMoved: result.EnvelopeAssignmentsMoved,
 		},
-		Headers: api.UpdateCategory200ResponseHeaders{XRequestID: requestID},
+		Headers: api.MergeCategory200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategoriesHandler) ArchiveCategory(ctx context.Context, request api.ArchiveCategoryRequestObject) (api.ArchiveCategoryResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	archived, err := h.repo.Archive(ctx, request.CategoryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ArchiveCategory404JSONResponse{
+				Body:    api.Error{Message: "category not found"},
+				Headers: api.ArchiveCategory404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("archive category: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("archive category: archived", zap.Int64("category_id", archived.ID))
+
+	return api.ArchiveCategory200JSONResponse{
+		Body:    toAPICategory(archived),
+		Headers: api.ArchiveCategory200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategoriesHandler) UnarchiveCategory(ctx context.Context, request api.UnarchiveCategoryRequestObject) (api.UnarchiveCategoryResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	restored, err := h.repo.Unarchive(ctx, request.CategoryId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UnarchiveCategory404JSONResponse{
+				Body:    api.Error{Message: "category not found"},
+				Headers: api.UnarchiveCategory404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("unarchive category: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("unarchive category: unarchived", zap.Int64("category_id", restored.ID))
+
+	return api.UnarchiveCategory200JSONResponse{
+		Body:    toAPICategory(restored),
+		Headers: api.UnarchiveCategory200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategoriesHandler) SuggestCategories(ctx context.Context, request api.SuggestCategoriesRequestObject) (api.SuggestCategoriesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+
+	limit := 5
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+	}
+
+	list, err := h.suggestions.Suggest(ctx, request.Params.Description, request.Params.AmountCents, limit)
+	if err != nil {
+		h.logger.Error("suggest categories: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.CategorySuggestion, 0, len(list))
+	for _, s := range list {
+		items = append(items, api.CategorySuggestion{CategoryId: s.CategoryID, Probability: s.Probability})
+	}
+
+	return api.SuggestCategories200JSONResponse{
+		Body:    api.CategorySuggestionList{Items: items},
+		Headers: api.SuggestCategories200ResponseHeaders{XRequestID: requestID},
 	}, nil
 }
+
+func toAPICategory(c categories.Category) api.Category {
+	return api.Category{
+		Id:         c.ID,
+		Name:       c.Name,
+		ParentId:   c.ParentID,
+		ArchivedAt: c.ArchivedAt,
+		CreatedAt:  c.CreatedAt,
+	}
+}
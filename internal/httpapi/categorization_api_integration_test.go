@@ -0,0 +1,178 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type categorizationRuleResponse struct {
+	ID         int64 `json:"id"`
+	Priority   int32 `json:"priority"`
+	CategoryID int64 `json:"category_id"`
+}
+
+type categorizationApplyResponse struct {
+	DryRun  bool `json:"dry_run"`
+	Changes []struct {
+		TransactionID int64 `json:"transaction_id"`
+		RuleID        int64 `json:"rule_id"`
+		CategoryID    int64 `json:"category_id"`
+	} `json:"changes"`
+}
+
+func TestCategorizationRules(t *testing.T) {
+	bakery := createTestCategory(t, "Rules-Bakery")
+	coffee := createTestCategory(t, "Rules-Coffee")
+
+	// Created before any rule exists, so it stays uncategorized.
+	before := createUncategorizedTransaction(t, "2047-01-02", -350, "Rules-Zq Bakery Montmartre")
+
+	general := createTestCategorizationRule(t, `{"priority":10,"category_id":`+itoa(bakery.ID)+`,"description_contains":"rules-zq bakery"}`)
+	specific := createTestCategorizationRule(t, `{"priority":1,"category_id":`+itoa(coffee.ID)+`,"description_pattern":"^Rules-Zq Bakery .*Coffee$","max_amount_cents":-1}`)
+	defer deleteTestCategorizationRule(t, general.ID)
+	defer deleteTestCategorizationRule(t, specific.ID)
+
+	t.Run("new transactions are categorized by priority", func(t *testing.T) {
+		created := createUncategorizedTransaction(t, "2047-01-03", -420, "Rules-Zq Bakery and Coffee")
+		if created.CategoryID == nil || *created.CategoryID != coffee.ID {
+			t.Fatalf("category = %v, want %d", created.CategoryID, coffee.ID)
+		}
+
+		created = createUncategorizedTransaction(t, "2047-01-04", -420, "RULES-ZQ BAKERY rue Lepic")
+		if created.CategoryID == nil || *created.CategoryID != bakery.ID {
+			t.Fatalf("category = %v, want %d", created.CategoryID, bakery.ID)
+		}
+	})
+
+	t.Run("imports are categorized", func(t *testing.T) {
+		csvBody := []byte("date,amount,description\n2047-01-05,-2.80,Rules-Zq Bakery Abbesses\n")
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import", "text/csv", csvBody)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if result.Created != 1 {
+			t.Fatalf("import result: %+v", result)
+		}
+
+		imported := getTestTransaction(t, *result.Rows[0].TransactionID)
+		if imported.CategoryID == nil || *imported.CategoryID != bakery.ID {
+			t.Fatalf("category = %v, want %d", imported.CategoryID, bakery.ID)
+		}
+	})
+
+	t.Run("apply previews and then categorizes existing rows", func(t *testing.T) {
+		preview := applyTestCategorizationRules(t, true)
+		if !preview.DryRun || !appliedTo(preview, before.ID, bakery.ID) {
+			t.Fatalf("preview does not categorize %d: %+v", before.ID, preview)
+		}
+		if got := getTestTransaction(t, before.ID); got.CategoryID != nil {
+			t.Fatalf("dry run categorized transaction %d", before.ID)
+		}
+
+		applied := applyTestCategorizationRules(t, false)
+		if applied.DryRun || !appliedTo(applied, before.ID, bakery.ID) {
+			t.Fatalf("apply does not categorize %d: %+v", before.ID, applied)
+		}
+		if got := getTestTransaction(t, before.ID); got.CategoryID == nil || *got.CategoryID != bakery.ID {
+			t.Fatalf("category = %v, want %d", got.CategoryID, bakery.ID)
+		}
+	})
+
+	t.Run("rules need a valid condition", func(t *testing.T) {
+		for _, body := range []string{
+			`{"category_id":` + itoa(bakery.ID) + `}`,
+			`{"category_id":` + itoa(bakery.ID) + `,"description_pattern":"("}`,
+			`{"category_id":` + itoa(bakery.ID) + `,"min_amount_cents":5,"max_amount_cents":1}`,
+		} {
+			resp := doRequest(t, http.MethodPost, testServer.URL+"/categorization-rules", []byte(body))
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%s: status = %d, want 400", body, resp.StatusCode)
+			}
+		}
+	})
+}
+
+func createUncategorizedTransaction(t *testing.T, date string, amountCents int64, description string) transactionResponse {
+	t.Helper()
+
+	body := []byte(`{"transaction_date":"` + date + `","amount_cents":` + itoa(amountCents) + `,"description":"` + description + `"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created transactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode transaction: %v", err)
+	}
+	return created
+}
+
+func createTestCategorizationRule(t *testing.T, body string) categorizationRuleResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/categorization-rules", []byte(body))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var rule categorizationRuleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rule); err != nil {
+		t.Fatalf("decode rule: %v", err)
+	}
+	return rule
+}
+
+func deleteTestCategorizationRule(t *testing.T, id int64) {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodDelete, testServer.URL+"/categorization-rules/"+itoa(id), nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d, want 204", resp.StatusCode)
+	}
+}
+
+func applyTestCategorizationRules(t *testing.T, dryRun bool) categorizationApplyResponse {
+	t.Helper()
+
+	query := ""
+	if dryRun {
+		query = "?dry_run=true"
+	}
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/categorization-rules/apply"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result categorizationApplyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode apply result: %v", err)
+	}
+	return result
+}
+
+func appliedTo(result categorizationApplyResponse, transactionID, categoryID int64) bool {
+	for _, c := range result.Changes {
+		if c.TransactionID == transactionID {
+			return c.CategoryID == categoryID
+		}
+	}
+	return false
+}
@@ -0,0 +1,241 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/categorization"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/suggestions"
+)
+
+type CategorizationHandler struct {
+	service     *categorization.Service
+	suggestions *suggestions.Service
+	logger      *zap.Logger
+}
+
+func NewCategorizationHandler(service *categorization.Service, suggestions *suggestions.Service, logger *zap.Logger) *CategorizationHandler {
+	return &CategorizationHandler{service: service, suggestions: suggestions, logger: logger}
+}
+
+func (h *CategorizationHandler) CreateCategorizationRule(ctx context.Context, request api.CreateCategorizationRuleRequestObject) (api.CreateCategorizationRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create categorization rule: missing request body")
+		return api.CreateCategorizationRule400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateCategorizationRule400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	var priority int
+	if request.Body.Priority != nil {
+		priority = int(*request.Body.Priority)
+	}
+
+	created, err := h.service.Create(ctx, categorization.CreateInput{
+		Priority:            priority,
+		CategoryID:          request.Body.CategoryId,
+		DescriptionContains: request.Body.DescriptionContains,
+		DescriptionPattern:  request.Body.DescriptionPattern,
+		MinAmountCents:      request.Body.MinAmountCents,
+		MaxAmountCents:      request.Body.MaxAmountCents,
+		AccountID:           request.Body.AccountId,
+	})
+	if err != nil {
+		if msg, ok := categorizationValidationMessage(err); ok {
+			return api.CreateCategorizationRule400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.CreateCategorizationRule400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create categorization rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create categorization rule: created", zap.Int64("rule_id", created.ID))
+
+	return api.CreateCategorizationRule201JSONResponse{
+		Body:    toAPICategorizationRule(created),
+		Headers: api.CreateCategorizationRule201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategorizationHandler) DeleteCategorizationRule(ctx context.Context, request api.DeleteCategorizationRuleRequestObject) (api.DeleteCategorizationRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.service.Delete(ctx, request.RuleId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteCategorizationRule404JSONResponse{
+				Body:    api.Error{Message: "categorization rule not found"},
+				Headers: api.DeleteCategorizationRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete categorization rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete categorization rule: deleted", zap.Int64("rule_id", request.RuleId))
+
+	return api.DeleteCategorizationRule204Response{
+		Headers: api.DeleteCategorizationRule204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategorizationHandler) GetCategorizationRule(ctx context.Context, request api.GetCategorizationRuleRequestObject) (api.GetCategorizationRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	rule, err := h.service.Get(ctx, request.RuleId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetCategorizationRule404JSONResponse{
+				Body:    api.Error{Message: "categorization rule not found"},
+				Headers: api.GetCategorizationRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get categorization rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetCategorizationRule200JSONResponse{
+		Body:    toAPICategorizationRule(rule),
+		Headers: api.GetCategorizationRule200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategorizationHandler) ListCategorizationRules(ctx context.Context, request api.ListCategorizationRulesRequestObject) (api.ListCategorizationRulesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error("list categorization rules: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.CategorizationRule, 0, len(list))
+	for _, r := range list {
+		items = append(items, toAPICategorizationRule(r))
+	}
+
+	return api.ListCategorizationRules200JSONResponse{
+		Body:    api.CategorizationRuleList{Items: items},
+		Headers: api.ListCategorizationRules200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategorizationHandler) UpdateCategorizationRule(ctx context.Context, request api.UpdateCategorizationRuleRequestObject) (api.UpdateCategorizationRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update categorization rule: missing request body")
+		return api.UpdateCategorizationRule400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateCategorizationRule400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	var priority int
+	if request.Body.Priority != nil {
+		priority = int(*request.Body.Priority)
+	}
+
+	updated, err := h.service.Update(ctx, request.RuleId, categorization.UpdateInput{
+		Priority:            priority,
+		CategoryID:          request.Body.CategoryId,
+		DescriptionContains: request.Body.DescriptionContains,
+		DescriptionPattern:  request.Body.DescriptionPattern,
+		MinAmountCents:      request.Body.MinAmountCents,
+		MaxAmountCents:      request.Body.MaxAmountCents,
+		AccountID:           request.Body.AccountId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateCategorizationRule404JSONResponse{
+				Body:    api.Error{Message: "categorization rule not found"},
+				Headers: api.UpdateCategorizationRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := categorizationValidationMessage(err); ok {
+			return api.UpdateCategorizationRule400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdateCategorizationRule400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update categorization rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdateCategorizationRule200JSONResponse{
+		Body:    toAPICategorizationRule(updated),
+		Headers: api.UpdateCategorizationRule200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *CategorizationHandler) ApplyCategorizationRules(ctx context.Context, request api.ApplyCategorizationRulesRequestObject) (api.ApplyCategorizationRulesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.service.Apply(ctx, dryRun)
+	if err != nil {
+		logger.Error("apply categorization rules: db error", zap.Error(err))
+		return nil, err
+	}
+	if !dryRun && len(result.Changes) > 0 {
+		h.suggestions.Invalidate()
+	}
+
+	logger.Info(
+		"apply categorization rules: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("changes", len(result.Changes)),
+	)
+
+	changes := make([]api.CategorizationChange, 0, len(result.Changes))
+	for _, c := range result.Changes {
+		changes = append(changes, api.CategorizationChange{
+			TransactionId: c.TransactionID,
+			Description:   c.Description,
+			AmountCents:   c.AmountCents,
+			RuleId:        c.RuleID,
+			CategoryId:    c.CategoryID,
+		})
+	}
+
+	return api.ApplyCategorizationRules200JSONResponse{
+		Body:    api.CategorizationApplyResult{DryRun: result.DryRun, Changes: changes},
+		Headers: api.ApplyCategorizationRules200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// categorizationValidationMessage maps rule errors that are the caller's
+// fault to a 400 message.
+func categorizationValidationMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, categorization.ErrNoConditions),
+		errors.Is(err, categorization.ErrInvalidPattern),
+		errors.Is(err, categorization.ErrInvalidAmountRange):
+		return err.Error(), true
+	case db.IsForeignKeyViolation(err):
+		return "category or account not found", true
+	}
+	return "", false
+}
+
+func toAPICategorizationRule(r categorization.Rule) api.CategorizationRule {
+	return api.CategorizationRule{
+		Id:                  r.ID,
+		Priority:            int32(r.Priority),
+		CategoryId:          r.CategoryID,
+		DescriptionContains: r.DescriptionContains,
+		DescriptionPattern:  r.DescriptionPattern,
+		MinAmountCents:      r.MinAmountCents,
+		MaxAmountCents:      r.MaxAmountCents,
+		AccountId:           r.AccountID,
+		CreatedAt:           r.CreatedAt,
+	}
+}
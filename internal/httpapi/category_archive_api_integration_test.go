@@ -0,0 +1,83 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestArchiveCategory(t *testing.T) {
+	gym := createTestCategory(t, "Archive-Gym")
+	createTestTransaction(t, gym.ID, "2042-01-15", -3000, "membership")
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(gym.ID)+"/archive", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("archive status = %d, want 200", resp.StatusCode)
+	}
+	var archived struct {
+		ArchivedAt *string `json:"archived_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&archived); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if archived.ArchivedAt == nil {
+		t.Fatalf("archived_at should be set")
+	}
+
+	t.Run("hidden from the default list", func(t *testing.T) {
+		if listContainsCategory(t, "", gym.ID) {
+			t.Fatalf("archived category listed by default")
+		}
+		if !listContainsCategory(t, "?include_archived=true", gym.ID) {
+			t.Fatalf("archived category missing with include_archived")
+		}
+	})
+
+	t.Run("rejects new transactions", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2042-02-15","amount_cents":-3000,"category_id":` + itoa(gym.ID) + `}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("history stays in analytics", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2042")
+		row, ok := findSummaryRow(summary.Spending.Rows, gym.ID)
+		if !ok || row.Values[0] != 3000 {
+			t.Fatalf("gym row: %+v", row)
+		}
+	})
+
+	t.Run("unarchive allows transactions again", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(gym.ID)+"/archive", nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unarchive status = %d, want 200", resp.StatusCode)
+		}
+
+		createTestTransaction(t, gym.ID, "2042-03-15", -3000, "membership")
+	})
+}
+
+func listContainsCategory(t *testing.T, query string, id int64) bool {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/categories"+query, nil)
+	defer resp.Body.Close()
+
+	var list categoryListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	for _, c := range list.Items {
+		if c.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCategoryHierarchy(t *testing.T) {
+	food := createTestCategory(t, "Hierarchy-Food")
+	groceries := createTestSubcategory(t, "Hierarchy-Groceries", food.ID)
+	organic := createTestSubcategory(t, "Hierarchy-Organic", groceries.ID)
+	restaurants := createTestSubcategory(t, "Hierarchy-Restaurants", food.ID)
+
+	createTestTransaction(t, food.ID, "2040-01-03", -500, "snack")
+	createTestTransaction(t, groceries.ID, "2040-01-10", -2000, "supermarket")
+	createTestTransaction(t, organic.ID, "2040-01-12", -1000, "market")
+	createTestTransaction(t, restaurants.ID, "2040-02-14", -4000, "dinner")
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		body := []byte(`{"name":"Hierarchy-Food","parent_id":` + itoa(organic.ID) + `}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/categories/"+itoa(food.ID), body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("unknown parent is rejected", func(t *testing.T) {
+		body := []byte(`{"name":"Hierarchy-Orphan","parent_id":999999999}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/categories", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("parent with children cannot be deleted", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(groceries.ID), nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusConflict {
+			t.Fatalf("status = %d, want 409", resp.StatusCode)
+		}
+	})
+
+	t.Run("flat summary keeps own amounts", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2040")
+
+		foodRow, _ := findSummaryRow(summary.Spending.Rows, food.ID)
+		groceriesRow, _ := findSummaryRow(summary.Spending.Rows, groceries.ID)
+		if foodRow.Total != 500 || groceriesRow.Total != 2000 {
+			t.Fatalf("totals = %d/%d, want 500/2000", foodRow.Total, groceriesRow.Total)
+		}
+		if groceriesRow.ParentID == nil || *groceriesRow.ParentID != food.ID {
+			t.Fatalf("groceries parent_id = %v, want %d", groceriesRow.ParentID, food.ID)
+		}
+		if summary.Spending.Total != 7500 {
+			t.Fatalf("spending total = %d, want 7500", summary.Spending.Total)
+		}
+	})
+
+	t.Run("depth 1 rolls everything into the root", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2040&depth=1")
+
+		foodRow, ok := findSummaryRow(summary.Spending.Rows, food.ID)
+		if !ok || foodRow.Values[0] != 3500 || foodRow.Values[1] != 4000 || foodRow.Total != 7500 {
+			t.Fatalf("food row: %+v", foodRow)
+		}
+		if _, ok := findSummaryRow(summary.Spending.Rows, groceries.ID); ok {
+			t.Fatalf("groceries should not be listed at depth 1")
+		}
+		if summary.Spending.Total != 7500 {
+			t.Fatalf("spending total = %d, want 7500", summary.Spending.Total)
+		}
+	})
+
+	t.Run("depth 2 rolls grandchildren into children", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2040&depth=2")
+
+		groceriesRow, ok := findSummaryRow(summary.Spending.Rows, groceries.ID)
+		if !ok || groceriesRow.Total != 3000 {
+			t.Fatalf("groceries row: %+v", groceriesRow)
+		}
+		if _, ok := findSummaryRow(summary.Spending.Rows, organic.ID); ok {
+			t.Fatalf("organic should not be listed at depth 2")
+		}
+		if summary.Spending.Total != 7500 {
+			t.Fatalf("spending total = %d, want 7500", summary.Spending.Total)
+		}
+	})
+}
+
+func createTestSubcategory(t *testing.T, name string, parentID int64) categoryResponse {
+	t.Helper()
+
+	body := []byte(`{"name":"` + name + `","parent_id":` + itoa(parentID) + `}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/categories", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created categoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode category: %v", err)
+	}
+	if created.ParentID == nil || *created.ParentID != parentID {
+		t.Fatalf("parent_id = %v, want %d", created.ParentID, parentID)
+	}
+	return created
+}
+
+func getTestSummary(t *testing.T, query string) summaryResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/transactions-summary"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var summary summaryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		t.Fatalf("decode summary: %v", err)
+	}
+	return summary
+}
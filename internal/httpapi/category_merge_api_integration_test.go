@@ -0,0 +1,129 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type categoryMergeResponse struct {
+	Target             categoryResponse `json:"target"`
+	TransactionsMoved  int64            `json:"transactions_moved"`
+	SplitLinesMoved    int64            `json:"split_lines_moved"`
+	SubcategoriesMoved int64            `json:"subcategories_moved"`
+	BudgetsMoved       int64            `json:"budgets_moved"`
+	EnvelopesMoved     int64            `json:"envelope_assignments_moved"`
+}
+
+func TestMergeCategory(t *testing.T) {
+	source := createTestCategory(t, "Merge-Cafe")
+	target := createTestCategory(t, "Merge-Coffee")
+	other := createTestCategory(t, "Merge-Other")
+	child := createTestSubcategory(t, "Merge-Cafe-Takeaway", source.ID)
+
+	createTestTransaction(t, source.ID, "2041-01-05", -350, "espresso")
+	createTestTransaction(t, source.ID, "2041-01-06", -400, "latte")
+	createTestTransaction(t, other.ID, "2041-01-07", -900, "unrelated")
+	createTestBudget(t, source.ID, 2000)
+	targetBudget := createTestBudget(t, target.ID, 5000)
+	assignTestEnvelope(t, source.ID, 2041, 1, 1500)
+	assignTestEnvelope(t, source.ID, 2041, 2, 700)
+	assignTestEnvelope(t, target.ID, 2041, 1, 1000)
+
+	body := []byte(`{"transaction_date":"2041-01-08","amount_cents":-1000,"splits":[{"category_id":` + itoa(source.ID) + `,"amount_cents":-600},{"category_id":` + itoa(other.ID) + `,"amount_cents":-400}]}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create split status = %d, want 201", resp.StatusCode)
+	}
+
+	t.Run("invalid targets are rejected", func(t *testing.T) {
+		cases := map[string]int64{
+			"self":       source.ID,
+			"descendant": child.ID,
+			"missing":    999999999,
+		}
+		for name, targetID := range cases {
+			body := []byte(`{"target_category_id":` + itoa(targetID) + `}`)
+			resp := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(source.ID)+"/merge", body)
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%s: status = %d, want 400", name, resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("unknown source is not found", func(t *testing.T) {
+		body := []byte(`{"target_category_id":` + itoa(target.ID) + `}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/categories/999999999/merge", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("status = %d, want 404", resp.StatusCode)
+		}
+	})
+
+	body = []byte(`{"target_category_id":` + itoa(target.ID) + `}`)
+	resp = doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(source.ID)+"/merge", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("merge status = %d, want 200", resp.StatusCode)
+	}
+	if resp.Header.Get("X-Request-ID") == "" {
+		t.Fatalf("missing X-Request-ID header")
+	}
+
+	var merged categoryMergeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&merged); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if merged.Target.ID != target.ID {
+		t.Fatalf("target id = %d, want %d", merged.Target.ID, target.ID)
+	}
+	if merged.TransactionsMoved != 2 || merged.SplitLinesMoved != 1 || merged.SubcategoriesMoved != 1 || merged.BudgetsMoved != 1 || merged.EnvelopesMoved != 2 {
+		t.Fatalf("unexpected counts: %+v", merged)
+	}
+
+	resp = doRequest(t, http.MethodGet, testServer.URL+"/budgets/"+itoa(targetBudget.ID), nil)
+	defer resp.Body.Close()
+	var budget budgetResponse
+	if err := json.NewDecoder(resp.Body).Decode(&budget); err != nil {
+		t.Fatalf("decode budget: %v", err)
+	}
+	if budget.AmountCents != 7000 {
+		t.Fatalf("target budget = %d, want both budgets added up to 7000", budget.AmountCents)
+	}
+
+	january := getTestEnvelopeMonth(t, "?year=2041&month=1")
+	if env, ok := findEnvelope(january.Envelopes, target.ID); !ok || env.AssignedCents != 2500 {
+		t.Fatalf("january target envelope = %+v, want 2500 assigned", env)
+	}
+	if _, ok := findEnvelope(january.Envelopes, source.ID); ok {
+		t.Fatalf("merged category still has an envelope")
+	}
+	february := getTestEnvelopeMonth(t, "?year=2041&month=2")
+	if env, ok := findEnvelope(february.Envelopes, target.ID); !ok || env.AssignedCents != 700 {
+		t.Fatalf("february target envelope = %+v, want 700 assigned", env)
+	}
+
+	resp = doRequest(t, http.MethodGet, testServer.URL+"/categories/"+itoa(source.ID), nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("source status = %d, want 404", resp.StatusCode)
+	}
+
+	list := listTransactionsInRange(t, "2041-01-01", "2041-12-31")
+	for _, tx := range list.Items {
+		if tx.CategoryID != nil && *tx.CategoryID == source.ID {
+			t.Fatalf("transaction %d still points at the merged category", tx.ID)
+		}
+	}
+
+	summary := getTestSummary(t, "?year=2041")
+	targetRow, ok := findSummaryRow(summary.Spending.Rows, target.ID)
+	if !ok || targetRow.Total != 1350 {
+		t.Fatalf("target row: %+v", targetRow)
+	}
+}
@@ -0,0 +1,186 @@
+package httpapi_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCurrencyMinorUnits(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "Minor-Units-2054")
+	importTestExchangeRates(t, "csv", "text/csv", "Date,JPY,BHD,\n2054-03-01,160,0.4,\n", false)
+
+	var yen, dinar transactionResponse
+
+	t.Run("amounts are in the currency's minor units", func(t *testing.T) {
+		yen = createTestTaggedTransaction(t, `{"transaction_date":"2054-03-02","amount_cents":-1500,"currency":"JPY","category_id":`+itoa(category.ID)+`}`)
+		if yen.AmountCents != -1500 || yen.CurrencyExponent != 0 {
+			t.Fatalf("yen = %d with exponent %d, want -1500 with 0", yen.AmountCents, yen.CurrencyExponent)
+		}
+		dinar = createTestTaggedTransaction(t, `{"transaction_date":"2054-03-03","amount_cents":-1234,"currency":"BHD","category_id":`+itoa(category.ID)+`}`)
+		if dinar.AmountCents != -1234 || dinar.CurrencyExponent != 3 {
+			t.Fatalf("dinar = %d with exponent %d, want -1234 with 3", dinar.AmountCents, dinar.CurrencyExponent)
+		}
+
+		got := getTestTransaction(t, yen.ID)
+		if got.AmountCents != -1500 || got.CurrencyExponent != 0 {
+			t.Fatalf("stored yen = %d with exponent %d, want -1500 with 0", got.AmountCents, got.CurrencyExponent)
+		}
+	})
+
+	t.Run("summary converts into base minor units", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2054")
+		row, ok := findSummaryRow(summary.Spending.Rows, category.ID)
+		if !ok {
+			t.Fatalf("category %d missing from summary", category.ID)
+		}
+		// 1500 JPY at 160 per euro is 9.375 EUR; 1.234 BHD at 0.4 per euro is 3.085 EUR.
+		if row.Values[2] != 1246 {
+			t.Fatalf("march spending = %d, want 1246", row.Values[2])
+		}
+	})
+
+	t.Run("csv export formats each currency's decimals", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/export?from_date=2054-03-01&to_date=2054-03-31", nil)
+		defer resp.Body.Close()
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("read csv: %v", err)
+		}
+		if len(records) != 3 {
+			t.Fatalf("records = %d, want header and 2 rows", len(records))
+		}
+		if records[1][2] != "-1500" || records[2][2] != "-1.234" {
+			t.Fatalf("amounts = %q, %q, want -1500 and -1.234", records[1][2], records[2][2])
+		}
+	})
+
+	t.Run("ofx export rejects several currencies", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/export?format=ofx&from_date=2054-03-01&to_date=2054-03-31", nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("ofx import parses the statement currency's decimals", func(t *testing.T) {
+		statement := []byte(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>JPY
+<BANKACCTFROM><BANKID>0001<ACCTID>OFX-JPY-2054</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20540410<TRNAMT>-2500<FITID>JPY-1<NAME>RAMEN</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20540411<TRNAMT>-2.50<FITID>JPY-2<NAME>ODD</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`)
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import/ofx", "application/x-ofx", statement)
+		defer resp.Body.Close()
+
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if result.Created != 1 || result.Failed != 1 {
+			t.Fatalf("import = %+v, want 1 created and 1 failed", result)
+		}
+
+		list := listTransactionsInRange(t, "2054-04-01", "2054-04-30")
+		if len(list.Items) != 1 || list.Items[0].AmountCents != -2500 || list.Items[0].Currency != "JPY" {
+			t.Fatalf("imported = %+v, want -2500 JPY", list.Items)
+		}
+	})
+}
+
+func TestBaseCurrencyConversion(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	importTestExchangeRates(t, "csv", "text/csv", "Date,JPY,\n2059-01-02,160,\n", false)
+	account := createTestAccount(t, "Conversion-2059", "checking")
+	payee := createTestPayee(t, `{"name":"Conversion 2059"}`)
+	body := func(date string, amountCents int64, currency string) string {
+		return `{"transaction_date":"` + date + `","amount_cents":` + itoa(amountCents) + `,"currency":"` + currency +
+			`","account_id":` + itoa(account.ID) + `,"payee_id":` + itoa(payee.ID) + `,"tags":["zq-conversion-2059"]}`
+	}
+	createTestTaggedTransaction(t, body("2059-01-05", -1600, "JPY"))
+	createTestTaggedTransaction(t, body("2059-01-06", -500, "EUR"))
+
+	t.Run("account balance", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/accounts/"+itoa(account.ID), nil)
+		defer resp.Body.Close()
+
+		var got accountResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode account: %v", err)
+		}
+		// 1600 JPY at 160 per euro is 10 EUR.
+		if got.BalanceCents != -1500 {
+			t.Fatalf("balance = %d, want -1500", got.BalanceCents)
+		}
+	})
+
+	t.Run("tag totals", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/tags?from_date=2059-01-01&to_date=2059-01-31", nil)
+		defer resp.Body.Close()
+
+		var totals tagTotalListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&totals); err != nil {
+			t.Fatalf("decode totals: %v", err)
+		}
+		for _, item := range totals.Items {
+			if item.Name == "zq-conversion-2059" && (item.SpendingCents != 1500 || item.TransactionCount != 2) {
+				t.Fatalf("total = %+v, want 1500 over 2", item)
+			}
+		}
+	})
+
+	t.Run("top payees", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/top-payees?from_date=2059-01-01&to_date=2059-01-31", nil)
+		defer resp.Body.Close()
+
+		var top topPayeeListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&top); err != nil {
+			t.Fatalf("decode top payees: %v", err)
+		}
+		if len(top.Items) != 1 || top.Items[0].PayeeID != payee.ID || top.Items[0].SpendingCents != 1500 {
+			t.Fatalf("top = %+v, want payee %d with 1500", top.Items, payee.ID)
+		}
+	})
+
+	t.Run("a missing rate is reported", func(t *testing.T) {
+		peso := createTestTaggedTransaction(t, body("2059-01-07", -1000, "CLP"))
+		defer func() {
+			resp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(peso.ID), nil)
+			resp.Body.Close()
+		}()
+
+		for _, path := range []string{"/analytics/tags", "/analytics/top-payees"} {
+			resp := doRequest(t, http.MethodGet, testServer.URL+path+"?from_date=2059-01-01&to_date=2059-01-31", nil)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%s status = %d, want 400", path, resp.StatusCode)
+			}
+		}
+
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/accounts/"+itoa(account.ID), nil)
+		defer resp.Body.Close()
+
+		var got struct {
+			BalanceCents *int64 `json:"balance_cents"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode account: %v", err)
+		}
+		if got.BalanceCents != nil {
+			t.Fatalf("balance = %d, want null", *got.BalanceCents)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type duplicateGroupListResponse struct {
+	Groups []struct {
+		Transactions []transactionResponse `json:"transactions"`
+	} `json:"groups"`
+}
+
+type duplicateResolveResponse struct {
+	Kept           *transactionResponse `json:"kept"`
+	DeletedIDs     []int64              `json:"deleted_ids"`
+	DismissedPairs int                  `json:"dismissed_pairs"`
+}
+
+func TestDuplicateTransactions(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "Duplicates-Dining")
+	manual := createUncategorizedTransaction(t, "2049-03-02", -2350, "Pizzeria Zqnapoli")
+	body := []byte(`{"transaction_date":"2049-03-04","amount_cents":-2350,"category_id":` + itoa(category.ID) + `,"description":"CARD 7781 PIZZERIA ZQNAPOLI"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201", resp.StatusCode)
+	}
+	var imported transactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&imported); err != nil {
+		t.Fatalf("decode transaction: %v", err)
+	}
+	resp.Body.Close()
+
+	// Same amount but a different payee, and the same payee too far apart.
+	createUncategorizedTransaction(t, "2049-03-03", -2350, "Zqfuel station")
+	createUncategorizedTransaction(t, "2049-03-20", -2350, "Pizzeria Zqnapoli")
+
+	const window = "?from_date=2049-03-01&to_date=2049-03-31"
+
+	t.Run("find groups similar transactions", func(t *testing.T) {
+		list := getTestDuplicates(t, window)
+		if len(list.Groups) != 1 {
+			t.Fatalf("groups = %+v, want 1", list.Groups)
+		}
+		members := list.Groups[0].Transactions
+		if len(members) != 2 || members[0].ID != manual.ID || members[1].ID != imported.ID {
+			t.Fatalf("group = %+v, want %d and %d", members, manual.ID, imported.ID)
+		}
+
+		if wide := getTestDuplicates(t, window+"&days=31"); len(wide.Groups) != 1 || len(wide.Groups[0].Transactions) != 3 {
+			t.Fatalf("groups with 31 days = %+v, want one group of 3", wide.Groups)
+		}
+	})
+
+	t.Run("invalid days", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/duplicates?days=90", nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("merge requires keep_id", func(t *testing.T) {
+		body := []byte(`{"action":"merge","transaction_ids":[` + itoa(manual.ID) + `,` + itoa(imported.ID) + `]}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions/duplicates/resolve", body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("merge keeps one and fills missing details", func(t *testing.T) {
+		result := resolveTestDuplicates(t, `{"action":"merge","keep_id":`+itoa(manual.ID)+`,"transaction_ids":[`+itoa(manual.ID)+`,`+itoa(imported.ID)+`]}`)
+		if result.Kept == nil || result.Kept.ID != manual.ID {
+			t.Fatalf("kept = %+v, want %d", result.Kept, manual.ID)
+		}
+		if result.Kept.CategoryID == nil || *result.Kept.CategoryID != category.ID {
+			t.Fatalf("kept category = %v, want %d", result.Kept.CategoryID, category.ID)
+		}
+		if result.Kept.Description == nil || *result.Kept.Description != "Pizzeria Zqnapoli" {
+			t.Fatalf("kept description = %v, want the original", result.Kept.Description)
+		}
+		if len(result.DeletedIDs) != 1 || result.DeletedIDs[0] != imported.ID {
+			t.Fatalf("deleted = %v, want [%d]", result.DeletedIDs, imported.ID)
+		}
+
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/"+itoa(imported.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("get deleted status = %d, want 404", resp.StatusCode)
+		}
+	})
+
+	t.Run("dismissed groups are not suggested again", func(t *testing.T) {
+		list := getTestDuplicates(t, window+"&days=31")
+		if len(list.Groups) != 1 {
+			t.Fatalf("groups = %+v, want 1", list.Groups)
+		}
+		ids := ""
+		for i, tx := range list.Groups[0].Transactions {
+			if i > 0 {
+				ids += ","
+			}
+			ids += itoa(tx.ID)
+		}
+
+		result := resolveTestDuplicates(t, `{"action":"dismiss","transaction_ids":[`+ids+`]}`)
+		if result.DismissedPairs != 1 || result.Kept != nil {
+			t.Fatalf("result = %+v, want 1 dismissed pair", result)
+		}
+
+		if list := getTestDuplicates(t, window+"&days=31"); len(list.Groups) != 0 {
+			t.Fatalf("groups = %+v, want none after dismissing", list.Groups)
+		}
+	})
+
+	t.Run("unknown transaction", func(t *testing.T) {
+		body := []byte(`{"action":"dismiss","transaction_ids":[` + itoa(manual.ID) + `,999999999]}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions/duplicates/resolve", body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("status = %d, want 404", resp.StatusCode)
+		}
+	})
+}
+
+func TestDuplicateMergeTakesOverExternalID(t *testing.T) {
+	manual := createUncategorizedTransaction(t, "2058-05-02", -4100, "Zqbakery")
+	statement := []byte(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR
+<BANKACCTFROM><BANKID>0001<ACCTID>OFX-DUP-2058</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20580503<TRNAMT>-41.00<FITID>DUP-2058-1<NAME>ZQBAKERY</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`)
+	importStatement := func() importResultResponse {
+		t.Helper()
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import/ofx", "application/x-ofx", statement)
+		defer resp.Body.Close()
+
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode import: %v", err)
+		}
+		return result
+	}
+
+	if result := importStatement(); result.Created != 1 {
+		t.Fatalf("import = %+v, want 1 created", result)
+	}
+	list := listTransactionsInRange(t, "2058-05-03", "2058-05-03")
+	if len(list.Items) != 1 || list.Items[0].ExternalID == nil {
+		t.Fatalf("imported = %+v, want one row with an external id", list.Items)
+	}
+	imported := list.Items[0]
+
+	result := resolveTestDuplicates(t, `{"action":"merge","keep_id":`+itoa(manual.ID)+`,"transaction_ids":[`+itoa(manual.ID)+`,`+itoa(imported.ID)+`]}`)
+	if result.Kept == nil || result.Kept.ExternalID == nil || *result.Kept.ExternalID != *imported.ExternalID {
+		t.Fatalf("kept = %+v, want external id %q", result.Kept, *imported.ExternalID)
+	}
+
+	// The kept row now stands for the statement line.
+	if again := importStatement(); again.Created != 0 || again.Skipped != 1 {
+		t.Fatalf("reimport = %+v, want 1 skipped", again)
+	}
+}
+
+func getTestDuplicates(t *testing.T, query string) duplicateGroupListResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/duplicates"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list duplicateGroupListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode duplicates: %v", err)
+	}
+	return list
+}
+
+func resolveTestDuplicates(t *testing.T, body string) duplicateResolveResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions/duplicates/resolve", []byte(body))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result duplicateResolveResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode resolve result: %v", err)
+	}
+	return result
+}
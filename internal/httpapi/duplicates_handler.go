@@ -0,0 +1,136 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/duplicates"
+	"zankowitch.com/go-db-app/internal/suggestions"
+)
+
+type DuplicatesHandler struct {
+	service     *duplicates.Service
+	suggestions *suggestions.Service
+	logger      *zap.Logger
+}
+
+func NewDuplicatesHandler(service *duplicates.Service, suggestions *suggestions.Service, logger *zap.Logger) *DuplicatesHandler {
+	return &DuplicatesHandler{service: service, suggestions: suggestions, logger: logger}
+}
+
+func (h *DuplicatesHandler) FindDuplicateTransactions(ctx context.Context, request api.FindDuplicateTransactionsRequestObject) (api.FindDuplicateTransactionsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+
+	days := duplicates.DefaultDays
+	if request.Params.Days != nil {
+		days = *request.Params.Days
+	}
+
+	groups, err := h.service.Find(ctx, duplicates.Query{
+		Days:     days,
+		FromDate: datePtrValue(request.Params.FromDate),
+		ToDate:   datePtrValue(request.Params.ToDate),
+	})
+	if err != nil {
+		if errors.Is(err, duplicates.ErrInvalidDays) || errors.Is(err, duplicates.ErrInvalidRange) {
+			return api.FindDuplicateTransactions400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.FindDuplicateTransactions400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("find duplicates: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.DuplicateGroup, 0, len(groups))
+	for _, g := range groups {
+		members := make([]api.Transaction, 0, len(g.Transactions))
+		for _, t := range g.Transactions {
+			members = append(members, toAPITransaction(t))
+		}
+		items = append(items, api.DuplicateGroup{Transactions: members})
+	}
+
+	return api.FindDuplicateTransactions200JSONResponse{
+		Body:    api.DuplicateGroupList{Groups: items},
+		Headers: api.FindDuplicateTransactions200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *DuplicatesHandler) ResolveDuplicateTransactions(ctx context.Context, request api.ResolveDuplicateTransactionsRequestObject) (api.ResolveDuplicateTransactionsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("resolve duplicates: missing request body")
+		return api.ResolveDuplicateTransactions400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ResolveDuplicateTransactions400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	result, err := h.service.Resolve(ctx, duplicates.ResolveInput{
+		Action:         duplicates.Action(request.Body.Action),
+		TransactionIDs: request.Body.TransactionIds,
+		KeepID:         request.Body.KeepId,
+	})
+	if err != nil {
+		if errors.Is(err, duplicates.ErrNotFound) {
+			return api.ResolveDuplicateTransactions404JSONResponse{
+				Body:    api.Error{Message: "transaction not found"},
+				Headers: api.ResolveDuplicateTransactions404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := duplicatesValidationMessage(err); ok {
+			return api.ResolveDuplicateTransactions400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.ResolveDuplicateTransactions400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("resolve duplicates: db error", zap.Error(err))
+		return nil, err
+	}
+
+	for _, id := range result.DeletedIDs {
+		h.suggestions.Forget(id)
+	}
+	body := api.DuplicateResolveResult{
+		DeletedIds:     result.DeletedIDs,
+		DismissedPairs: result.DismissedPairs,
+	}
+	if result.Kept != nil {
+		h.suggestions.Learn(*result.Kept)
+		kept := toAPITransaction(*result.Kept)
+		body.Kept = &kept
+	}
+
+	logger.Info(
+		"resolve duplicates: done",
+		zap.String("action", string(request.Body.Action)),
+		zap.Int64s("deleted_ids", result.DeletedIDs),
+		zap.Int("dismissed_pairs", result.DismissedPairs),
+	)
+
+	return api.ResolveDuplicateTransactions200JSONResponse{
+		Body:    body,
+		Headers: api.ResolveDuplicateTransactions200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// duplicatesValidationMessage maps resolve errors that are the caller's fault
+// to a 400 message.
+func duplicatesValidationMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, duplicates.ErrInvalidAction),
+		errors.Is(err, duplicates.ErrTooFew),
+		errors.Is(err, duplicates.ErrKeepRequired),
+		errors.Is(err, duplicates.ErrKeepNotInGroup),
+		errors.Is(err, duplicates.ErrTransferLeg),
+		errors.Is(err, duplicates.ErrAmountsMismatch):
+		return err.Error(), true
+	}
+	return "", false
+}
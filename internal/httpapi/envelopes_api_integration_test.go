@@ -0,0 +1,126 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type envelopeResponse struct {
+	CategoryID       int64 `json:"category_id"`
+	CarriedOverCents int64 `json:"carried_over_cents"`
+	AssignedCents    int64 `json:"assigned_cents"`
+	ActivityCents    int64 `json:"activity_cents"`
+	AvailableCents   int64 `json:"available_cents"`
+}
+
+type envelopeMonthResponse struct {
+	Year                   int32              `json:"year"`
+	Month                  int32              `json:"month"`
+	IncomeCents            int64              `json:"income_cents"`
+	AssignedCents          int64              `json:"assigned_cents"`
+	AvailableToBudgetCents int64              `json:"available_to_budget_cents"`
+	Envelopes              []envelopeResponse `json:"envelopes"`
+}
+
+func TestEnvelopes(t *testing.T) {
+	salary := createTestCategory(t, "Envelope-Salary")
+	groceries := createTestCategory(t, "Envelope-Groceries")
+
+	createTestTransaction(t, salary.ID, "2044-01-01", 300000, "salary")
+	createTestTransaction(t, groceries.ID, "2044-01-10", -30000, "supermarket")
+	createTestTransaction(t, groceries.ID, "2044-02-10", -50000, "supermarket")
+
+	before := getTestEnvelopeMonth(t, "?year=2044&month=1")
+	if before.IncomeCents < 300000 {
+		t.Fatalf("income = %d, want at least 300000", before.IncomeCents)
+	}
+
+	january := assignTestEnvelope(t, groceries.ID, 2044, 1, 40000)
+	if before.AvailableToBudgetCents-january.AvailableToBudgetCents != 40000 {
+		t.Fatalf("available to budget went from %d to %d, want a 40000 drop", before.AvailableToBudgetCents, january.AvailableToBudgetCents)
+	}
+	env, ok := findEnvelope(january.Envelopes, groceries.ID)
+	if !ok || env.AssignedCents != 40000 || env.ActivityCents != -30000 || env.AvailableCents != 10000 {
+		t.Fatalf("january envelope: %+v", env)
+	}
+
+	t.Run("leftover and overspending roll over", func(t *testing.T) {
+		february := getTestEnvelopeMonth(t, "?year=2044&month=2")
+		env, ok := findEnvelope(february.Envelopes, groceries.ID)
+		if !ok || env.CarriedOverCents != 10000 || env.ActivityCents != -50000 || env.AvailableCents != -40000 {
+			t.Fatalf("february envelope: %+v", env)
+		}
+
+		march := getTestEnvelopeMonth(t, "?year=2044&month=3")
+		env, ok = findEnvelope(march.Envelopes, groceries.ID)
+		if !ok || env.CarriedOverCents != -40000 || env.AvailableCents != -40000 {
+			t.Fatalf("march envelope: %+v", env)
+		}
+	})
+
+	t.Run("cannot unassign more than assigned", func(t *testing.T) {
+		body := []byte(`{"category_id":` + itoa(groceries.ID) + `,"year":2044,"month":1,"amount_cents":-50000}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/envelopes/assign", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("unassign moves money back", func(t *testing.T) {
+		january := assignTestEnvelope(t, groceries.ID, 2044, 1, -10000)
+		env, _ := findEnvelope(january.Envelopes, groceries.ID)
+		if env.AssignedCents != 30000 {
+			t.Fatalf("assigned = %d, want 30000", env.AssignedCents)
+		}
+		if before.AvailableToBudgetCents-january.AvailableToBudgetCents != 30000 {
+			t.Fatalf("available to budget = %d, want %d", january.AvailableToBudgetCents, before.AvailableToBudgetCents-30000)
+		}
+	})
+}
+
+func getTestEnvelopeMonth(t *testing.T, query string) envelopeMonthResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/envelopes"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var month envelopeMonthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&month); err != nil {
+		t.Fatalf("decode envelopes: %v", err)
+	}
+	return month
+}
+
+func assignTestEnvelope(t *testing.T, categoryID int64, year, month int, amountCents int64) envelopeMonthResponse {
+	t.Helper()
+
+	body := []byte(`{"category_id":` + itoa(categoryID) + `,"year":` + itoa(int64(year)) + `,"month":` + itoa(int64(month)) + `,"amount_cents":` + itoa(amountCents) + `}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/envelopes/assign", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var summary envelopeMonthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		t.Fatalf("decode envelopes: %v", err)
+	}
+	return summary
+}
+
+func findEnvelope(envelopes []envelopeResponse, categoryID int64) (envelopeResponse, bool) {
+	for _, e := range envelopes {
+		if e.CategoryID == categoryID {
+			return e, true
+		}
+	}
+	return envelopeResponse{}, false
+}
@@ -0,0 +1,123 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/envelopes"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type EnvelopesHandler struct {
+	service *envelopes.Service
+	logger  *zap.Logger
+}
+
+func NewEnvelopesHandler(service *envelopes.Service, logger *zap.Logger) *EnvelopesHandler {
+	return &EnvelopesHandler{service: service, logger: logger}
+}
+
+func (h *EnvelopesHandler) GetEnvelopeMonth(ctx context.Context, request api.GetEnvelopeMonthRequestObject) (api.GetEnvelopeMonthResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	if request.Params.Year <= 0 || request.Params.Month < 1 || request.Params.Month > 12 {
+		return api.GetEnvelopeMonth400JSONResponse{
+			Body:    api.Error{Message: "year must be a positive integer and month between 1 and 12"},
+			Headers: api.GetEnvelopeMonth400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	summary, err := h.service.Month(ctx, int(request.Params.Year), int(request.Params.Month))
+	if err != nil {
+		if errors.Is(err, transactions.ErrMissingRate) {
+			return api.GetEnvelopeMonth400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.GetEnvelopeMonth400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("envelope month: query failed", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetEnvelopeMonth200JSONResponse{
+		Body:    toAPIEnvelopeMonth(summary),
+		Headers: api.GetEnvelopeMonth200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *EnvelopesHandler) AssignEnvelope(ctx context.Context, request api.AssignEnvelopeRequestObject) (api.AssignEnvelopeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("assign envelope: missing request body")
+		return api.AssignEnvelope400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.AssignEnvelope400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+	if request.Body.Year <= 0 || request.Body.Month < 1 || request.Body.Month > 12 {
+		return api.AssignEnvelope400JSONResponse{
+			Body:    api.Error{Message: "year must be a positive integer and month between 1 and 12"},
+			Headers: api.AssignEnvelope400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	summary, err := h.service.Assign(ctx, envelopes.AssignInput{
+		CategoryID:  request.Body.CategoryId,
+		Year:        int(request.Body.Year),
+		Month:       int(request.Body.Month),
+		AmountCents: request.Body.AmountCents,
+	})
+	if err != nil {
+		if errors.Is(err, envelopes.ErrNegativeAssignment) || errors.Is(err, transactions.ErrMissingRate) {
+			return api.AssignEnvelope400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.AssignEnvelope400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.AssignEnvelope400JSONResponse{
+				Body:    api.Error{Message: "category not found"},
+				Headers: api.AssignEnvelope400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("assign envelope: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"assign envelope: assigned",
+		zap.Int64("category_id", request.Body.CategoryId),
+		zap.Int64("amount_cents", request.Body.AmountCents),
+	)
+
+	return api.AssignEnvelope200JSONResponse{
+		Body:    toAPIEnvelopeMonth(summary),
+		Headers: api.AssignEnvelope200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func toAPIEnvelopeMonth(m envelopes.MonthSummary) api.EnvelopeMonth {
+	items := make([]api.Envelope, 0, len(m.Envelopes))
+	for _, e := range m.Envelopes {
+		items = append(items, api.Envelope{
+			CategoryId:       e.CategoryID,
+			CarriedOverCents: e.CarriedOverCents,
+			AssignedCents:    e.AssignedCents,
+			ActivityCents:    e.ActivityCents,
+			AvailableCents:   e.AvailableCents,
+		})
+	}
+
+	return api.EnvelopeMonth{
+		Year:                   int32(m.Year),
+		Month:                  int32(m.Month),
+		IncomeCents:            m.IncomeCents,
+		AssignedCents:          m.AssignedCents,
+		AvailableToBudgetCents: m.AvailableToBudgetCents,
+		Envelopes:              items,
+	}
+}
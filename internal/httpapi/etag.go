@@ -0,0 +1,35 @@
+package httpapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// etag formats a row version as a strong ETag.
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatchVersion returns the row version an If-Match header requires, or nil
+// when there is no header or it is "*". A value that is not a single ETag of
+// ours requires version 0, which no row has, so the write fails with 412.
+func ifMatchVersion(header *string) *int64 {
+	if header == nil {
+		return nil
+	}
+
+	value := strings.TrimSpace(*header)
+	if value == "*" {
+		return nil
+	}
+
+	var version int64
+	if unquoted, ok := strings.CutPrefix(value, `"`); ok {
+		if digits, ok := strings.CutSuffix(unquoted, `"`); ok {
+			if v, err := strconv.ParseInt(digits, 10, 64); err == nil && v > 0 {
+				version = v
+			}
+		}
+	}
+	return &version
+}
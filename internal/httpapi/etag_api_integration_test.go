@@ -0,0 +1,118 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+func TestETagPreconditions(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "ETag-2057")
+	created := createTestTaggedTransaction(t, `{"transaction_date":"2057-01-10","amount_cents":-1000,"category_id":`+itoa(category.ID)+`}`)
+	transactionURL := testServer.URL + "/transactions/" + itoa(created.ID)
+	update := []byte(`{"transaction_date":"2057-01-10","amount_cents":-2000,"category_id":` + itoa(category.ID) + `}`)
+
+	var readETag, updatedETag string
+
+	t.Run("get returns an etag", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, transactionURL, nil)
+		resp.Body.Close()
+
+		readETag = resp.Header.Get("ETag")
+		if readETag == "" {
+			t.Fatalf("missing ETag header")
+		}
+	})
+
+	t.Run("update with the current etag succeeds", func(t *testing.T) {
+		resp := doConditionalRequest(t, http.MethodPut, transactionURL, readETag, update)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+
+		updatedETag = resp.Header.Get("ETag")
+		if updatedETag == "" || updatedETag == readETag {
+			t.Fatalf("ETag after update = %q, want a new one (was %q)", updatedETag, readETag)
+		}
+	})
+
+	t.Run("stale etag is rejected", func(t *testing.T) {
+		resp := doConditionalRequest(t, http.MethodPut, transactionURL, readETag, update)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("update status = %d, want 412", resp.StatusCode)
+		}
+
+		del := doConditionalRequest(t, http.MethodDelete, transactionURL, readETag, nil)
+		del.Body.Close()
+		if del.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("delete status = %d, want 412", del.StatusCode)
+		}
+
+		if got := getTestTransaction(t, created.ID); got.AmountCents != -2000 {
+			t.Fatalf("amount = %d, want -2000", got.AmountCents)
+		}
+	})
+
+	t.Run("changes by other paths move the etag", func(t *testing.T) {
+		// Archiving bumps the category; a stale If-Match no longer matches.
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/categories/"+itoa(category.ID), nil)
+		resp.Body.Close()
+		categoryETag := resp.Header.Get("ETag")
+
+		archive := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(category.ID)+"/archive", nil)
+		archive.Body.Close()
+
+		body := []byte(`{"name":"ETag-2057-renamed"}`)
+		stale := doConditionalRequest(t, http.MethodPut, testServer.URL+"/categories/"+itoa(category.ID), categoryETag, body)
+		stale.Body.Close()
+		if stale.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("category update status = %d, want 412", stale.StatusCode)
+		}
+	})
+
+	t.Run("delete with the current etag succeeds", func(t *testing.T) {
+		resp := doConditionalRequest(t, http.MethodDelete, transactionURL, updatedETag, nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+	})
+
+	t.Run("requests without if-match are unconditional", func(t *testing.T) {
+		other := createTestTaggedTransaction(t, `{"transaction_date":"2057-02-10","amount_cents":-500}`)
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(other.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+	})
+}
+
+func doConditionalRequest(t *testing.T, method, url, ifMatch string, body []byte) *http.Response {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("If-Match", ifMatch)
+
+	resp, err := testClient.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	return resp
+}
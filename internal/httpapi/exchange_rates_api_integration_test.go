@@ -0,0 +1,177 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type exchangeRateImportResponse struct {
+	DryRun     bool     `json:"dry_run"`
+	Imported   int      `json:"imported"`
+	Currencies []string `json:"currencies"`
+	FromDate   *string  `json:"from_date"`
+	ToDate     *string  `json:"to_date"`
+}
+
+type exchangeRateListResponse struct {
+	Items []struct {
+		Currency string  `json:"currency"`
+		RateDate string  `json:"rate_date"`
+		Rate     float64 `json:"rate"`
+	} `json:"items"`
+}
+
+const ecbDailyXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2053-03-03">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="JPY" rate="160"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestExchangeRates(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "Multi-Currency-2053")
+
+	t.Run("import xml", func(t *testing.T) {
+		dry := importTestExchangeRates(t, "xml", "application/xml", ecbDailyXML, true)
+		if !dry.DryRun || dry.Imported != 2 {
+			t.Fatalf("dry run = %+v, want 2 rates not stored", dry)
+		}
+		if list := listTestExchangeRates(t, "?currency=USD&from_date=2053-01-01&to_date=2053-12-31"); len(list.Items) != 0 {
+			t.Fatalf("dry run stored %+v", list.Items)
+		}
+
+		result := importTestExchangeRates(t, "xml", "application/xml", ecbDailyXML, false)
+		if result.Imported != 2 || len(result.Currencies) != 2 || result.Currencies[0] != "JPY" || result.Currencies[1] != "USD" {
+			t.Fatalf("import = %+v, want JPY and USD", result)
+		}
+		if result.FromDate == nil || *result.FromDate != "2053-03-03" {
+			t.Fatalf("from_date = %v, want 2053-03-03", result.FromDate)
+		}
+	})
+
+	t.Run("import csv", func(t *testing.T) {
+		const csv = "Date,GBP,USD,CYP,\n2053-03-03,0.8,1.25,N/A,\n"
+		result := importTestExchangeRates(t, "csv", "text/csv", csv, false)
+		if result.Imported != 2 {
+			t.Fatalf("import = %+v, want 2 rates", result)
+		}
+
+		list := listTestExchangeRates(t, "?currency=USD&from_date=2053-01-01&to_date=2053-12-31")
+		if len(list.Items) != 1 || list.Items[0].Rate != 1.25 || list.Items[0].RateDate != "2053-03-03" {
+			t.Fatalf("usd rates = %+v, want one replaced rate", list.Items)
+		}
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/exchange-rates/import/xml", "application/xml", []byte(`<Document/>`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("xml status = %d, want 400", resp.StatusCode)
+		}
+
+		resp = doRequestWithContentType(t, http.MethodPost, testServer.URL+"/exchange-rates/import/csv", "text/csv", []byte("Date,EUR\n2053-03-03,1\n"))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("csv status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("transactions carry a currency", func(t *testing.T) {
+		eur := createTestTaggedTransaction(t, `{"transaction_date":"2053-03-04","amount_cents":-1000,"category_id":`+itoa(category.ID)+`}`)
+		if eur.Currency != "EUR" {
+			t.Fatalf("currency = %q, want the EUR base currency", eur.Currency)
+		}
+		usd := createTestTaggedTransaction(t, `{"transaction_date":"2053-03-05","amount_cents":-2500,"currency":"USD","category_id":`+itoa(category.ID)+`}`)
+		if usd.Currency != "USD" {
+			t.Fatalf("currency = %q, want USD", usd.Currency)
+		}
+		createTestTaggedTransaction(t, `{"transaction_date":"2053-03-06","amount_cents":-800,"currency":"GBP","category_id":`+itoa(category.ID)+`}`)
+
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", []byte(`{"transaction_date":"2053-03-06","amount_cents":-800,"currency":"usd"}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("lower-case currency status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("summary converts into the base currency", func(t *testing.T) {
+		summary := getTestSummary(t, "?year=2053")
+		row, ok := findSummaryRow(summary.Spending.Rows, category.ID)
+		if !ok {
+			t.Fatalf("category %d missing from summary", category.ID)
+		}
+		// 10.00 EUR + 25.00 USD at 1.25 per euro + 8.00 GBP at 0.8 per euro.
+		if row.Values[2] != 4000 {
+			t.Fatalf("march spending = %d, want 4000", row.Values[2])
+		}
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		chf := createTestTaggedTransaction(t, `{"transaction_date":"2053-04-01","amount_cents":-100,"currency":"CHF"}`)
+
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/monthly-savings?year=2053", nil)
+		var errBody struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&errBody); err != nil {
+			t.Fatalf("decode error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+		if errBody.Message != "missing exchange rate to convert CHF into EUR on 2053-04-01" {
+			t.Fatalf("message = %q", errBody.Message)
+		}
+
+		resp = doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(chf.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete status = %d, want 204", resp.StatusCode)
+		}
+	})
+}
+
+func importTestExchangeRates(t *testing.T, format, contentType, body string, dryRun bool) exchangeRateImportResponse {
+	t.Helper()
+
+	url := testServer.URL + "/exchange-rates/import/" + format
+	if dryRun {
+		url += "?dry_run=true"
+	}
+	resp := doRequestWithContentType(t, http.MethodPost, url, contentType, []byte(body))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result exchangeRateImportResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode import result: %v", err)
+	}
+	return result
+}
+
+func listTestExchangeRates(t *testing.T, query string) exchangeRateListResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/exchange-rates"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list exchangeRateListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode exchange rates: %v", err)
+	}
+	return list
+}
@@ -0,0 +1,147 @@
+package httpapi
+
+import (
+	"context"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/rates"
+)
+
+type ExchangeRatesHandler struct {
+	repo   *rates.Repository
+	logger *zap.Logger
+}
+
+func NewExchangeRatesHandler(repo *rates.Repository, logger *zap.Logger) *ExchangeRatesHandler {
+	return &ExchangeRatesHandler{repo: repo, logger: logger}
+}
+
+func (h *ExchangeRatesHandler) ListExchangeRates(ctx context.Context, request api.ListExchangeRatesRequestObject) (api.ListExchangeRatesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	from := datePtrValue(request.Params.FromDate)
+	to := datePtrValue(request.Params.ToDate)
+	if from != nil && to != nil && from.After(*to) {
+		return api.ListExchangeRates400JSONResponse{
+			Body:    api.Error{Message: "from_date must not be after to_date"},
+			Headers: api.ListExchangeRates400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	list, err := h.repo.List(ctx, rates.Query{Currency: request.Params.Currency, From: from, To: to})
+	if err != nil {
+		h.logger.Error("list exchange rates: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.ExchangeRate, 0, len(list))
+	for _, r := range list {
+		items = append(items, api.ExchangeRate{
+			Currency: r.Currency,
+			RateDate: types.Date{Time: r.Date},
+			Rate:     r.Rate,
+		})
+	}
+
+	return api.ListExchangeRates200JSONResponse{
+		Body:    api.ExchangeRateList{Items: items},
+		Headers: api.ListExchangeRates200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *ExchangeRatesHandler) ImportExchangeRatesXml(ctx context.Context, request api.ImportExchangeRatesXmlRequestObject) (api.ImportExchangeRatesXmlResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("import exchange rates xml: missing request body")
+		return api.ImportExchangeRatesXml400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ImportExchangeRatesXml400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	list, err := rates.ParseXML(request.Body)
+	if err != nil {
+		logger.Warn("import exchange rates xml: invalid file", zap.Error(err))
+		return api.ImportExchangeRatesXml400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ImportExchangeRatesXml400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.repo.Import(ctx, list, dryRun)
+	if err != nil {
+		logger.Error("import exchange rates xml: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"import exchange rates xml: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("imported", result.Imported),
+		zap.Strings("currencies", result.Currencies),
+	)
+
+	return api.ImportExchangeRatesXml200JSONResponse{
+		Body:    toAPIExchangeRateImportResult(result),
+		Headers: api.ImportExchangeRatesXml200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *ExchangeRatesHandler) ImportExchangeRatesCsv(ctx context.Context, request api.ImportExchangeRatesCsvRequestObject) (api.ImportExchangeRatesCsvResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("import exchange rates csv: missing request body")
+		return api.ImportExchangeRatesCsv400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ImportExchangeRatesCsv400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	list, err := rates.ParseCSV(request.Body)
+	if err != nil {
+		logger.Warn("import exchange rates csv: invalid file", zap.Error(err))
+		return api.ImportExchangeRatesCsv400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ImportExchangeRatesCsv400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.repo.Import(ctx, list, dryRun)
+	if err != nil {
+		logger.Error("import exchange rates csv: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"import exchange rates csv: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("imported", result.Imported),
+		zap.Strings("currencies", result.Currencies),
+	)
+
+	return api.ImportExchangeRatesCsv200JSONResponse{
+		Body:    toAPIExchangeRateImportResult(result),
+		Headers: api.ImportExchangeRatesCsv200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func toAPIExchangeRateImportResult(result rates.ImportResult) api.ExchangeRateImportResult {
+	out := api.ExchangeRateImportResult{
+		DryRun:     result.DryRun,
+		Imported:   result.Imported,
+		Currencies: result.Currencies,
+	}
+	if result.From != nil {
+		out.FromDate = &types.Date{Time: *result.From}
+	}
+	if result.To != nil {
+		out.ToDate = &types.Date{Time: *result.To}
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package httpapi_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExportTransactions(t *testing.T) {
+	category := createTestCategory(t, "Export-Groceries")
+	createTestTransaction(t, category.ID, "2036-01-05", -1250, "market")
+	createTestTransaction(t, category.ID, "2036-01-20", -800, "bakery")
+	createTestTransaction(t, category.ID, "2036-02-02", 5000, "refund")
+
+	base := testServer.URL + "/transactions/export?from_date=2036-01-01&to_date=2036-01-31"
+
+	t.Run("csv is the default", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, base, nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		if resp.Header.Get("Content-Type") != "text/csv" {
+			t.Fatalf("content type = %q", resp.Header.Get("Content-Type"))
+		}
+		if resp.Header.Get("X-Request-ID") == "" {
+			t.Fatalf("missing X-Request-ID header")
+		}
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("read csv: %v", err)
+		}
+		if len(records) != 3 {
+			t.Fatalf("records = %d, want header and 2 rows", len(records))
+		}
+		if records[1][7] != "market" || records[1][6] != category.Name || records[1][3] != "-1250" {
+			t.Fatalf("unexpected first row: %v", records[1])
+		}
+	})
+
+	t.Run("accept header selects ndjson", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, base+"&type=spending", nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		req.Header.Set("Accept", "application/x-ndjson")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.Header.Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("content type = %q", resp.Header.Get("Content-Type"))
+		}
+
+		lines := 0
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var row struct {
+				AmountCents  int64   `json:"amount_cents"`
+				CategoryName *string `json:"category_name"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+				t.Fatalf("decode line: %v", err)
+			}
+			if row.CategoryName == nil || *row.CategoryName != category.Name {
+				t.Fatalf("unexpected category name: %v", row.CategoryName)
+			}
+			lines++
+		}
+		if lines != 2 {
+			t.Fatalf("lines = %d, want 2", lines)
+		}
+	})
+
+	t.Run("format parameter selects ofx", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/export?format=ofx&from_date=2036-01-01&to_date=2036-12-31", nil)
+		defer resp.Body.Close()
+
+		if resp.Header.Get("Content-Type") != "application/x-ofx" {
+			t.Fatalf("content type = %q", resp.Header.Get("Content-Type"))
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if strings.Count(string(body), "<STMTTRN>") != 3 || !strings.Contains(string(body), "<DTSTART>20360101</DTSTART>") {
+			t.Fatalf("unexpected ofx body: %s", body)
+		}
+	})
+
+	t.Run("invalid format is rejected", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/export?format=xlsx", nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/exports"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func (h *TransactionsHandler) ExportTransactions(ctx context.Context, request api.ExportTransactionsRequestObject) (api.ExportTransactionsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+
+	format := exports.FormatCSV
+	if request.Params.Format != nil {
+		format = exports.Format(*request.Params.Format)
+	} else if request.Params.Accept != nil {
+		if accepted, ok := exports.FormatFromAccept(*request.Params.Accept); ok {
+			format = accepted
+		}
+	}
+
+	var filter transactions.ListFilter
+	var period exports.Period
+	if request.Params.FromDate != nil {
+		from := request.Params.FromDate.Time
+		filter.FromDate = &from
+		period.From = from
+	}
+	if request.Params.ToDate != nil {
+		to := request.Params.ToDate.Time
+		filter.ToDate = &to
+		period.To = to
+	}
+	filter.AccountID = request.Params.AccountId
+	filter.CategoryID = request.Params.CategoryId
+	if request.Params.Type != nil {
+		switch string(*request.Params.Type) {
+		case "spending", "income":
+			t := string(*request.Params.Type)
+			filter.Type = &t
+		default:
+			return api.ExportTransactions400JSONResponse{
+				Body:    api.Error{Message: "type must be spending or income"},
+				Headers: api.ExportTransactions400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+	}
+
+	if format == exports.FormatOFX {
+		currencies, err := h.repo.ExportCurrencies(ctx, filter)
+		if err != nil {
+			logger.Error("export transactions: currency query failed", zap.Error(err))
+			return nil, err
+		}
+		if len(currencies) > 1 {
+			return api.ExportTransactions400JSONResponse{
+				Body:    api.Error{Message: exports.ErrMixedCurrencies.Error()},
+				Headers: api.ExportTransactions400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+	}
+
+	pr, pw := io.Pipe()
+	writer, err := exports.NewWriter(format, pw, period)
+	if err != nil {
+		return api.ExportTransactions400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ExportTransactions400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	// Rows are encoded as they are read from the database and handed to the
+	// response through the pipe. The generated response closes the reader when
+	// it is done, which aborts the query if the client went away.
+	go func() {
+		start := time.Now()
+		count := 0
+		err := h.repo.Export(ctx, filter, func(row transactions.ExportRow) error {
+			count++
+			return writer.Write(row)
+		})
+		if err == nil {
+			err = writer.Close()
+		}
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			logger.Error("export transactions: stream error", zap.Error(err))
+		} else if err == nil {
+			logger.Info(
+				"export transactions: done",
+				zap.String("format", string(format)),
+				zap.Int("rows", count),
+				zap.Duration("duration", time.Since(start)),
+			)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	headers := api.ExportTransactions200ResponseHeaders{XRequestID: requestID}
+	switch format {
+	case exports.FormatNDJSON:
+		return api.ExportTransactions200ApplicationxNdjsonResponse{Body: pr, Headers: headers}, nil
+	case exports.FormatOFX:
+		return api.ExportTransactions200ApplicationxOfxResponse{Body: pr, Headers: headers}, nil
+	default:
+		return api.ExportTransactions200TextcsvResponse{Body: pr, Headers: headers}, nil
+	}
+}
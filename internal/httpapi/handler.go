@@ -10,10 +10,22 @@ type Handler struct {
 	transactions *TransactionsHandler
 	categories   *CategoriesHandler
 	analytics    *AnalyticsHandler
+	imports      *ImportsHandler
+	accounts     *AccountsHandler
+	transfers    *TransfersHandler
+	budgets      *BudgetsHandler
+	envelopes    *EnvelopesHandler
+	recurring    *RecurringHandler
+	rules        *CategorizationHandler
+	duplicates   *DuplicatesHandler
+	tags         *TagsHandler
+	payees       *PayeesHandler
+	rates        *ExchangeRatesHandler
+	trash        *TrashHandler
 }
 
-func NewHandler(transactions *TransactionsHandler, categories *CategoriesHandler, analytics *AnalyticsHandler) *Handler {
-	return &Handler{transactions: transactions, categories: categories, analytics: analytics}
+func NewHandler(transactions *TransactionsHandler, categories *CategoriesHandler, analytics *AnalyticsHandler, imports *ImportsHandler, accounts *AccountsHandler, transfers *TransfersHandler, budgets *BudgetsHandler, envelopes *EnvelopesHandler, recurring *RecurringHandler, rules *CategorizationHandler, duplicates *DuplicatesHandler, tags *TagsHandler, payees *PayeesHandler, rates *ExchangeRatesHandler, trash *TrashHandler) *Handler {
+	return &Handler{transactions: transactions, categories: categories, analytics: analytics, imports: imports, accounts: accounts, transfers: transfers, budgets: budgets, envelopes: envelopes, recurring: recurring, rules: rules, duplicates: duplicates, tags: tags, payees: payees, rates: rates, trash: trash}
 }
 
 func (h *Handler) CreateTransaction(ctx context.Context, request api.CreateTransactionRequestObject) (api.CreateTransactionResponseObject, error) {
@@ -24,6 +36,14 @@ func (h *Handler) DeleteTransaction(ctx context.Context, request api.DeleteTrans
 	return h.transactions.DeleteTransaction(ctx, request)
 }
 
+func (h *Handler) RestoreTransaction(ctx context.Context, request api.RestoreTransactionRequestObject) (api.RestoreTransactionResponseObject, error) {
+	return h.transactions.RestoreTransaction(ctx, request)
+}
+
+func (h *Handler) GetTransactionHistory(ctx context.Context, request api.GetTransactionHistoryRequestObject) (api.GetTransactionHistoryResponseObject, error) {
+	return h.transactions.GetTransactionHistory(ctx, request)
+}
+
 func (h *Handler) GetTransaction(ctx context.Context, request api.GetTransactionRequestObject) (api.GetTransactionResponseObject, error) {
 	return h.transactions.GetTransaction(ctx, request)
 }
@@ -36,6 +56,22 @@ func (h *Handler) ListTransactions(ctx context.Context, request api.ListTransact
 	return h.transactions.ListTransactions(ctx, request)
 }
 
+func (h *Handler) ExportTransactions(ctx context.Context, request api.ExportTransactionsRequestObject) (api.ExportTransactionsResponseObject, error) {
+	return h.transactions.ExportTransactions(ctx, request)
+}
+
+func (h *Handler) ImportTransactions(ctx context.Context, request api.ImportTransactionsRequestObject) (api.ImportTransactionsResponseObject, error) {
+	return h.imports.ImportTransactions(ctx, request)
+}
+
+func (h *Handler) ImportTransactionsOfx(ctx context.Context, request api.ImportTransactionsOfxRequestObject) (api.ImportTransactionsOfxResponseObject, error) {
+	return h.imports.ImportTransactionsOfx(ctx, request)
+}
+
+func (h *Handler) ImportTransactionsCamt(ctx context.Context, request api.ImportTransactionsCamtRequestObject) (api.ImportTransactionsCamtResponseObject, error) {
+	return h.imports.ImportTransactionsCamt(ctx, request)
+}
+
 func (h *Handler) CreateCategory(ctx context.Context, request api.CreateCategoryRequestObject) (api.CreateCategoryResponseObject, error) {
 	return h.categories.CreateCategory(ctx, request)
 }
@@ -64,4 +100,224 @@ func (h *Handler) GetMonthlySavings(ctx context.Context, request api.GetMonthlyS
 	return h.analytics.GetMonthlySavings(ctx, request)
 }
 
+func (h *Handler) CreateAccount(ctx context.Context, request api.CreateAccountRequestObject) (api.CreateAccountResponseObject, error) {
+	return h.accounts.CreateAccount(ctx, request)
+}
+
+func (h *Handler) DeleteAccount(ctx context.Context, request api.DeleteAccountRequestObject) (api.DeleteAccountResponseObject, error) {
+	return h.accounts.DeleteAccount(ctx, request)
+}
+
+func (h *Handler) GetAccount(ctx context.Context, request api.GetAccountRequestObject) (api.GetAccountResponseObject, error) {
+	return h.accounts.GetAccount(ctx, request)
+}
+
+func (h *Handler) ListAccounts(ctx context.Context, request api.ListAccountsRequestObject) (api.ListAccountsResponseObject, error) {
+	return h.accounts.ListAccounts(ctx, request)
+}
+
+func (h *Handler) UpdateAccount(ctx context.Context, request api.UpdateAccountRequestObject) (api.UpdateAccountResponseObject, error) {
+	return h.accounts.UpdateAccount(ctx, request)
+}
+
+func (h *Handler) CreateTransfer(ctx context.Context, request api.CreateTransferRequestObject) (api.CreateTransferResponseObject, error) {
+	return h.transfers.CreateTransfer(ctx, request)
+}
+
+func (h *Handler) DeleteTransfer(ctx context.Context, request api.DeleteTransferRequestObject) (api.DeleteTransferResponseObject, error) {
+	return h.transfers.DeleteTransfer(ctx, request)
+}
+
+func (h *Handler) GetTransfer(ctx context.Context, request api.GetTransferRequestObject) (api.GetTransferResponseObject, error) {
+	return h.transfers.GetTransfer(ctx, request)
+}
+
+func (h *Handler) ListTransfers(ctx context.Context, request api.ListTransfersRequestObject) (api.ListTransfersResponseObject, error) {
+	return h.transfers.ListTransfers(ctx, request)
+}
+
+func (h *Handler) UpdateTransfer(ctx context.Context, request api.UpdateTransferRequestObject) (api.UpdateTransferResponseObject, error) {
+	return h.transfers.UpdateTransfer(ctx, request)
+}
+
+func (h *Handler) MergeCategory(ctx context.Context, request api.MergeCategoryRequestObject) (api.MergeCategoryResponseObject, error) {
+	return h.categories.MergeCategory(ctx, request)
+}
+
+func (h *Handler) ArchiveCategory(ctx context.Context, request api.ArchiveCategoryRequestObject) (api.ArchiveCategoryResponseObject, error) {
+	return h.categories.ArchiveCategory(ctx, request)
+}
+
+func (h *Handler) UnarchiveCategory(ctx context.Context, request api.UnarchiveCategoryRequestObject) (api.UnarchiveCategoryResponseObject, error) {
+	return h.categories.UnarchiveCategory(ctx, request)
+}
+
+func (h *Handler) CreateBudget(ctx context.Context, request api.CreateBudgetRequestObject) (api.CreateBudgetResponseObject, error) {
+	return h.budgets.CreateBudget(ctx, request)
+}
+
+func (h *Handler) ListBudgets(ctx context.Context, request api.ListBudgetsRequestObject) (api.ListBudgetsResponseObject, error) {
+	return h.budgets.ListBudgets(ctx, request)
+}
+
+func (h *Handler) GetBudget(ctx context.Context, request api.GetBudgetRequestObject) (api.GetBudgetResponseObject, error) {
+	return h.budgets.GetBudget(ctx, request)
+}
+
+func (h *Handler) UpdateBudget(ctx context.Context, request api.UpdateBudgetRequestObject) (api.UpdateBudgetResponseObject, error) {
+	return h.budgets.UpdateBudget(ctx, request)
+}
+
+func (h *Handler) DeleteBudget(ctx context.Context, request api.DeleteBudgetRequestObject) (api.DeleteBudgetResponseObject, error) {
+	return h.budgets.DeleteBudget(ctx, request)
+}
+
+func (h *Handler) GetBudgetVsActual(ctx context.Context, request api.GetBudgetVsActualRequestObject) (api.GetBudgetVsActualResponseObject, error) {
+	return h.analytics.GetBudgetVsActual(ctx, request)
+}
+
+func (h *Handler) GetEnvelopeMonth(ctx context.Context, request api.GetEnvelopeMonthRequestObject) (api.GetEnvelopeMonthResponseObject, error) {
+	return h.envelopes.GetEnvelopeMonth(ctx, request)
+}
+
+func (h *Handler) AssignEnvelope(ctx context.Context, request api.AssignEnvelopeRequestObject) (api.AssignEnvelopeResponseObject, error) {
+	return h.envelopes.AssignEnvelope(ctx, request)
+}
+
+func (h *Handler) CreateRecurringRule(ctx context.Context, request api.CreateRecurringRuleRequestObject) (api.CreateRecurringRuleResponseObject, error) {
+	return h.recurring.CreateRecurringRule(ctx, request)
+}
+
+func (h *Handler) ListRecurringRules(ctx context.Context, request api.ListRecurringRulesRequestObject) (api.ListRecurringRulesResponseObject, error) {
+	return h.recurring.ListRecurringRules(ctx, request)
+}
+
+func (h *Handler) GetRecurringRule(ctx context.Context, request api.GetRecurringRuleRequestObject) (api.GetRecurringRuleResponseObject, error) {
+	return h.recurring.GetRecurringRule(ctx, request)
+}
+
+func (h *Handler) UpdateRecurringRule(ctx context.Context, request api.UpdateRecurringRuleRequestObject) (api.UpdateRecurringRuleResponseObject, error) {
+	return h.recurring.UpdateRecurringRule(ctx, request)
+}
+
+func (h *Handler) DeleteRecurringRule(ctx context.Context, request api.DeleteRecurringRuleRequestObject) (api.DeleteRecurringRuleResponseObject, error) {
+	return h.recurring.DeleteRecurringRule(ctx, request)
+}
+
+func (h *Handler) RunRecurringRules(ctx context.Context, request api.RunRecurringRulesRequestObject) (api.RunRecurringRulesResponseObject, error) {
+	return h.recurring.RunRecurringRules(ctx, request)
+}
+
+func (h *Handler) GetCashFlow(ctx context.Context, request api.GetCashFlowRequestObject) (api.GetCashFlowResponseObject, error) {
+	return h.analytics.GetCashFlow(ctx, request)
+}
+
+func (h *Handler) CreateCategorizationRule(ctx context.Context, request api.CreateCategorizationRuleRequestObject) (api.CreateCategorizationRuleResponseObject, error) {
+	return h.rules.CreateCategorizationRule(ctx, request)
+}
+
+func (h *Handler) ListCategorizationRules(ctx context.Context, request api.ListCategorizationRulesRequestObject) (api.ListCategorizationRulesResponseObject, error) {
+	return h.rules.ListCategorizationRules(ctx, request)
+}
+
+func (h *Handler) GetCategorizationRule(ctx context.Context, request api.GetCategorizationRuleRequestObject) (api.GetCategorizationRuleResponseObject, error) {
+	return h.rules.GetCategorizationRule(ctx, request)
+}
+
+func (h *Handler) UpdateCategorizationRule(ctx context.Context, request api.UpdateCategorizationRuleRequestObject) (api.UpdateCategorizationRuleResponseObject, error) {
+	return h.rules.UpdateCategorizationRule(ctx, request)
+}
+
+func (h *Handler) DeleteCategorizationRule(ctx context.Context, request api.DeleteCategorizationRuleRequestObject) (api.DeleteCategorizationRuleResponseObject, error) {
+	return h.rules.DeleteCategorizationRule(ctx, request)
+}
+
+func (h *Handler) ApplyCategorizationRules(ctx context.Context, request api.ApplyCategorizationRulesRequestObject) (api.ApplyCategorizationRulesResponseObject, error) {
+	return h.rules.ApplyCategorizationRules(ctx, request)
+}
+
+func (h *Handler) SuggestCategories(ctx context.Context, request api.SuggestCategoriesRequestObject) (api.SuggestCategoriesResponseObject, error) {
+	return h.categories.SuggestCategories(ctx, request)
+}
+
+func (h *Handler) FindDuplicateTransactions(ctx context.Context, request api.FindDuplicateTransactionsRequestObject) (api.FindDuplicateTransactionsResponseObject, error) {
+	return h.duplicates.FindDuplicateTransactions(ctx, request)
+}
+
+func (h *Handler) ResolveDuplicateTransactions(ctx context.Context, request api.ResolveDuplicateTransactionsRequestObject) (api.ResolveDuplicateTransactionsResponseObject, error) {
+	return h.duplicates.ResolveDuplicateTransactions(ctx, request)
+}
+
+func (h *Handler) CreateTag(ctx context.Context, request api.CreateTagRequestObject) (api.CreateTagResponseObject, error) {
+	return h.tags.CreateTag(ctx, request)
+}
+
+func (h *Handler) DeleteTag(ctx context.Context, request api.DeleteTagRequestObject) (api.DeleteTagResponseObject, error) {
+	return h.tags.DeleteTag(ctx, request)
+}
+
+func (h *Handler) GetTag(ctx context.Context, request api.GetTagRequestObject) (api.GetTagResponseObject, error) {
+	return h.tags.GetTag(ctx, request)
+}
+
+func (h *Handler) ListTags(ctx context.Context, request api.ListTagsRequestObject) (api.ListTagsResponseObject, error) {
+	return h.tags.ListTags(ctx, request)
+}
+
+func (h *Handler) UpdateTag(ctx context.Context, request api.UpdateTagRequestObject) (api.UpdateTagResponseObject, error) {
+	return h.tags.UpdateTag(ctx, request)
+}
+
+func (h *Handler) GetTagTotals(ctx context.Context, request api.GetTagTotalsRequestObject) (api.GetTagTotalsResponseObject, error) {
+	return h.analytics.GetTagTotals(ctx, request)
+}
+
+func (h *Handler) CreatePayee(ctx context.Context, request api.CreatePayeeRequestObject) (api.CreatePayeeResponseObject, error) {
+	return h.payees.CreatePayee(ctx, request)
+}
+
+func (h *Handler) DeletePayee(ctx context.Context, request api.DeletePayeeRequestObject) (api.DeletePayeeResponseObject, error) {
+	return h.payees.DeletePayee(ctx, request)
+}
+
+func (h *Handler) GetPayee(ctx context.Context, request api.GetPayeeRequestObject) (api.GetPayeeResponseObject, error) {
+	return h.payees.GetPayee(ctx, request)
+}
+
+func (h *Handler) ListPayees(ctx context.Context, request api.ListPayeesRequestObject) (api.ListPayeesResponseObject, error) {
+	return h.payees.ListPayees(ctx, request)
+}
+
+func (h *Handler) UpdatePayee(ctx context.Context, request api.UpdatePayeeRequestObject) (api.UpdatePayeeResponseObject, error) {
+	return h.payees.UpdatePayee(ctx, request)
+}
+
+func (h *Handler) MergePayee(ctx context.Context, request api.MergePayeeRequestObject) (api.MergePayeeResponseObject, error) {
+	return h.payees.MergePayee(ctx, request)
+}
+
+func (h *Handler) ApplyPayeePatterns(ctx context.Context, request api.ApplyPayeePatternsRequestObject) (api.ApplyPayeePatternsResponseObject, error) {
+	return h.payees.ApplyPayeePatterns(ctx, request)
+}
+
+func (h *Handler) GetTopPayees(ctx context.Context, request api.GetTopPayeesRequestObject) (api.GetTopPayeesResponseObject, error) {
+	return h.analytics.GetTopPayees(ctx, request)
+}
+
+func (h *Handler) ListExchangeRates(ctx context.Context, request api.ListExchangeRatesRequestObject) (api.ListExchangeRatesResponseObject, error) {
+	return h.rates.ListExchangeRates(ctx, request)
+}
+
+func (h *Handler) ImportExchangeRatesXml(ctx context.Context, request api.ImportExchangeRatesXmlRequestObject) (api.ImportExchangeRatesXmlResponseObject, error) {
+	return h.rates.ImportExchangeRatesXml(ctx, request)
+}
+
+func (h *Handler) ImportExchangeRatesCsv(ctx context.Context, request api.ImportExchangeRatesCsvRequestObject) (api.ImportExchangeRatesCsvResponseObject, error) {
+	return h.rates.ImportExchangeRatesCsv(ctx, request)
+}
+
+func (h *Handler) GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error) {
+	return h.trash.GetTrash(ctx, request)
+}
+
 var _ api.StrictServerInterface = (*Handler)(nil)
@@ -0,0 +1,227 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type importRowResponse struct {
+	Line          int32   `json:"line"`
+	Status        string  `json:"status"`
+	TransactionID *int64  `json:"transaction_id"`
+	Message       *string `json:"message"`
+}
+
+type importResultResponse struct {
+	DryRun  bool                `json:"dry_run"`
+	Created int32               `json:"created"`
+	Skipped int32               `json:"skipped"`
+	Failed  int32               `json:"failed"`
+	Rows    []importRowResponse `json:"rows"`
+}
+
+func TestImportTransactionsCSV(t *testing.T) {
+	category := createTestCategory(t, "Import-CSV")
+
+	csvBody := []byte("Booking Date;Amount;Text;Category\n" +
+		"03.01.2033;-12,50;bakery;" + category.Name + "\n" +
+		";;;\n" +
+		"04.01.2033;not-a-number;broken;\n" +
+		"05.01.2033;1.250,00;salary;\n" +
+		"06.01.2033;-3,00;unknown category;Does-Not-Exist\n")
+	query := "?date_column=Booking%20Date&amount_column=Amount&description_column=Text&category_column=Category" +
+		"&date_format=DD.MM.YYYY&delimiter=semicolon&decimal_separator=comma"
+
+	t.Run("dry run validates without writing", func(t *testing.T) {
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import"+query+"&dry_run=true", "text/csv", csvBody)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if !result.DryRun || result.Created != 2 || result.Skipped != 1 || result.Failed != 2 {
+			t.Fatalf("unexpected dry run result: %+v", result)
+		}
+
+		list := listTransactionsInRange(t, "2033-01-01", "2033-01-31")
+		if len(list.Items) != 0 {
+			t.Fatalf("dry run created %d transactions", len(list.Items))
+		}
+	})
+
+	t.Run("import creates valid rows", func(t *testing.T) {
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import"+query, "text/csv", csvBody)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		if resp.Header.Get("X-Request-ID") == "" {
+			t.Fatalf("missing X-Request-ID header")
+		}
+
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if result.Created != 2 || result.Skipped != 1 || result.Failed != 2 {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+		if len(result.Rows) != 5 {
+			t.Fatalf("rows len = %d, want 5", len(result.Rows))
+		}
+		if result.Rows[2].Line != 4 || result.Rows[2].Status != "failed" {
+			t.Fatalf("unexpected row for line 4: %+v", result.Rows[2])
+		}
+
+		list := listTransactionsInRange(t, "2033-01-01", "2033-01-31")
+		if len(list.Items) != 2 {
+			t.Fatalf("expected 2 transactions, got %d", len(list.Items))
+		}
+		for _, item := range list.Items {
+			switch *item.Description {
+			case "bakery":
+				if item.AmountCents != -1250 || item.CategoryID == nil || *item.CategoryID != category.ID {
+					t.Fatalf("unexpected bakery transaction: %+v", item)
+				}
+			case "salary":
+				if item.AmountCents != 125000 || item.CategoryID != nil {
+					t.Fatalf("unexpected salary transaction: %+v", item)
+				}
+			}
+		}
+	})
+
+	t.Run("missing column is rejected", func(t *testing.T) {
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import?amount_column=Betrag", "text/csv", csvBody)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+}
+
+func listTransactionsInRange(t *testing.T, from, to string) transactionListResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions?from_date="+from+"&to_date="+to+"&limit=200", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list transactionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	return list
+}
+
+func TestImportTransactionsOFXSkipsKnownFITIDs(t *testing.T) {
+	statement := []byte(`OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR
+<BANKACCTFROM><BANKID>30004<ACCTID>OFX-IT-1</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20340105<TRNAMT>-20.00<FITID>F-1<NAME>PHARMACY</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20340106<TRNAMT>-5.50<FITID>F-2<NAME>BAKERY</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20340106<TRNAMT>-5.50<FITID>F-2<NAME>BAKERY</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`)
+
+	importOFX := func(t *testing.T) importResultResponse {
+		t.Helper()
+
+		resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import/ofx", "application/x-ofx", statement)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+
+		var result importResultResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return result
+	}
+
+	first := importOFX(t)
+	if first.Created != 2 || first.Skipped != 1 || first.Failed != 0 {
+		t.Fatalf("unexpected first import result: %+v", first)
+	}
+
+	second := importOFX(t)
+	if second.Created != 0 || second.Skipped != 3 {
+		t.Fatalf("unexpected second import result: %+v", second)
+	}
+
+	list := listTransactionsInRange(t, "2034-01-01", "2034-01-31")
+	if len(list.Items) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(list.Items))
+	}
+}
+
+func TestImportTransactionsCAMT(t *testing.T) {
+	statement := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Acct><Id><IBAN>FR7630006000011234567890189</IBAN></Id></Acct>
+      <Ntry>
+        <Amt Ccy="EUR">61.20</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2035-02-03</Dt></BookgDt>
+        <AcctSvcrRef>CAMT-IT-1</AcctSvcrRef>
+        <NtryDtls><TxDtls><RmtInf><Ustrd>Electricity February</Ustrd></RmtInf></TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">abc</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2035-02-04</Dt></BookgDt>
+        <AcctSvcrRef>CAMT-IT-2</AcctSvcrRef>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`)
+
+	resp := doRequestWithContentType(t, http.MethodPost, testServer.URL+"/transactions/import/camt", "application/xml", statement)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result importResultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if result.Created != 1 || result.Failed != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	list := listTransactionsInRange(t, "2035-02-01", "2035-02-28")
+	if len(list.Items) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(list.Items))
+	}
+	if list.Items[0].AmountCents != -6120 || list.Items[0].Description == nil || *list.Items[0].Description != "Electricity February" {
+		t.Fatalf("unexpected transaction: %+v", list.Items[0])
+	}
+}
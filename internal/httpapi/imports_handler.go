@@ -0,0 +1,219 @@
+package httpapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/imports"
+	"zankowitch.com/go-db-app/internal/suggestions"
+)
+
+type ImportsHandler struct {
+	importer    *imports.Importer
+	suggestions *suggestions.Service
+	logger      *zap.Logger
+}
+
+func NewImportsHandler(importer *imports.Importer, suggestions *suggestions.Service, logger *zap.Logger) *ImportsHandler {
+	return &ImportsHandler{importer: importer, suggestions: suggestions, logger: logger}
+}
+
+// runImport imports entries and has the suggestion model retrained when rows
+// were written.
+func (h *ImportsHandler) runImport(ctx context.Context, entries []imports.Entry, dryRun bool) (imports.Result, error) {
+	result, err := h.importer.Import(ctx, entries, dryRun)
+	if err == nil && !dryRun && result.Created > 0 {
+		h.suggestions.Invalidate()
+	}
+	return result, err
+}
+
+func (h *ImportsHandler) ImportTransactions(ctx context.Context, request api.ImportTransactionsRequestObject) (api.ImportTransactionsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("import transactions: missing request body")
+		return api.ImportTransactions400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ImportTransactions400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	opts := csvOptionsFromParams(request.Params)
+	entries, err := imports.ParseCSV(request.Body, opts)
+	if err != nil {
+		logger.Warn("import transactions: invalid csv", zap.Error(err))
+		return api.ImportTransactions400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ImportTransactions400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.runImport(ctx, entries, dryRun)
+	if err != nil {
+		logger.Error("import transactions: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"import transactions: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("created", result.Created),
+		zap.Int("skipped", result.Skipped),
+		zap.Int("failed", result.Failed),
+	)
+
+	return api.ImportTransactions200JSONResponse{
+		Body:    toAPIImportResult(result),
+		Headers: api.ImportTransactions200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *ImportsHandler) ImportTransactionsOfx(ctx context.Context, request api.ImportTransactionsOfxRequestObject) (api.ImportTransactionsOfxResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("import ofx: missing request body")
+		return api.ImportTransactionsOfx400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ImportTransactionsOfx400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	entries, err := imports.ParseOFX(request.Body)
+	if err != nil {
+		logger.Warn("import ofx: invalid file", zap.Error(err))
+		return api.ImportTransactionsOfx400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ImportTransactionsOfx400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.runImport(ctx, entries, dryRun)
+	if err != nil {
+		logger.Error("import ofx: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"import ofx: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("created", result.Created),
+		zap.Int("skipped", result.Skipped),
+		zap.Int("failed", result.Failed),
+	)
+
+	return api.ImportTransactionsOfx200JSONResponse{
+		Body:    toAPIImportResult(result),
+		Headers: api.ImportTransactionsOfx200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *ImportsHandler) ImportTransactionsCamt(ctx context.Context, request api.ImportTransactionsCamtRequestObject) (api.ImportTransactionsCamtResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("import camt: missing request body")
+		return api.ImportTransactionsCamt400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.ImportTransactionsCamt400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	entries, err := imports.ParseCAMT(request.Body)
+	if err != nil {
+		logger.Warn("import camt: invalid file", zap.Error(err))
+		return api.ImportTransactionsCamt400JSONResponse{
+			Body:    api.Error{Message: err.Error()},
+			Headers: api.ImportTransactionsCamt400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.runImport(ctx, entries, dryRun)
+	if err != nil {
+		logger.Error("import camt: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"import camt: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("created", result.Created),
+		zap.Int("skipped", result.Skipped),
+		zap.Int("failed", result.Failed),
+	)
+
+	return api.ImportTransactionsCamt200JSONResponse{
+		Body:    toAPIImportResult(result),
+		Headers: api.ImportTransactionsCamt200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func csvOptionsFromParams(params api.ImportTransactionsParams) imports.CSVOptions {
+	opts := imports.CSVOptions{
+		DateColumn:        "date",
+		AmountColumn:      "amount",
+		DescriptionColumn: "description",
+		DateLayout:        "2006-01-02",
+		Delimiter:         ',',
+		DecimalSeparator:  '.',
+	}
+
+	if params.DateColumn != nil {
+		opts.DateColumn = *params.DateColumn
+	}
+	if params.AmountColumn != nil {
+		opts.AmountColumn = *params.AmountColumn
+	}
+	if params.DescriptionColumn != nil {
+		opts.DescriptionColumn = *params.DescriptionColumn
+	}
+	if params.CategoryColumn != nil {
+		opts.CategoryColumn = *params.CategoryColumn
+	}
+	if params.DateFormat != nil {
+		if layout, ok := imports.DateLayout(string(*params.DateFormat)); ok {
+			opts.DateLayout = layout
+		}
+	}
+	if params.Delimiter != nil {
+		switch *params.Delimiter {
+		case api.ImportTransactionsParamsDelimiterSemicolon:
+			opts.Delimiter = ';'
+		case api.ImportTransactionsParamsDelimiterTab:
+			opts.Delimiter = '\t'
+		case api.ImportTransactionsParamsDelimiterPipe:
+			opts.Delimiter = '|'
+		}
+	}
+	if params.DecimalSeparator != nil && *params.DecimalSeparator == api.ImportTransactionsParamsDecimalSeparatorComma {
+		opts.DecimalSeparator = ','
+	}
+
+	return opts
+}
+
+func toAPIImportResult(result imports.Result) api.ImportResult {
+	rows := make([]api.ImportRowResult, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		rows = append(rows, api.ImportRowResult{
+			Line:          int32(row.Line),
+			Status:        api.ImportRowResultStatus(row.Status),
+			TransactionId: row.TransactionID,
+			Message:       row.Message,
+		})
+	}
+
+	return api.ImportResult{
+		DryRun:  result.DryRun,
+		Created: int32(result.Created),
+		Skipped: int32(result.Skipped),
+		Failed:  int32(result.Failed),
+		Rows:    rows,
+	}
+}
@@ -0,0 +1,229 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type payeeResponse struct {
+	ID       int64    `json:"id"`
+	Name     string   `json:"name"`
+	Patterns []string `json:"patterns"`
+}
+
+type payeeApplyResponse struct {
+	DryRun  bool `json:"dry_run"`
+	Changes []struct {
+		TransactionID int64 `json:"transaction_id"`
+		PayeeID       int64 `json:"payee_id"`
+	} `json:"changes"`
+}
+
+type payeeMergeResponse struct {
+	Target            payeeResponse `json:"target"`
+	TransactionsMoved int64         `json:"transactions_moved"`
+	PatternsMoved     int64         `json:"patterns_moved"`
+}
+
+type topPayeeListResponse struct {
+	Items []struct {
+		PayeeID          int64  `json:"payee_id"`
+		Name             string `json:"name"`
+		SpendingCents    int64  `json:"spending_cents"`
+		TransactionCount int64  `json:"transaction_count"`
+	} `json:"items"`
+}
+
+func TestPayees(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	amazon := createTestPayee(t, `{"name":" Amazon ","patterns":["^AMZN MKTP DE\\*","^amazon eu\\b"]}`)
+	bakery := createTestPayee(t, `{"name":"Zq-Bakery-2052"}`)
+	if amazon.Name != "Amazon" || len(amazon.Patterns) != 2 {
+		t.Fatalf("payee = %+v, want trimmed name and 2 patterns", amazon)
+	}
+
+	var grocer payeeResponse
+	var order, bread, groceries transactionResponse
+
+	t.Run("invalid payees", func(t *testing.T) {
+		for _, body := range []string{`{"name":"AMAZON"}`, `{"name":"Zq-Broken-2052","patterns":["("]}`} {
+			resp := doRequest(t, http.MethodPost, testServer.URL+"/payees", []byte(body))
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%s: status = %d, want 400", body, resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("normalize on create", func(t *testing.T) {
+		order = createTestTaggedTransaction(t, `{"transaction_date":"2052-03-02","amount_cents":-3499,"description":"AMZN MKTP DE*1A2B3"}`)
+		if order.PayeeID == nil || *order.PayeeID != amazon.ID {
+			t.Fatalf("payee_id = %v, want %d", order.PayeeID, amazon.ID)
+		}
+		bread = createTestTaggedTransaction(t, `{"transaction_date":"2052-03-03","amount_cents":-500,"description":"AMZN MKTP DE*bakery","payee_id":`+itoa(bakery.ID)+`}`)
+		if bread.PayeeID == nil || *bread.PayeeID != bakery.ID {
+			t.Fatalf("payee_id = %v, want the given %d", bread.PayeeID, bakery.ID)
+		}
+
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", []byte(`{"transaction_date":"2052-03-04","amount_cents":-100,"payee_id":999999999}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("unknown payee status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("update without payee clears it", func(t *testing.T) {
+		refund := createTestTaggedTransaction(t, `{"transaction_date":"2052-04-04","amount_cents":1299,"description":"AMZN MKTP DE*refund"}`)
+		if refund.PayeeID == nil || *refund.PayeeID != amazon.ID {
+			t.Fatalf("payee_id = %v, want %d", refund.PayeeID, amazon.ID)
+		}
+
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(refund.ID), []byte(`{"transaction_date":"2052-04-04","amount_cents":1299,"description":"AMZN MKTP DE*refund"}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		if got := getTestTransaction(t, refund.ID); got.PayeeID != nil {
+			t.Fatalf("payee_id = %d, want none", *got.PayeeID)
+		}
+	})
+
+	t.Run("apply to existing transactions", func(t *testing.T) {
+		groceries = createTestTaggedTransaction(t, `{"transaction_date":"2052-03-05","amount_cents":-4500,"description":"ZQGROCER 2052 STORE 12"}`)
+		if groceries.PayeeID != nil {
+			t.Fatalf("payee_id = %d, want none yet", *groceries.PayeeID)
+		}
+		grocer = createTestPayee(t, `{"name":"Zq-Grocer-2052","patterns":["^zqgrocer 2052"]}`)
+
+		preview := applyTestPayeePatterns(t, true)
+		if !preview.DryRun || !assignedTo(preview, groceries.ID, grocer.ID) {
+			t.Fatalf("dry run = %+v, want groceries assigned", preview)
+		}
+		if got := getTestTransaction(t, groceries.ID); got.PayeeID != nil {
+			t.Fatal("dry run linked the transaction")
+		}
+
+		applied := applyTestPayeePatterns(t, false)
+		if applied.DryRun || !assignedTo(applied, groceries.ID, grocer.ID) {
+			t.Fatalf("apply = %+v, want groceries assigned", applied)
+		}
+		if got := getTestTransaction(t, groceries.ID); got.PayeeID == nil || *got.PayeeID != grocer.ID {
+			t.Fatalf("payee_id = %v, want %d", got.PayeeID, grocer.ID)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		marketplace := createTestPayee(t, `{"name":"Zq-Marketplace-2052","patterns":["^zqmarketplace"]}`)
+		resale := createTestTaggedTransaction(t, `{"transaction_date":"2052-03-06","amount_cents":-2000,"description":"ZQMARKETPLACE 88"}`)
+		if resale.PayeeID == nil || *resale.PayeeID != marketplace.ID {
+			t.Fatalf("payee_id = %v, want %d", resale.PayeeID, marketplace.ID)
+		}
+
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/payees/"+itoa(marketplace.ID)+"/merge", []byte(`{"target_payee_id":`+itoa(marketplace.ID)+`}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("merge into self status = %d, want 400", resp.StatusCode)
+		}
+
+		resp = doRequest(t, http.MethodPost, testServer.URL+"/payees/"+itoa(marketplace.ID)+"/merge", []byte(`{"target_payee_id":`+itoa(amazon.ID)+`}`))
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("merge status = %d, want 200", resp.StatusCode)
+		}
+		var merged payeeMergeResponse
+		if err := json.NewDecoder(resp.Body).Decode(&merged); err != nil {
+			t.Fatalf("decode merge: %v", err)
+		}
+		if merged.TransactionsMoved != 1 || merged.PatternsMoved != 1 || len(merged.Target.Patterns) != 3 || merged.Target.Patterns[2] != "^zqmarketplace" {
+			t.Fatalf("merge = %+v, want 1 transaction and the pattern moved last", merged)
+		}
+		if got := getTestTransaction(t, resale.ID); got.PayeeID == nil || *got.PayeeID != amazon.ID {
+			t.Fatalf("payee_id = %v, want %d", got.PayeeID, amazon.ID)
+		}
+
+		list := listTestTransactions(t, "?from_date=2052-03-01&to_date=2052-03-31&payee_id="+itoa(amazon.ID))
+		if len(list.Items) != 2 {
+			t.Fatalf("filtered = %d items, want 2", len(list.Items))
+		}
+	})
+
+	t.Run("top payees", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/top-payees?from_date=2052-03-01&to_date=2052-03-31&limit=2", nil)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		var top topPayeeListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&top); err != nil {
+			t.Fatalf("decode top payees: %v", err)
+		}
+		if len(top.Items) != 2 {
+			t.Fatalf("top = %+v, want 2 payees", top.Items)
+		}
+		if first := top.Items[0]; first.PayeeID != amazon.ID || first.SpendingCents != 5499 || first.TransactionCount != 2 {
+			t.Fatalf("first = %+v, want Amazon with 5499 over 2", first)
+		}
+		if second := top.Items[1]; second.PayeeID != grocer.ID || second.SpendingCents != 4500 {
+			t.Fatalf("second = %+v, want the grocer with 4500", second)
+		}
+	})
+
+	t.Run("delete keeps transactions", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/payees/"+itoa(bakery.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete status = %d, want 204", resp.StatusCode)
+		}
+		if got := getTestTransaction(t, bread.ID); got.PayeeID != nil {
+			t.Fatalf("payee_id = %d, want none", *got.PayeeID)
+		}
+	})
+}
+
+func createTestPayee(t *testing.T, body string) payeeResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/payees", []byte(body))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created payeeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode payee: %v", err)
+	}
+	return created
+}
+
+func applyTestPayeePatterns(t *testing.T, dryRun bool) payeeApplyResponse {
+	t.Helper()
+
+	url := testServer.URL + "/payees/apply"
+	if dryRun {
+		url += "?dry_run=true"
+	}
+	resp := doRequest(t, http.MethodPost, url, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result payeeApplyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode apply result: %v", err)
+	}
+	return result
+}
+
+func assignedTo(result payeeApplyResponse, transactionID, payeeID int64) bool {
+	for _, c := range result.Changes {
+		if c.TransactionID == transactionID {
+			return c.PayeeID == payeeID
+		}
+	}
+	return false
+}
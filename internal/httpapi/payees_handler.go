@@ -0,0 +1,254 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/payees"
+)
+
+type PayeesHandler struct {
+	service *payees.Service
+	logger  *zap.Logger
+}
+
+func NewPayeesHandler(service *payees.Service, logger *zap.Logger) *PayeesHandler {
+	return &PayeesHandler{service: service, logger: logger}
+}
+
+func (h *PayeesHandler) CreatePayee(ctx context.Context, request api.CreatePayeeRequestObject) (api.CreatePayeeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create payee: missing request body")
+		return api.CreatePayee400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreatePayee400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	created, err := h.service.Create(ctx, payees.CreateInput{
+		Name:     request.Body.Name,
+		Patterns: stringsValue(request.Body.Patterns),
+	})
+	if err != nil {
+		if msg, ok := payeeValidationMessage(err); ok {
+			return api.CreatePayee400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.CreatePayee400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create payee: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create payee: created", zap.Int64("payee_id", created.ID))
+
+	return api.CreatePayee201JSONResponse{
+		Body:    toAPIPayee(created),
+		Headers: api.CreatePayee201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) DeletePayee(ctx context.Context, request api.DeletePayeeRequestObject) (api.DeletePayeeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.service.Delete(ctx, request.PayeeId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeletePayee404JSONResponse{
+				Body:    api.Error{Message: "payee not found"},
+				Headers: api.DeletePayee404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete payee: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete payee: deleted", zap.Int64("payee_id", request.PayeeId))
+
+	return api.DeletePayee204Response{
+		Headers: api.DeletePayee204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) GetPayee(ctx context.Context, request api.GetPayeeRequestObject) (api.GetPayeeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	payee, err := h.service.Get(ctx, request.PayeeId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetPayee404JSONResponse{
+				Body:    api.Error{Message: "payee not found"},
+				Headers: api.GetPayee404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get payee: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetPayee200JSONResponse{
+		Body:    toAPIPayee(payee),
+		Headers: api.GetPayee200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) ListPayees(ctx context.Context, request api.ListPayeesRequestObject) (api.ListPayeesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error("list payees: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.Payee, 0, len(list))
+	for _, p := range list {
+		items = append(items, toAPIPayee(p))
+	}
+
+	return api.ListPayees200JSONResponse{
+		Body:    api.PayeeList{Items: items},
+		Headers: api.ListPayees200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) UpdatePayee(ctx context.Context, request api.UpdatePayeeRequestObject) (api.UpdatePayeeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update payee: missing request body")
+		return api.UpdatePayee400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdatePayee400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, err := h.service.Update(ctx, request.PayeeId, payees.UpdateInput{
+		Name:     request.Body.Name,
+		Patterns: stringsValue(request.Body.Patterns),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdatePayee404JSONResponse{
+				Body:    api.Error{Message: "payee not found"},
+				Headers: api.UpdatePayee404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := payeeValidationMessage(err); ok {
+			return api.UpdatePayee400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdatePayee400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update payee: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdatePayee200JSONResponse{
+		Body:    toAPIPayee(updated),
+		Headers: api.UpdatePayee200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) MergePayee(ctx context.Context, request api.MergePayeeRequestObject) (api.MergePayeeResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("merge payee: missing request body")
+		return api.MergePayee400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.MergePayee400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	result, err := h.service.Merge(ctx, request.PayeeId, request.Body.TargetPayeeId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.MergePayee404JSONResponse{
+				Body:    api.Error{Message: "payee not found"},
+				Headers: api.MergePayee404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, payees.ErrMergeIntoSelf) || errors.Is(err, payees.ErrTargetNotFound) {
+			return api.MergePayee400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.MergePayee400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("merge payee: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"merge payee: merged",
+		zap.Int64("payee_id", request.PayeeId),
+		zap.Int64("target_payee_id", result.Target.ID),
+		zap.Int64("transactions_moved", result.TransactionsMoved),
+	)
+
+	return api.MergePayee200JSONResponse{
+		Body: api.PayeeMergeResult{
+			Target:            toAPIPayee(result.Target),
+			TransactionsMoved: result.TransactionsMoved,
+			PatternsMoved:     result.PatternsMoved,
+		},
+		Headers: api.MergePayee200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *PayeesHandler) ApplyPayeePatterns(ctx context.Context, request api.ApplyPayeePatternsRequestObject) (api.ApplyPayeePatternsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	result, err := h.service.Apply(ctx, dryRun)
+	if err != nil {
+		logger.Error("apply payee patterns: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"apply payee patterns: done",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("changes", len(result.Changes)),
+	)
+
+	changes := make([]api.PayeeAssignment, 0, len(result.Changes))
+	for _, c := range result.Changes {
+		changes = append(changes, api.PayeeAssignment{
+			TransactionId: c.TransactionID,
+			Description:   c.Description,
+			PayeeId:       c.PayeeID,
+		})
+	}
+
+	return api.ApplyPayeePatterns200JSONResponse{
+		Body:    api.PayeeApplyResult{DryRun: result.DryRun, Changes: changes},
+		Headers: api.ApplyPayeePatterns200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// payeeValidationMessage maps payee errors that are the caller's fault to a
+// 400 message.
+func payeeValidationMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, payees.ErrBlankName),
+		errors.Is(err, payees.ErrInvalidPattern):
+		return err.Error(), true
+	case db.IsUniqueViolation(err):
+		return "payee name already exists", true
+	}
+	return "", false
+}
+
+func toAPIPayee(p payees.Payee) api.Payee {
+	return api.Payee{
+		Id:        p.ID,
+		Name:      p.Name,
+		Patterns:  p.Patterns,
+		CreatedAt: p.CreatedAt,
+	}
+}
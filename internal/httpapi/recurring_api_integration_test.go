@@ -0,0 +1,291 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type recurringRuleResponse struct {
+	ID          int64   `json:"id"`
+	Frequency   string  `json:"frequency"`
+	Every       int32   `json:"every"`
+	StartDate   string  `json:"start_date"`
+	EndDate     *string `json:"end_date"`
+	NextDate    string  `json:"next_date"`
+	AmountCents int64   `json:"amount_cents"`
+	CategoryID  *int64  `json:"category_id"`
+	LastError   *string `json:"last_error"`
+}
+
+type recurringRunResponse struct {
+	RulesRun            int32 `json:"rules_run"`
+	TransactionsCreated int32 `json:"transactions_created"`
+	Failures            []struct {
+		RuleID  int64  `json:"rule_id"`
+		Message string `json:"message"`
+	} `json:"failures"`
+}
+
+func TestRecurringRules(t *testing.T) {
+	rent := createTestCategory(t, "Recurring-Rent")
+	housing := createTestCategory(t, "Recurring-Housing")
+
+	body := []byte(`{"frequency":"monthly","start_date":"2045-01-31","end_date":"2045-12-31","amount_cents":-95000,"category_id":` + itoa(rent.ID) + `,"description":"rent"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+	var rule recurringRuleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rule); err != nil {
+		t.Fatalf("decode rule: %v", err)
+	}
+	if rule.Every != 1 || rule.NextDate != "2045-01-31" {
+		t.Fatalf("rule = %+v, want every 1 and next date 2045-01-31", rule)
+	}
+
+	run := runTestRecurringRules(t, "2045-04-30")
+	if run.TransactionsCreated < 4 {
+		t.Fatalf("transactions created = %d, want at least 4", run.TransactionsCreated)
+	}
+
+	booked := recurringTransactions(t, rule.ID)
+	wantDates := []string{"2045-01-31", "2045-02-28", "2045-03-31", "2045-04-30"}
+	if len(booked) != len(wantDates) {
+		t.Fatalf("booked %d transactions, want %d", len(booked), len(wantDates))
+	}
+	for i, date := range wantDates {
+		if booked[i].TransactionDate != date || booked[i].AmountCents != -95000 {
+			t.Fatalf("occurrence %d = %+v, want %s", i, booked[i], date)
+		}
+	}
+
+	t.Run("catching up again books nothing twice", func(t *testing.T) {
+		runTestRecurringRules(t, "2045-04-30")
+		if got := len(recurringTransactions(t, rule.ID)); got != len(wantDates) {
+			t.Fatalf("booked %d transactions, want %d", got, len(wantDates))
+		}
+
+		rule := getTestRecurringRule(t, rule.ID)
+		if rule.NextDate != "2045-05-31" {
+			t.Fatalf("next date = %s, want 2045-05-31", rule.NextDate)
+		}
+	})
+
+	t.Run("update applies to occurrences from a date", func(t *testing.T) {
+		body := []byte(`{"frequency":"monthly","start_date":"2045-01-31","end_date":"2045-12-31","amount_cents":-99000,"category_id":` + itoa(housing.ID) + `,"description":"rent","apply_from":"2045-03-01"}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/recurring-rules/"+itoa(rule.ID), body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		var result struct {
+			Rule                recurringRuleResponse `json:"rule"`
+			TransactionsUpdated int64                 `json:"transactions_updated"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode result: %v", err)
+		}
+		if result.TransactionsUpdated != 2 {
+			t.Fatalf("transactions updated = %d, want 2", result.TransactionsUpdated)
+		}
+		if result.Rule.NextDate != "2045-05-31" {
+			t.Fatalf("next date = %s, want 2045-05-31", result.Rule.NextDate)
+		}
+
+		booked := recurringTransactions(t, rule.ID)
+		if booked[1].AmountCents != -95000 || *booked[1].CategoryID != rent.ID {
+			t.Fatalf("february occurrence changed: %+v", booked[1])
+		}
+		if booked[2].AmountCents != -99000 || *booked[2].CategoryID != housing.ID {
+			t.Fatalf("march occurrence not updated: %+v", booked[2])
+		}
+	})
+
+	t.Run("invalid schedule is rejected", func(t *testing.T) {
+		body := []byte(`{"frequency":"weekly","every":0,"start_date":"2045-01-01","amount_cents":-100}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+
+		body = []byte(`{"frequency":"weekly","start_date":"2045-02-01","end_date":"2045-01-01","amount_cents":-100}`)
+		resp2 := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		defer resp2.Body.Close()
+
+		if resp2.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp2.StatusCode)
+		}
+	})
+
+	t.Run("archived category is reported on the rule", func(t *testing.T) {
+		gym := createTestCategory(t, "Recurring-Gym-2045")
+		body := []byte(`{"frequency":"monthly","start_date":"2045-02-10","end_date":"2045-02-10","amount_cents":-3000,"category_id":` + itoa(gym.ID) + `,"description":"gym"}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		var gymRule recurringRuleResponse
+		if err := json.NewDecoder(resp.Body).Decode(&gymRule); err != nil {
+			t.Fatalf("decode rule: %v", err)
+		}
+		resp.Body.Close()
+
+		archive := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(gym.ID)+"/archive", nil)
+		archive.Body.Close()
+		if archive.StatusCode != http.StatusOK {
+			t.Fatalf("archive status = %d, want 200", archive.StatusCode)
+		}
+
+		rejected := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		rejected.Body.Close()
+		if rejected.StatusCode != http.StatusBadRequest {
+			t.Fatalf("create with archived category status = %d, want 400", rejected.StatusCode)
+		}
+
+		run := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules/run?through=2045-04-30", nil)
+		var result recurringRunResponse
+		if err := json.NewDecoder(run.Body).Decode(&result); err != nil {
+			t.Fatalf("decode run: %v", err)
+		}
+		run.Body.Close()
+		if len(result.Failures) != 1 || result.Failures[0].RuleID != gymRule.ID {
+			t.Fatalf("failures = %+v, want the gym rule", result.Failures)
+		}
+		if broken := getTestRecurringRule(t, gymRule.ID); broken.LastError == nil || broken.NextDate != "2045-02-10" {
+			t.Fatalf("broken rule = %+v, want a last error and no booking", broken)
+		}
+
+		del := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(gym.ID), nil)
+		del.Body.Close()
+		if del.StatusCode != http.StatusConflict {
+			t.Fatalf("delete category status = %d, want 409", del.StatusCode)
+		}
+
+		// Keeping the archived category is not a change that needs checking.
+		kept := []byte(`{"frequency":"monthly","start_date":"2045-02-10","end_date":"2045-02-10","amount_cents":-3500,"category_id":` + itoa(gym.ID) + `,"description":"gym"}`)
+		update := doRequest(t, http.MethodPut, testServer.URL+"/recurring-rules/"+itoa(gymRule.ID), kept)
+		update.Body.Close()
+		if update.StatusCode != http.StatusOK {
+			t.Fatalf("update keeping the archived category status = %d, want 200", update.StatusCode)
+		}
+
+		unarchive := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(gym.ID)+"/archive", nil)
+		unarchive.Body.Close()
+		runTestRecurringRules(t, "2045-04-30")
+		if fixed := getTestRecurringRule(t, gymRule.ID); fixed.LastError != nil {
+			t.Fatalf("last error = %q after a successful run, want none", *fixed.LastError)
+		}
+	})
+
+	t.Run("merge moves rules to the target", func(t *testing.T) {
+		source := createTestCategory(t, "Recurring-Gym-Old-2045")
+		body := []byte(`{"frequency":"monthly","start_date":"2045-03-10","end_date":"2045-03-10","amount_cents":-3000,"category_id":` + itoa(source.ID) + `,"description":"gym"}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules", body)
+		var moved recurringRuleResponse
+		if err := json.NewDecoder(resp.Body).Decode(&moved); err != nil {
+			t.Fatalf("decode rule: %v", err)
+		}
+		resp.Body.Close()
+
+		merge := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(source.ID)+"/merge", []byte(`{"target_category_id":`+itoa(housing.ID)+`}`))
+		var result struct {
+			RecurringRulesMoved int64 `json:"recurring_rules_moved"`
+		}
+		if err := json.NewDecoder(merge.Body).Decode(&result); err != nil {
+			t.Fatalf("decode merge: %v", err)
+		}
+		merge.Body.Close()
+		if result.RecurringRulesMoved != 1 {
+			t.Fatalf("recurring rules moved = %d, want 1", result.RecurringRulesMoved)
+		}
+		if rule := getTestRecurringRule(t, moved.ID); rule.CategoryID == nil || *rule.CategoryID != housing.ID {
+			t.Fatalf("rule category = %v, want %d", rule.CategoryID, housing.ID)
+		}
+	})
+
+	t.Run("deleting a rule keeps its transactions", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/recurring-rules/"+itoa(rule.ID), nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+
+		var kept int
+		for _, tx := range listTransactionsInRange(t, "2045-01-01", "2045-12-31").Items {
+			if tx.Description == nil || *tx.Description != "rent" {
+				continue
+			}
+			if tx.RecurringRuleID != nil {
+				t.Fatalf("transaction %d still linked to deleted rule", tx.ID)
+			}
+			kept++
+		}
+		if kept != len(wantDates) {
+			t.Fatalf("kept %d transactions, want %d", kept, len(wantDates))
+		}
+
+		resp = doRequest(t, http.MethodGet, testServer.URL+"/recurring-rules/"+itoa(rule.ID), nil)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("status = %d, want 404", resp.StatusCode)
+		}
+	})
+}
+
+func runTestRecurringRules(t *testing.T, through string) recurringRunResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/recurring-rules/run?through="+through, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var result recurringRunResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode run: %v", err)
+	}
+	if len(result.Failures) > 0 {
+		t.Fatalf("run failures: %+v", result.Failures)
+	}
+	return result
+}
+
+func getTestRecurringRule(t *testing.T, id int64) recurringRuleResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/recurring-rules/"+itoa(id), nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var rule recurringRuleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rule); err != nil {
+		t.Fatalf("decode rule: %v", err)
+	}
+	return rule
+}
+
+// recurringTransactions returns the transactions booked by a rule in 2045,
+// oldest first.
+func recurringTransactions(t *testing.T, ruleID int64) []transactionResponse {
+	t.Helper()
+
+	list := listTransactionsInRange(t, "2045-01-01", "2045-12-31")
+	var booked []transactionResponse
+	for i := len(list.Items) - 1; i >= 0; i-- {
+		tx := list.Items[i]
+		if tx.RecurringRuleID != nil && *tx.RecurringRuleID == ruleID {
+			booked = append(booked, tx)
+		}
+	}
+	return booked
+}
@@ -0,0 +1,269 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type RecurringHandler struct {
+	service *recurring.Service
+	logger  *zap.Logger
+}
+
+func NewRecurringHandler(service *recurring.Service, logger *zap.Logger) *RecurringHandler {
+	return &RecurringHandler{service: service, logger: logger}
+}
+
+func (h *RecurringHandler) CreateRecurringRule(ctx context.Context, request api.CreateRecurringRuleRequestObject) (api.CreateRecurringRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create recurring rule: missing request body")
+		return api.CreateRecurringRule400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateRecurringRule400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	created, err := h.service.Create(ctx, recurring.CreateInput{
+		Frequency:   recurring.Frequency(request.Body.Frequency),
+		Every:       everyValue(request.Body.Every),
+		StartDate:   request.Body.StartDate.Time,
+		EndDate:     datePtrValue(request.Body.EndDate),
+		AmountCents: request.Body.AmountCents,
+		AccountID:   request.Body.AccountId,
+		CategoryID:  request.Body.CategoryId,
+		Description: request.Body.Description,
+	})
+	if err != nil {
+		if msg, ok := recurringValidationMessage(err); ok {
+			return api.CreateRecurringRule400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.CreateRecurringRule400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create recurring rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create recurring rule: created", zap.Int64("rule_id", created.ID))
+
+	return api.CreateRecurringRule201JSONResponse{
+		Body:    toAPIRecurringRule(created),
+		Headers: api.CreateRecurringRule201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *RecurringHandler) DeleteRecurringRule(ctx context.Context, request api.DeleteRecurringRuleRequestObject) (api.DeleteRecurringRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.service.Delete(ctx, request.RuleId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteRecurringRule404JSONResponse{
+				Body:    api.Error{Message: "recurring rule not found"},
+				Headers: api.DeleteRecurringRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete recurring rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete recurring rule: deleted", zap.Int64("rule_id", request.RuleId))
+
+	return api.DeleteRecurringRule204Response{
+		Headers: api.DeleteRecurringRule204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *RecurringHandler) GetRecurringRule(ctx context.Context, request api.GetRecurringRuleRequestObject) (api.GetRecurringRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	rule, err := h.service.Get(ctx, request.RuleId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetRecurringRule404JSONResponse{
+				Body:    api.Error{Message: "recurring rule not found"},
+				Headers: api.GetRecurringRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get recurring rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetRecurringRule200JSONResponse{
+		Body:    toAPIRecurringRule(rule),
+		Headers: api.GetRecurringRule200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *RecurringHandler) ListRecurringRules(ctx context.Context, request api.ListRecurringRulesRequestObject) (api.ListRecurringRulesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error("list recurring rules: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.RecurringRule, 0, len(list))
+	for _, r := range list {
+		items = append(items, toAPIRecurringRule(r))
+	}
+
+	return api.ListRecurringRules200JSONResponse{
+		Body:    api.RecurringRuleList{Items: items},
+		Headers: api.ListRecurringRules200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *RecurringHandler) UpdateRecurringRule(ctx context.Context, request api.UpdateRecurringRuleRequestObject) (api.UpdateRecurringRuleResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update recurring rule: missing request body")
+		return api.UpdateRecurringRule400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateRecurringRule400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, changed, err := h.service.Update(ctx, request.RuleId, recurring.UpdateInput{
+		Frequency:   recurring.Frequency(request.Body.Frequency),
+		Every:       everyValue(request.Body.Every),
+		StartDate:   request.Body.StartDate.Time,
+		EndDate:     datePtrValue(request.Body.EndDate),
+		AmountCents: request.Body.AmountCents,
+		AccountID:   request.Body.AccountId,
+		CategoryID:  request.Body.CategoryId,
+		Description: request.Body.Description,
+		ApplyFrom:   datePtrValue(request.Body.ApplyFrom),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateRecurringRule404JSONResponse{
+				Body:    api.Error{Message: "recurring rule not found"},
+				Headers: api.UpdateRecurringRule404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := recurringValidationMessage(err); ok {
+			return api.UpdateRecurringRule400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdateRecurringRule400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update recurring rule: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info(
+		"update recurring rule: updated",
+		zap.Int64("rule_id", updated.ID),
+		zap.Int64("transactions_updated", changed),
+	)
+
+	return api.UpdateRecurringRule200JSONResponse{
+		Body: api.RecurringRuleUpdateResult{
+			Rule:                toAPIRecurringRule(updated),
+			TransactionsUpdated: changed,
+		},
+		Headers: api.UpdateRecurringRule200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *RecurringHandler) RunRecurringRules(ctx context.Context, request api.RunRecurringRulesRequestObject) (api.RunRecurringRulesResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+
+	now := time.Now()
+	through := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if request.Params.Through != nil {
+		through = request.Params.Through.Time
+	}
+
+	result, err := h.service.Run(ctx, through)
+	if err != nil {
+		logger.Error("run recurring rules: db error", zap.Error(err))
+		return nil, err
+	}
+
+	failures := make([]api.RecurringRunFailure, 0, len(result.Failures))
+	for _, f := range result.Failures {
+		logger.Warn("run recurring rules: rule failed", zap.Int64("rule_id", f.RuleID), zap.Error(f.Err))
+		failures = append(failures, api.RecurringRunFailure{RuleId: f.RuleID, Message: f.Err.Error()})
+	}
+
+	logger.Info(
+		"run recurring rules: done",
+		zap.Int("rules", result.RulesRun),
+		zap.Int("transactions", result.TransactionsCreated),
+	)
+
+	return api.RunRecurringRules200JSONResponse{
+		Body: api.RecurringRunResult{
+			RulesRun:            int32(result.RulesRun),
+			TransactionsCreated: int32(result.TransactionsCreated),
+			Failures:            failures,
+		},
+		Headers: api.RunRecurringRules200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// recurringValidationMessage maps rule errors that are the caller's fault to
+// a 400 message.
+func recurringValidationMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, recurring.ErrInvalidFrequency),
+		errors.Is(err, recurring.ErrInvalidEvery),
+		errors.Is(err, recurring.ErrEndBeforeStart),
+		errors.Is(err, transactions.ErrArchivedCategory),
+		errors.Is(err, transactions.ErrDeletedCategory):
+		return err.Error(), true
+	case db.IsForeignKeyViolation(err):
+		return "account or category not found", true
+	}
+	return "", false
+}
+
+func everyValue(v *int32) int {
+	if v == nil {
+		return 1
+	}
+	return int(*v)
+}
+
+func datePtrValue(d *types.Date) *time.Time {
+	if d == nil {
+		return nil
+	}
+	t := d.Time
+	return &t
+}
+
+func toAPIRecurringRule(r recurring.Rule) api.RecurringRule {
+	rule := api.RecurringRule{
+		Id:          r.ID,
+		Frequency:   api.RecurringFrequency(r.Frequency),
+		Every:       int32(r.Every),
+		StartDate:   types.Date{Time: r.StartDate},
+		NextDate:    types.Date{Time: r.NextDate},
+		AmountCents: r.AmountCents,
+		AccountId:   r.AccountID,
+		CategoryId:  r.CategoryID,
+		Description: r.Description,
+		CreatedAt:   r.CreatedAt,
+		LastError:   r.LastError,
+		LastErrorAt: r.LastErrorAt,
+	}
+	if r.EndDate != nil {
+		rule.EndDate = &types.Date{Time: *r.EndDate}
+	}
+	return rule
+}
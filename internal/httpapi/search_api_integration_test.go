@@ -0,0 +1,89 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSearchTransactions(t *testing.T) {
+	category := createTestCategory(t, "Search-Shopping")
+	createTestTransaction(t, category.ID, "2050-04-02", -3499, "AMZN Mktp order 4411")
+	createTestTransaction(t, category.ID, "2050-04-05", -1299, "Zqamazonia books")
+	createTestTransaction(t, category.ID, "2050-04-09", -899, "Amazon Prime monthly")
+	createTestTransaction(t, category.ID, "2050-04-12", 2500, "Amazon refund order 4411")
+	createTestTransaction(t, category.ID, "2050-04-15", -4200, "Corner bakery")
+
+	const window = "&from_date=2050-04-01&to_date=2050-04-30"
+
+	t.Run("prefix match, newest first", func(t *testing.T) {
+		list := searchTestTransactions(t, "AMAZ", window)
+		if len(list.Items) != 2 {
+			t.Fatalf("items = %d, want 2", len(list.Items))
+		}
+		if *list.Items[0].Description != "Amazon refund order 4411" || *list.Items[1].Description != "Amazon Prime monthly" {
+			t.Fatalf("items = %+v, want the refund then Prime", list.Items)
+		}
+		for _, item := range list.Items {
+			if item.SearchRank == nil || *item.SearchRank <= 0 {
+				t.Fatalf("search_rank = %v, want a positive rank", item.SearchRank)
+			}
+		}
+	})
+
+	t.Run("every word must match", func(t *testing.T) {
+		list := searchTestTransactions(t, "order 4411", window)
+		if len(list.Items) != 2 {
+			t.Fatalf("items = %d, want 2", len(list.Items))
+		}
+	})
+
+	t.Run("combines with filters", func(t *testing.T) {
+		list := searchTestTransactions(t, "order", window+"&type=spending&category_id="+itoa(category.ID))
+		if len(list.Items) != 1 || *list.Items[0].Description != "AMZN Mktp order 4411" {
+			t.Fatalf("items = %+v, want the spending order only", list.Items)
+		}
+	})
+
+	t.Run("pages with the keyset cursor", func(t *testing.T) {
+		first := searchTestTransactions(t, "amazon", window+"&limit=1")
+		if len(first.Items) != 1 {
+			t.Fatalf("first page = %d items, want 1", len(first.Items))
+		}
+		cursor := "&after_date=" + first.Items[0].TransactionDate + "&after_id=" + itoa(first.Items[0].ID)
+		second := searchTestTransactions(t, "amazon", window+"&limit=1"+cursor)
+		if len(second.Items) != 1 || second.Items[0].ID == first.Items[0].ID {
+			t.Fatalf("second page = %+v, want the next match", second.Items)
+		}
+		cursor = "&after_date=" + second.Items[0].TransactionDate + "&after_id=" + itoa(second.Items[0].ID)
+		if third := searchTestTransactions(t, "amazon", window+"&limit=1"+cursor); len(third.Items) != 0 {
+			t.Fatalf("third page = %+v, want none", third.Items)
+		}
+	})
+
+	t.Run("no words", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions?q="+url.QueryEscape("&!*"), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+}
+
+func searchTestTransactions(t *testing.T, q, params string) transactionListResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions?q="+url.QueryEscape(q)+params, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list transactionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	return list
+}
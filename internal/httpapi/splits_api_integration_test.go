@@ -0,0 +1,97 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type splitResponse struct {
+	CategoryID  int64 `json:"category_id"`
+	AmountCents int64 `json:"amount_cents"`
+}
+
+func TestSplitTransactions(t *testing.T) {
+	groceries := createTestCategory(t, "Split-Groceries")
+	household := createTestCategory(t, "Split-Household")
+
+	body := []byte(`{"transaction_date":"2039-03-04","amount_cents":-10000,"category_id":` + itoa(groceries.ID) + `,"description":"supermarket",` +
+		`"splits":[{"category_id":` + itoa(groceries.ID) + `,"amount_cents":-7000},{"category_id":` + itoa(household.ID) + `,"amount_cents":-3000,"description":"detergent"}]}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+	var created struct {
+		ID     int64           `json:"id"`
+		Splits []splitResponse `json:"splits"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(created.Splits) != 2 {
+		t.Fatalf("splits = %d, want 2", len(created.Splits))
+	}
+
+	t.Run("mismatched sum is rejected", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2039-03-05","amount_cents":-10000,"splits":[{"category_id":` + itoa(groceries.ID) + `,"amount_cents":-9000}]}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+
+		resp = doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.ID), body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("update status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("summary aggregates by split line", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/transactions-summary?year=2039", nil)
+		defer resp.Body.Close()
+
+		var summary summaryResponse
+		if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+			t.Fatalf("decode summary: %v", err)
+		}
+		groceriesRow, _ := findSummaryRow(summary.Spending.Rows, groceries.ID)
+		householdRow, _ := findSummaryRow(summary.Spending.Rows, household.ID)
+		if groceriesRow.Values[2] != 7000 || householdRow.Values[2] != 3000 {
+			t.Fatalf("march spending = %d/%d, want 7000/3000", groceriesRow.Values[2], householdRow.Values[2])
+		}
+	})
+
+	t.Run("category filter matches split lines", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions?from_date=2039-01-01&to_date=2039-12-31&category_id="+itoa(household.ID), nil)
+		defer resp.Body.Close()
+
+		var list transactionListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("decode list: %v", err)
+		}
+		if len(list.Items) != 1 || list.Items[0].ID != created.ID {
+			t.Fatalf("unexpected list: %+v", list.Items)
+		}
+	})
+
+	t.Run("update without splits clears them", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2039-03-04","amount_cents":-10000,"category_id":` + itoa(groceries.ID) + `}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.ID), body)
+		defer resp.Body.Close()
+
+		var updated struct {
+			Splits []splitResponse `json:"splits"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(updated.Splits) != 0 {
+			t.Fatalf("splits = %d, want 0", len(updated.Splits))
+		}
+	})
+}
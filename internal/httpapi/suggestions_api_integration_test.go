@@ -0,0 +1,97 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+type categorySuggestionListResponse struct {
+	Items []struct {
+		CategoryID  int64   `json:"category_id"`
+		Probability float64 `json:"probability"`
+	} `json:"items"`
+}
+
+func TestSuggestCategories(t *testing.T) {
+	groceries := createTestCategory(t, "Suggest-Groceries")
+	travel := createTestCategory(t, "Suggest-Travel")
+
+	createTestTransaction(t, groceries.ID, "2048-01-03", -5230, "CARD 1234 ZQMARKET CENTRE")
+	createTestTransaction(t, groceries.ID, "2048-01-10", -1899, "Zqmarket city 10/01")
+	createTestTransaction(t, groceries.ID, "2048-01-17", -4410, "ZQMARKET")
+	createTestTransaction(t, travel.ID, "2048-01-05", -8900, "Zqrail voyages")
+	createTestTransaction(t, travel.ID, "2048-01-20", -1250, "ZQRAIL TER 2211")
+
+	list := getTestSuggestions(t, "zqmarket express 9981", -2450)
+	if len(list.Items) == 0 || list.Items[0].CategoryID != groceries.ID {
+		t.Fatalf("suggestions = %+v, want %d first", list.Items, groceries.ID)
+	}
+	if p := list.Items[0].Probability; p <= 0.5 || p > 1 {
+		t.Fatalf("probability = %f, want a confident guess", p)
+	}
+
+	t.Run("learns new transactions incrementally", func(t *testing.T) {
+		if list := getTestSuggestions(t, "zqferry crossing", -6000); len(list.Items) != 0 {
+			t.Fatalf("suggestions = %+v, want none for unseen words", list.Items)
+		}
+
+		body := []byte(`{"transaction_date":"2048-02-01","amount_cents":-6000,"category_id":` + itoa(travel.ID) + `,"description":"ZQFERRY crossing"}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", body)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create status = %d, want 201", resp.StatusCode)
+		}
+		var ferry transactionResponse
+		if err := json.NewDecoder(resp.Body).Decode(&ferry); err != nil {
+			t.Fatalf("decode transaction: %v", err)
+		}
+
+		list := getTestSuggestions(t, "zqferry crossing", -6000)
+		if len(list.Items) == 0 || list.Items[0].CategoryID != travel.ID {
+			t.Fatalf("suggestions = %+v, want %d first", list.Items, travel.ID)
+		}
+
+		body = []byte(`{"transaction_date":"2048-02-01","amount_cents":-6000,"category_id":` + itoa(groceries.ID) + `,"description":"ZQFERRY crossing"}`)
+		update := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(ferry.ID), body)
+		update.Body.Close()
+		if update.StatusCode != http.StatusOK {
+			t.Fatalf("update status = %d, want 200", update.StatusCode)
+		}
+
+		list = getTestSuggestions(t, "zqferry", -6000)
+		if len(list.Items) == 0 || list.Items[0].CategoryID != groceries.ID {
+			t.Fatalf("suggestions = %+v, want %d first after the update", list.Items, groceries.ID)
+		}
+	})
+
+	t.Run("archived categories are not suggested", func(t *testing.T) {
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/categories/"+itoa(travel.ID)+"/archive", nil)
+		resp.Body.Close()
+
+		for _, item := range getTestSuggestions(t, "zqrail", -1000).Items {
+			if item.CategoryID == travel.ID {
+				t.Fatalf("archived category %d was suggested", travel.ID)
+			}
+		}
+	})
+}
+
+func getTestSuggestions(t *testing.T, description string, amountCents int64) categorySuggestionListResponse {
+	t.Helper()
+
+	query := "?description=" + url.QueryEscape(description) + "&amount_cents=" + itoa(amountCents)
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/categories/suggestions"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list categorySuggestionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode suggestions: %v", err)
+	}
+	return list
+}
@@ -0,0 +1,206 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+type tagResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type tagTotalListResponse struct {
+	Items []struct {
+		TagID            int64  `json:"tag_id"`
+		Name             string `json:"name"`
+		SpendingCents    int64  `json:"spending_cents"`
+		IncomeCents      int64  `json:"income_cents"`
+		TransactionCount int64  `json:"transaction_count"`
+	} `json:"items"`
+}
+
+func TestTags(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	vacation := createTestTag(t, "  Zq-Vacation-2051 ")
+	reimbursable := createTestTag(t, "zq-reimbursable")
+	if vacation.Name != "Zq-Vacation-2051" {
+		t.Fatalf("name = %q, want it trimmed", vacation.Name)
+	}
+
+	var hotel, train transactionResponse
+
+	t.Run("duplicate name ignoring case", func(t *testing.T) {
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/tags", []byte(`{"name":"ZQ-VACATION-2051"}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("tag transactions", func(t *testing.T) {
+		hotel = createTestTaggedTransaction(t, `{"transaction_date":"2051-07-02","amount_cents":-42000,"description":"hotel","tags":["zq-vacation-2051","ZQ-REIMBURSABLE"]}`)
+		if want := []string{"zq-reimbursable", "Zq-Vacation-2051"}; !reflect.DeepEqual(hotel.Tags, want) {
+			t.Fatalf("tags = %v, want %v", hotel.Tags, want)
+		}
+		train = createTestTaggedTransaction(t, `{"transaction_date":"2051-07-01","amount_cents":-8900,"description":"train","tags":["Zq-Vacation-2051"]}`)
+		createTestTaggedTransaction(t, `{"transaction_date":"2051-07-03","amount_cents":15000,"description":"expense refund","tags":["zq-reimbursable"]}`)
+		createTestTaggedTransaction(t, `{"transaction_date":"2051-07-04","amount_cents":-500,"description":"coffee"}`)
+
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", []byte(`{"transaction_date":"2051-07-05","amount_cents":-100,"tags":["zq-unknown"]}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("unknown tag status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("filter any and all", func(t *testing.T) {
+		const window = "?from_date=2051-07-01&to_date=2051-07-31&limit=50"
+		anyList := listTestTransactions(t, window+"&tag=zq-vacation-2051&tag=zq-reimbursable")
+		if len(anyList.Items) != 3 {
+			t.Fatalf("any = %d items, want 3", len(anyList.Items))
+		}
+		allList := listTestTransactions(t, window+"&tag=zq-vacation-2051&tag=zq-reimbursable&tag_mode=all")
+		if len(allList.Items) != 1 || allList.Items[0].ID != hotel.ID {
+			t.Fatalf("all = %+v, want only %d", allList.Items, hotel.ID)
+		}
+		spending := listTestTransactions(t, window+"&tag=zq-reimbursable&type=spending")
+		if len(spending.Items) != 1 || spending.Items[0].ID != hotel.ID {
+			t.Fatalf("reimbursable spending = %+v, want only %d", spending.Items, hotel.ID)
+		}
+	})
+
+	t.Run("update keeps tags unless sent", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2051-07-01","amount_cents":-9900,"description":"train"}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(train.ID), body)
+		var updated transactionResponse
+		if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+			t.Fatalf("decode transaction: %v", err)
+		}
+		resp.Body.Close()
+		if len(updated.Tags) != 1 {
+			t.Fatalf("tags = %v, want them kept", updated.Tags)
+		}
+
+		body = []byte(`{"transaction_date":"2051-07-01","amount_cents":-9900,"description":"train","tags":["zq-reimbursable"]}`)
+		resp = doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(train.ID), body)
+		updated = transactionResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+			t.Fatalf("decode transaction: %v", err)
+		}
+		resp.Body.Close()
+		if want := []string{"zq-reimbursable"}; !reflect.DeepEqual(updated.Tags, want) {
+			t.Fatalf("tags = %v, want %v", updated.Tags, want)
+		}
+	})
+
+	t.Run("totals per tag", func(t *testing.T) {
+		resp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/tags?from_date=2051-07-01&to_date=2051-07-31", nil)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		var totals tagTotalListResponse
+		if err := json.NewDecoder(resp.Body).Decode(&totals); err != nil {
+			t.Fatalf("decode totals: %v", err)
+		}
+
+		found := 0
+		for _, item := range totals.Items {
+			switch item.TagID {
+			case vacation.ID:
+				found++
+				if item.SpendingCents != 42000 || item.IncomeCents != 0 || item.TransactionCount != 1 {
+					t.Fatalf("vacation = %+v, want 42000 spent over 1", item)
+				}
+			case reimbursable.ID:
+				found++
+				if item.SpendingCents != 51900 || item.IncomeCents != 15000 || item.TransactionCount != 3 {
+					t.Fatalf("reimbursable = %+v, want 51900 spent, 15000 in over 3", item)
+				}
+			}
+		}
+		if found != 2 {
+			t.Fatalf("totals = %+v, want both tags", totals.Items)
+		}
+	})
+
+	t.Run("rename and delete", func(t *testing.T) {
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/tags/"+itoa(reimbursable.ID), []byte(`{"name":"zq-expensed"}`))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("rename status = %d, want 200", resp.StatusCode)
+		}
+		if got := getTestTransaction(t, hotel.ID); !reflect.DeepEqual(got.Tags, []string{"zq-expensed", "Zq-Vacation-2051"}) {
+			t.Fatalf("tags = %v, want the new name", got.Tags)
+		}
+
+		resp = doRequest(t, http.MethodDelete, testServer.URL+"/tags/"+itoa(vacation.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete status = %d, want 204", resp.StatusCode)
+		}
+		if got := getTestTransaction(t, hotel.ID); !reflect.DeepEqual(got.Tags, []string{"zq-expensed"}) {
+			t.Fatalf("tags = %v, want the deleted tag removed", got.Tags)
+		}
+
+		resp = doRequest(t, http.MethodGet, testServer.URL+"/tags/"+itoa(vacation.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("get status = %d, want 404", resp.StatusCode)
+		}
+	})
+}
+
+func createTestTag(t *testing.T, name string) tagResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/tags", []byte(`{"name":"`+name+`"}`))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created tagResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode tag: %v", err)
+	}
+	return created
+}
+
+func createTestTaggedTransaction(t *testing.T, body string) transactionResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions", []byte(body))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created transactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode transaction: %v", err)
+	}
+	return created
+}
+
+func listTestTransactions(t *testing.T, query string) transactionListResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions"+query, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var list transactionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	return list
+}
@@ -0,0 +1,168 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/tags"
+)
+
+type TagsHandler struct {
+	repo   *tags.Repository
+	logger *zap.Logger
+}
+
+func NewTagsHandler(repo *tags.Repository, logger *zap.Logger) *TagsHandler {
+	return &TagsHandler{repo: repo, logger: logger}
+}
+
+func (h *TagsHandler) CreateTag(ctx context.Context, request api.CreateTagRequestObject) (api.CreateTagResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create tag: missing request body")
+		return api.CreateTag400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateTag400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	created, err := h.repo.Create(ctx, tags.CreateInput{Name: request.Body.Name})
+	if err != nil {
+		if msg, ok := tagValidationMessage(err); ok {
+			return api.CreateTag400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.CreateTag400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create tag: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create tag: created", zap.Int64("tag_id", created.ID))
+
+	return api.CreateTag201JSONResponse{
+		Body:    toAPITag(created),
+		Headers: api.CreateTag201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TagsHandler) DeleteTag(ctx context.Context, request api.DeleteTagRequestObject) (api.DeleteTagResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.repo.Delete(ctx, request.TagId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteTag404JSONResponse{
+				Body:    api.Error{Message: "tag not found"},
+				Headers: api.DeleteTag404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete tag: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete tag: deleted", zap.Int64("tag_id", request.TagId))
+
+	return api.DeleteTag204Response{
+		Headers: api.DeleteTag204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TagsHandler) GetTag(ctx context.Context, request api.GetTagRequestObject) (api.GetTagResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	tag, err := h.repo.Get(ctx, request.TagId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetTag404JSONResponse{
+				Body:    api.Error{Message: "tag not found"},
+				Headers: api.GetTag404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get tag: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetTag200JSONResponse{
+		Body:    toAPITag(tag),
+		Headers: api.GetTag200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TagsHandler) ListTags(ctx context.Context, request api.ListTagsRequestObject) (api.ListTagsResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.repo.List(ctx)
+	if err != nil {
+		h.logger.Error("list tags: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.Tag, 0, len(list))
+	for _, t := range list {
+		items = append(items, toAPITag(t))
+	}
+
+	return api.ListTags200JSONResponse{
+		Body:    api.TagList{Items: items},
+		Headers: api.ListTags200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TagsHandler) UpdateTag(ctx context.Context, request api.UpdateTagRequestObject) (api.UpdateTagResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update tag: missing request body")
+		return api.UpdateTag400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateTag400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, err := h.repo.Update(ctx, request.TagId, tags.UpdateInput{Name: request.Body.Name})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateTag404JSONResponse{
+				Body:    api.Error{Message: "tag not found"},
+				Headers: api.UpdateTag404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := tagValidationMessage(err); ok {
+			return api.UpdateTag400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdateTag400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update tag: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdateTag200JSONResponse{
+		Body:    toAPITag(updated),
+		Headers: api.UpdateTag200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+// tagValidationMessage maps tag errors that are the caller's fault to a 400
+// message.
+func tagValidationMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, tags.ErrBlankName):
+		return err.Error(), true
+	case db.IsUniqueViolation(err):
+		return "tag name already exists", true
+	}
+	return "", false
+}
+
+func toAPITag(t tags.Tag) api.Tag {
+	return api.Tag{
+		Id:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -17,13 +17,27 @@ import (
 	"github.com/pressly/goose/v3"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 
+	"zankowitch.com/go-db-app/internal/accounts"
+	"zankowitch.com/go-db-app/internal/budgets"
+	"zankowitch.com/go-db-app/internal/cashflow"
 	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/categorization"
 	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/duplicates"
+	"zankowitch.com/go-db-app/internal/envelopes"
 	"zankowitch.com/go-db-app/internal/handlers"
 	"zankowitch.com/go-db-app/internal/httpapi"
 	"zankowitch.com/go-db-app/internal/httpserver"
+	"zankowitch.com/go-db-app/internal/imports"
 	"zankowitch.com/go-db-app/internal/logging"
+	"zankowitch.com/go-db-app/internal/payees"
+	"zankowitch.com/go-db-app/internal/rates"
+	"zankowitch.com/go-db-app/internal/recurring"
+	"zankowitch.com/go-db-app/internal/suggestions"
+	"zankowitch.com/go-db-app/internal/tags"
 	"zankowitch.com/go-db-app/internal/transactions"
+	"zankowitch.com/go-db-app/internal/transfers"
+	"zankowitch.com/go-db-app/internal/trash"
 )
 
 var (
@@ -50,12 +64,35 @@ func startTestServer(db *sql.DB) *httptest.Server {
 	}
 
 	health := handlers.NewHealthHandler(db, config.Config{HealthTimeout: 2 * time.Second})
-	txRepo := transactions.NewRepository(db)
+	cfg := config.Config{BaseCurrency: "EUR"}
+	txRepo := transactions.NewRepository(db, cfg)
 	catRepo := categories.NewRepository(db)
-	txHandler := httpapi.NewTransactionsHandler(txRepo, logger)
-	catHandler := httpapi.NewCategoriesHandler(catRepo, logger)
-	analyticsHandler := httpapi.NewAnalyticsHandler(txRepo, catRepo, logger)
-	apiHandler := httpapi.NewHandler(txHandler, catHandler, analyticsHandler)
+	suggestionService := suggestions.NewService(txRepo, catRepo)
+	transferService := transfers.NewService(db, txRepo)
+	rulesService := categorization.NewService(db, categorization.NewRepository(db, cfg), txRepo)
+	payeeRepo := payees.NewRepository(db, cfg)
+	payeeService := payees.NewService(db, payeeRepo, txRepo)
+	txHandler := httpapi.NewTransactionsHandler(txRepo, transferService, rulesService, payeeService, suggestionService, logger)
+	catHandler := httpapi.NewCategoriesHandler(catRepo, categories.NewService(db, catRepo), suggestionService, logger)
+	budgetRepo := budgets.NewRepository(db, cfg)
+	accountRepo := accounts.NewRepository(db, cfg)
+	recurringRepo := recurring.NewRepository(db, cfg)
+	cashFlow := cashflow.NewService(txRepo, accountRepo, recurringRepo)
+	tagRepo := tags.NewRepository(db, cfg)
+	analyticsHandler := httpapi.NewAnalyticsHandler(txRepo, catRepo, budgetRepo, cashFlow, tagRepo, payeeRepo, logger)
+	importsHandler := httpapi.NewImportsHandler(imports.NewImporter(db, txRepo, catRepo, categorization.NewRepository(db, cfg), payeeRepo), suggestionService, logger)
+	accountsHandler := httpapi.NewAccountsHandler(accountRepo, logger)
+	transfersHandler := httpapi.NewTransfersHandler(transferService, logger)
+	budgetsHandler := httpapi.NewBudgetsHandler(budgetRepo, txRepo, logger)
+	envelopesHandler := httpapi.NewEnvelopesHandler(envelopes.NewService(envelopes.NewRepository(db, cfg), txRepo), logger)
+	recurringHandler := httpapi.NewRecurringHandler(recurring.NewService(db, recurringRepo, txRepo), logger)
+	rulesHandler := httpapi.NewCategorizationHandler(rulesService, suggestionService, logger)
+	duplicatesHandler := httpapi.NewDuplicatesHandler(duplicates.NewService(db, duplicates.NewRepository(db), txRepo), suggestionService, logger)
+	tagsHandler := httpapi.NewTagsHandler(tagRepo, logger)
+	payeesHandler := httpapi.NewPayeesHandler(payeeService, logger)
+	ratesHandler := httpapi.NewExchangeRatesHandler(rates.NewRepository(db), logger)
+	trashHandler := httpapi.NewTrashHandler(trash.NewService(config.Config{TrashRetention: 30 * 24 * time.Hour}, db, txRepo, catRepo), logger)
+	apiHandler := httpapi.NewHandler(txHandler, catHandler, analyticsHandler, importsHandler, accountsHandler, transfersHandler, budgetsHandler, envelopesHandler, recurringHandler, rulesHandler, duplicatesHandler, tagsHandler, payeesHandler, ratesHandler, trashHandler)
 
 	mux, err := httpserver.NewMux(health, apiHandler)
 	if err != nil {
@@ -124,6 +161,16 @@ func migrationsDir() string {
 func doRequest(t *testing.T, method, url string, body []byte) *http.Response {
 	t.Helper()
 
+	contentType := ""
+	if body != nil {
+		contentType = "application/json"
+	}
+	return doRequestWithContentType(t, method, url, contentType, body)
+}
+
+func doRequestWithContentType(t *testing.T, method, url, contentType string, body []byte) *http.Response {
+	t.Helper()
+
 	var reader *bytes.Reader
 	if body != nil {
 		reader = bytes.NewReader(body)
@@ -135,8 +182,8 @@ func doRequest(t *testing.T, method, url string, body []byte) *http.Response {
 	if err != nil {
 		t.Fatalf("new request: %v", err)
 	}
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	resp, err := testClient.Do(req)
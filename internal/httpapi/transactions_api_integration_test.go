@@ -7,12 +7,21 @@ import (
 )
 
 type transactionResponse struct {
-	ID              int64   `json:"id"`
-	TransactionDate string  `json:"transaction_date"`
-	CategoryID      *int64  `json:"category_id"`
-	AmountCents     int64   `json:"amount_cents"`
-	Description     *string `json:"description"`
-	CreatedAt       string  `json:"created_at"`
+	ID               int64    `json:"id"`
+	TransactionDate  string   `json:"transaction_date"`
+	AccountID        *int64   `json:"account_id"`
+	CategoryID       *int64   `json:"category_id"`
+	AmountCents      int64    `json:"amount_cents"`
+	Currency         string   `json:"currency"`
+	CurrencyExponent int      `json:"currency_exponent"`
+	Description      *string  `json:"description"`
+	ExternalID       *string  `json:"external_id"`
+	TransferID       *int64   `json:"transfer_id"`
+	RecurringRuleID  *int64   `json:"recurring_rule_id"`
+	PayeeID          *int64   `json:"payee_id"`
+	SearchRank       *float64 `json:"search_rank"`
+	Tags             []string `json:"tags"`
+	CreatedAt        string   `json:"created_at"`
 }
 
 type transactionListResponse struct {
@@ -1,8 +1,10 @@
 package httpapi
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -10,16 +12,25 @@ import (
 	"go.uber.org/zap"
 
 	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/categorization"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/payees"
+	"zankowitch.com/go-db-app/internal/suggestions"
 	"zankowitch.com/go-db-app/internal/transactions"
+	"zankowitch.com/go-db-app/internal/transfers"
 )
 
 type TransactionsHandler struct {
-	repo   *transactions.Repository
-	logger *zap.Logger
+	repo        *transactions.Repository
+	transfers   *transfers.Service
+	rules       *categorization.Service
+	payees      *payees.Service
+	suggestions *suggestions.Service
+	logger      *zap.Logger
 }
 
-func NewTransactionsHandler(repo *transactions.Repository, logger *zap.Logger) *TransactionsHandler {
-	return &TransactionsHandler{repo: repo, logger: logger}
+func NewTransactionsHandler(repo *transactions.Repository, transfers *transfers.Service, rules *categorization.Service, payees *payees.Service, suggestions *suggestions.Service, logger *zap.Logger) *TransactionsHandler {
+	return &TransactionsHandler{repo: repo, transfers: transfers, rules: rules, payees: payees, suggestions: suggestions, logger: logger}
 }
 
 func (h *TransactionsHandler) CreateTransaction(ctx context.Context, request api.CreateTransactionRequestObject) (api.CreateTransactionResponseObject, error) {
@@ -36,32 +47,54 @@ func (h *TransactionsHandler) CreateTransaction(ctx context.Context, request api
 	logger.Debug(
 		"create transaction: request",
 		zap.String("transaction_date", request.Body.TransactionDate.String()),
+		zap.Any("account_id", request.Body.AccountId),
 		zap.Any("category_id", request.Body.CategoryId),
 		zap.Int64("amount_cents", request.Body.AmountCents),
 		zap.String("description", stringPtrValue(request.Body.Description)),
 	)
 
-	created, err := h.repo.Create(ctx, transactions.CreateInput{
+	in := transactions.CreateInput{
 		TransactionDate: request.Body.TransactionDate.Time,
+		AccountID:       request.Body.AccountId,
 		CategoryID:      request.Body.CategoryId,
 		AmountCents:     request.Body.AmountCents,
+		Currency:        currencyValue(request.Body.Currency),
 		Description:     request.Body.Description,
-	})
+		PayeeID:         request.Body.PayeeId,
+		Splits:          fromAPISplits(request.Body.Splits),
+		Tags:            stringsValue(request.Body.Tags),
+	}
+	if err := h.rules.Categorize(ctx, &in); err != nil {
+		logger.Error("create transaction: categorization rules failed", zap.Error(err))
+		return nil, err
+	}
+	if err := h.payees.Normalize(ctx, &in); err != nil {
+		logger.Error("create transaction: payee patterns failed", zap.Error(err))
+		return nil, err
+	}
+
+	created, err := h.repo.Create(ctx, in)
 	if err != nil {
+		if errors.Is(err, transactions.ErrSplitSum) || errors.Is(err, transactions.ErrArchivedCategory) || errors.Is(err, transactions.ErrDeletedCategory) || errors.Is(err, transactions.ErrUnknownTag) {
+			return api.CreateTransaction400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.CreateTransaction400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.CreateTransaction400JSONResponse{
+				Body:    api.Error{Message: "account, category or payee not found"},
+				Headers: api.CreateTransaction400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		logger.Error("create transaction: db error", zap.Error(err))
 		return nil, err
 	}
 
 	logger.Info("create transaction: created", zap.Int64("transaction_id", created.ID))
+	h.suggestions.Learn(created)
 
-	response := api.Transaction{
-		Id:              created.ID,
-		TransactionDate: types.Date{Time: created.TransactionDate},
-		CategoryId:      created.CategoryID,
-		AmountCents:     created.AmountCents,
-		Description:     created.Description,
-		CreatedAt:       created.CreatedAt,
-	}
+	response := toAPITransaction(created)
 
 	return api.CreateTransaction201JSONResponse{
 		Body:    response,
@@ -71,7 +104,7 @@ func (h *TransactionsHandler) CreateTransaction(ctx context.Context, request api
 
 func (h *TransactionsHandler) DeleteTransaction(ctx context.Context, request api.DeleteTransactionRequestObject) (api.DeleteTransactionResponseObject, error) {
 	requestID := requestIDFromContext(ctx)
-	err := h.repo.Delete(ctx, request.TransactionId)
+	err := h.transfers.DeleteTransaction(ctx, request.TransactionId, ifMatchVersion(request.Params.IfMatch))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return api.DeleteTransaction404JSONResponse{
@@ -79,17 +112,47 @@ func (h *TransactionsHandler) DeleteTransaction(ctx context.Context, request api
 				Headers: api.DeleteTransaction404ResponseHeaders{XRequestID: requestID},
 			}, nil
 		}
+		if errors.Is(err, transactions.ErrVersionMismatch) {
+			return api.DeleteTransaction412JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.DeleteTransaction412ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		h.logger.Error("delete transaction: db error", zap.Error(err))
 		return nil, err
 	}
 
 	h.logger.Info("delete transaction: deleted", zap.Int64("transaction_id", request.TransactionId))
+	h.suggestions.Forget(request.TransactionId)
 
 	return api.DeleteTransaction204Response{
 		Headers: api.DeleteTransaction204ResponseHeaders{XRequestID: requestID},
 	}, nil
 }
 
+func (h *TransactionsHandler) RestoreTransaction(ctx context.Context, request api.RestoreTransactionRequestObject) (api.RestoreTransactionResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	restored, err := h.repo.Restore(ctx, request.TransactionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.RestoreTransaction404JSONResponse{
+				Body:    api.Error{Message: "transaction not found in the trash"},
+				Headers: api.RestoreTransaction404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("restore transaction: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("restore transaction: restored", zap.Int64("transaction_id", restored.ID))
+	h.suggestions.Learn(restored)
+
+	return api.RestoreTransaction200JSONResponse{
+		Body:    toAPITransaction(restored),
+		Headers: api.RestoreTransaction200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
 func (h *TransactionsHandler) ListTransactions(ctx context.Context, request api.ListTransactionsRequestObject) (api.ListTransactionsResponseObject, error) {
 	requestID := requestIDFromContext(ctx)
 	logger := h.logger.With(zap.String("request_id", requestID))
@@ -159,7 +222,48 @@ func (h *TransactionsHandler) ListTransactions(ctx context.Context, request api.
 		afterID = request.Params.AfterId
 	}
 
-	rows, err := h.repo.ListAfter(ctx, int(limit), fromDate, toDate, request.Params.CategoryId, txType, afterDate, afterID)
+	filter := transactions.ListFilter{
+		FromDate:   fromDate,
+		ToDate:     toDate,
+		AccountID:  request.Params.AccountId,
+		CategoryID: request.Params.CategoryId,
+		PayeeID:    request.Params.PayeeId,
+		Type:       txType,
+		Tags:       stringsValue(request.Params.Tag),
+		TagMode:    transactions.TagModeAny,
+	}
+	if request.Params.TagMode != nil {
+		filter.TagMode = transactions.TagMode(*request.Params.TagMode)
+	}
+
+	if request.Params.Q != nil {
+		hits, err := h.repo.Search(ctx, *request.Params.Q, int(limit), filter, afterDate, afterID)
+		if err != nil {
+			if errors.Is(err, transactions.ErrEmptySearch) {
+				return api.ListTransactions400JSONResponse{
+					Body:    api.Error{Message: err.Error()},
+					Headers: api.ListTransactions400ResponseHeaders{XRequestID: requestID},
+				}, nil
+			}
+			logger.Error("list transactions: search error", zap.Error(err))
+			return nil, err
+		}
+
+		items := make([]api.Transaction, 0, len(hits))
+		for _, hit := range hits {
+			item := toAPITransaction(hit.Transaction)
+			rank := hit.Rank
+			item.SearchRank = &rank
+			items = append(items, item)
+		}
+
+		return api.ListTransactions200JSONResponse{
+			Body:    api.TransactionList{Items: items},
+			Headers: api.ListTransactions200ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	rows, err := h.repo.ListAfter(ctx, int(limit), filter, afterDate, afterID)
 	if err != nil {
 		logger.Error("list transactions: db error", zap.Error(err))
 		return nil, err
@@ -167,14 +271,7 @@ func (h *TransactionsHandler) ListTransactions(ctx context.Context, request api.
 
 	items := make([]api.Transaction, 0, len(rows))
 	for _, row := range rows {
-		items = append(items, api.Transaction{
-			Id:              row.ID,
-			TransactionDate: types.Date{Time: row.TransactionDate},
-			CategoryId:      row.CategoryID,
-			AmountCents:     row.AmountCents,
-			Description:     row.Description,
-			CreatedAt:       row.CreatedAt,
-		})
+		items = append(items, toAPITransaction(row))
 	}
 
 	return api.ListTransactions200JSONResponse{
@@ -197,18 +294,51 @@ func (h *TransactionsHandler) GetTransaction(ctx context.Context, request api.Ge
 		return nil, err
 	}
 
-	response := api.Transaction{
-		Id:              row.ID,
-		TransactionDate: types.Date{Time: row.TransactionDate},
-		CategoryId:      row.CategoryID,
-		AmountCents:     row.AmountCents,
-		Description:     row.Description,
-		CreatedAt:       row.CreatedAt,
-	}
+	response := toAPITransaction(row)
 
 	return api.GetTransaction200JSONResponse{
 		Body:    response,
-		Headers: api.GetTransaction200ResponseHeaders{XRequestID: requestID},
+		Headers: api.GetTransaction200ResponseHeaders{ETag: etag(row.Version), XRequestID: requestID},
+	}, nil
+}
+
+// GetTransactionHistory answers 404 only when nothing was ever recorded for
+// the transaction and it does not exist, so that transactions created before
+// the audit log have an empty history.
+func (h *TransactionsHandler) GetTransactionHistory(ctx context.Context, request api.GetTransactionHistoryRequestObject) (api.GetTransactionHistoryResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	changes, err := h.repo.History(ctx, request.TransactionId)
+	if err != nil {
+		h.logger.Error("get transaction history: db error", zap.Error(err))
+		return nil, err
+	}
+
+	if len(changes) == 0 {
+		if _, err := h.repo.Get(ctx, request.TransactionId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return api.GetTransactionHistory404JSONResponse{
+					Body:    api.Error{Message: "transaction not found"},
+					Headers: api.GetTransactionHistory404ResponseHeaders{XRequestID: requestID},
+				}, nil
+			}
+			h.logger.Error("get transaction history: db error", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	items := make([]api.TransactionChange, 0, len(changes))
+	for _, c := range changes {
+		item, err := toAPITransactionChange(c)
+		if err != nil {
+			h.logger.Error("get transaction history: decode change", zap.Int64("change_id", c.ID), zap.Error(err))
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return api.GetTransactionHistory200JSONResponse{
+		Body:    api.TransactionHistory{Items: items},
+		Headers: api.GetTransactionHistory200ResponseHeaders{XRequestID: requestID},
 	}, nil
 }
 
@@ -223,11 +353,16 @@ func (h *TransactionsHandler) UpdateTransaction(ctx context.Context, request api
 		}, nil
 	}
 
-	updated, err := h.repo.Update(ctx, request.TransactionId, transactions.UpdateInput{
+	updated, err := h.transfers.UpdateTransaction(ctx, request.TransactionId, ifMatchVersion(request.Params.IfMatch), transactions.UpdateInput{
 		TransactionDate: request.Body.TransactionDate.Time,
+		AccountID:       request.Body.AccountId,
 		CategoryID:      request.Body.CategoryId,
 		AmountCents:     request.Body.AmountCents,
+		Currency:        currencyValue(request.Body.Currency),
 		Description:     request.Body.Description,
+		PayeeID:         request.Body.PayeeId,
+		Splits:          fromAPISplits(request.Body.Splits),
+		Tags:            stringsValue(request.Body.Tags),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -236,28 +371,155 @@ func (h *TransactionsHandler) UpdateTransaction(ctx context.Context, request api
 				Headers: api.UpdateTransaction404ResponseHeaders{XRequestID: requestID},
 			}, nil
 		}
+		if errors.Is(err, transactions.ErrVersionMismatch) {
+			return api.UpdateTransaction412JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.UpdateTransaction412ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if errors.Is(err, transactions.ErrSplitSum) || errors.Is(err, transactions.ErrArchivedCategory) || errors.Is(err, transactions.ErrDeletedCategory) || errors.Is(err, transactions.ErrUnknownTag) {
+			return api.UpdateTransaction400JSONResponse{
+				Body:    api.Error{Message: err.Error()},
+				Headers: api.UpdateTransaction400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := transferValidationMessage(err); ok {
+			return api.UpdateTransaction400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdateTransaction400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.UpdateTransaction400JSONResponse{
+				Body:    api.Error{Message: "account, category or payee not found"},
+				Headers: api.UpdateTransaction400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
 		logger.Error("update transaction: db error", zap.Error(err))
 		return nil, err
 	}
+	h.suggestions.Learn(updated)
 
-	response := api.Transaction{
-		Id:              updated.ID,
-		TransactionDate: types.Date{Time: updated.TransactionDate},
-		CategoryId:      updated.CategoryID,
-		AmountCents:     updated.AmountCents,
-		Description:     updated.Description,
-		CreatedAt:       updated.CreatedAt,
-	}
+	response := toAPITransaction(updated)
 
 	return api.UpdateTransaction200JSONResponse{
 		Body:    response,
-		Headers: api.UpdateTransaction200ResponseHeaders{XRequestID: requestID},
+		Headers: api.UpdateTransaction200ResponseHeaders{ETag: etag(updated.Version), XRequestID: requestID},
 	}, nil
 }
 
+func toAPITransaction(t transactions.Transaction) api.Transaction {
+	return api.Transaction{
+		Id:               t.ID,
+		TransactionDate:  types.Date{Time: t.TransactionDate},
+		AccountId:        t.AccountID,
+		CategoryId:       t.CategoryID,
+		AmountCents:      t.AmountCents,
+		Currency:         t.Currency,
+		CurrencyExponent: t.CurrencyExponent,
+		Description:      t.Description,
+		ExternalId:       t.ExternalID,
+		TransferId:       t.TransferID,
+		RecurringRuleId:  t.RecurringRuleID,
+		PayeeId:          t.PayeeID,
+		Splits:           toAPISplits(t.Splits),
+		Tags:             tagsOrEmpty(t.Tags),
+		CreatedAt:        t.CreatedAt,
+	}
+}
+
+func toAPITransactionChange(c transactions.Change) (api.TransactionChange, error) {
+	before, err := decodeAuditedRow(c.Before)
+	if err != nil {
+		return api.TransactionChange{}, err
+	}
+	after, err := decodeAuditedRow(c.After)
+	if err != nil {
+		return api.TransactionChange{}, err
+	}
+
+	return api.TransactionChange{
+		Id:        c.ID,
+		Action:    api.TransactionChangeAction(c.Action),
+		RequestId: c.RequestID,
+		ChangedAt: c.ChangedAt,
+		Before:    before,
+		After:     after,
+	}, nil
+}
+
+// decodeAuditedRow keeps numbers as json.Number so that amounts and IDs beyond
+// float64 precision are reported unchanged.
+func decodeAuditedRow(raw json.RawMessage) (*map[string]interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var row map[string]interface{}
+	if err := dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func fromAPISplits(splits *[]api.TransactionSplit) []transactions.Split {
+	if splits == nil {
+		return nil
+	}
+
+	out := make([]transactions.Split, 0, len(*splits))
+	for _, s := range *splits {
+		out = append(out, transactions.Split{
+			CategoryID:  s.CategoryId,
+			AmountCents: s.AmountCents,
+			Description: s.Description,
+		})
+	}
+	return out
+}
+
+func toAPISplits(splits []transactions.Split) []api.TransactionSplit {
+	out := make([]api.TransactionSplit, 0, len(splits))
+	for _, s := range splits {
+		out = append(out, api.TransactionSplit{
+			CategoryId:  s.CategoryID,
+			AmountCents: s.AmountCents,
+			Description: s.Description,
+		})
+	}
+	return out
+}
+
 func stringPtrValue(v *string) string {
 	if v == nil {
 		return "<nil>"
 	}
 	return *v
 }
+
+// stringsValue keeps nil for an omitted list, so the repository can tell it
+// from an empty one.
+func stringsValue(v *[]string) []string {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// currencyValue maps an omitted currency to the empty string, which the
+// repository reads as the base currency on create and as unchanged on update.
+func currencyValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
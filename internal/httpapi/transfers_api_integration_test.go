@@ -0,0 +1,197 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type transferResponse struct {
+	ID                  int64  `json:"id"`
+	FromAccountID       int64  `json:"from_account_id"`
+	ToAccountID         int64  `json:"to_account_id"`
+	AmountCents         int64  `json:"amount_cents"`
+	Currency            string `json:"currency"`
+	CurrencyExponent    int    `json:"currency_exponent"`
+	DebitTransactionID  int64  `json:"debit_transaction_id"`
+	CreditTransactionID int64  `json:"credit_transaction_id"`
+}
+
+func TestTransfersHTTP(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	checking := createTestAccount(t, "Transfer-Checking", "checking")
+	savings := createTestAccount(t, "Transfer-Savings", "savings")
+	category := createTestCategory(t, "Transfer-Category")
+	createTestTransaction(t, category.ID, "2038-01-03", -4000, "groceries")
+
+	var created transferResponse
+
+	t.Run("create transfer books both legs", func(t *testing.T) {
+		body := []byte(`{"from_account_id":` + itoa(checking.ID) + `,"to_account_id":` + itoa(savings.ID) + `,"transfer_date":"2038-01-10","amount_cents":20000,"description":"to savings"}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transfers", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("status = %d, want 201", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+
+		debit := getTestTransaction(t, created.DebitTransactionID)
+		credit := getTestTransaction(t, created.CreditTransactionID)
+		if debit.AmountCents != -20000 || *debit.AccountID != checking.ID || *debit.TransferID != created.ID {
+			t.Fatalf("unexpected debit leg: %+v", debit)
+		}
+		if credit.AmountCents != 20000 || *credit.AccountID != savings.ID {
+			t.Fatalf("unexpected credit leg: %+v", credit)
+		}
+	})
+
+	t.Run("same account is rejected", func(t *testing.T) {
+		body := []byte(`{"from_account_id":` + itoa(checking.ID) + `,"to_account_id":` + itoa(checking.ID) + `,"transfer_date":"2038-01-10","amount_cents":100}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transfers", body)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("status = %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("analytics exclude transfers", func(t *testing.T) {
+		// Even a categorized leg must not count as spending.
+		body := []byte(`{"transaction_date":"2038-01-10","amount_cents":-20000,"account_id":` + itoa(checking.ID) + `,"category_id":` + itoa(category.ID) + `,"description":"to savings"}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.DebitTransactionID), body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("update leg status = %d, want 200", resp.StatusCode)
+		}
+
+		savingsResp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/monthly-savings?year=2038", nil)
+		defer savingsResp.Body.Close()
+
+		var monthly monthlySavingsResponse
+		if err := json.NewDecoder(savingsResp.Body).Decode(&monthly); err != nil {
+			t.Fatalf("decode savings: %v", err)
+		}
+		if monthly.Total != -4000 {
+			t.Fatalf("savings total = %d, want -4000", monthly.Total)
+		}
+
+		summaryResp := doRequest(t, http.MethodGet, testServer.URL+"/analytics/transactions-summary?year=2038", nil)
+		defer summaryResp.Body.Close()
+
+		var summary summaryResponse
+		if err := json.NewDecoder(summaryResp.Body).Decode(&summary); err != nil {
+			t.Fatalf("decode summary: %v", err)
+		}
+		row, ok := findSummaryRow(summary.Spending.Rows, category.ID)
+		if !ok || row.Total != 4000 {
+			t.Fatalf("unexpected spending row: %+v", row)
+		}
+	})
+
+	t.Run("editing one leg updates the other", func(t *testing.T) {
+		body := []byte(`{"transaction_date":"2038-01-12","amount_cents":15000,"account_id":` + itoa(savings.ID) + `,"description":"partial"}`)
+		resp := doRequest(t, http.MethodPut, testServer.URL+"/transactions/"+itoa(created.CreditTransactionID), body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+
+		debit := getTestTransaction(t, created.DebitTransactionID)
+		if debit.AmountCents != -15000 || debit.TransactionDate != "2038-01-12" || *debit.Description != "partial" {
+			t.Fatalf("debit leg not mirrored: %+v", debit)
+		}
+		if debit.CategoryID == nil || *debit.CategoryID != category.ID {
+			t.Fatalf("debit leg lost its category: %+v", debit)
+		}
+	})
+
+	t.Run("deleting one leg deletes the transfer", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(created.CreditTransactionID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", resp.StatusCode)
+		}
+
+		for _, url := range []string{
+			testServer.URL + "/transfers/" + itoa(created.ID),
+			testServer.URL + "/transactions/" + itoa(created.DebitTransactionID),
+		} {
+			resp := doRequest(t, http.MethodGet, url, nil)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusNotFound {
+				t.Fatalf("GET %s status = %d, want 404", url, resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("amounts are in the transfer's currency", func(t *testing.T) {
+		accounts := `{"from_account_id":` + itoa(checking.ID) + `,"to_account_id":` + itoa(savings.ID) + `,"transfer_date":"2059-02-01"`
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transfers", []byte(accounts+`,"amount_cents":1500,"currency":"JPY"}`))
+		defer resp.Body.Close()
+
+		var yen transferResponse
+		if err := json.NewDecoder(resp.Body).Decode(&yen); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if yen.AmountCents != 1500 || yen.Currency != "JPY" || yen.CurrencyExponent != 0 {
+			t.Fatalf("transfer = %+v, want 1500 JPY with exponent 0", yen)
+		}
+		if debit := getTestTransaction(t, yen.DebitTransactionID); debit.AmountCents != -1500 || debit.Currency != "JPY" {
+			t.Fatalf("debit leg = %+v, want -1500 JPY", debit)
+		}
+
+		resp = doRequest(t, http.MethodPut, testServer.URL+"/transfers/"+itoa(yen.ID), []byte(accounts+`,"amount_cents":2000}`))
+		defer resp.Body.Close()
+
+		var updated transferResponse
+		if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if updated.AmountCents != 2000 || updated.Currency != "JPY" {
+			t.Fatalf("updated = %+v, want 2000 JPY", updated)
+		}
+
+		resp = doRequest(t, http.MethodDelete, testServer.URL+"/transfers/"+itoa(yen.ID), nil)
+		resp.Body.Close()
+	})
+}
+
+func createTestAccount(t *testing.T, name, accountType string) accountResponse {
+	t.Helper()
+
+	name += "-" + time.Now().UTC().Format("150405.000000000")
+	body := []byte(`{"name":"` + name + `","type":"` + accountType + `"}`)
+	resp := doRequest(t, http.MethodPost, testServer.URL+"/accounts", body)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", resp.StatusCode)
+	}
+
+	var created accountResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode account: %v", err)
+	}
+	return created
+}
+
+func getTestTransaction(t *testing.T, id int64) transactionResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/"+itoa(id), nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var got transactionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode transaction: %v", err)
+	}
+	return got
+}
@@ -0,0 +1,199 @@
+package httpapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transfers"
+)
+
+type TransfersHandler struct {
+	service *transfers.Service
+	logger  *zap.Logger
+}
+
+func NewTransfersHandler(service *transfers.Service, logger *zap.Logger) *TransfersHandler {
+	return &TransfersHandler{service: service, logger: logger}
+}
+
+func (h *TransfersHandler) CreateTransfer(ctx context.Context, request api.CreateTransferRequestObject) (api.CreateTransferResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("create transfer: missing request body")
+		return api.CreateTransfer400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.CreateTransfer400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	created, err := h.service.Create(ctx, transferInput(*request.Body))
+	if err != nil {
+		if msg, ok := transferValidationMessage(err); ok {
+			return api.CreateTransfer400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.CreateTransfer400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.CreateTransfer400JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.CreateTransfer400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("create transfer: db error", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("create transfer: created", zap.Int64("transfer_id", created.ID))
+
+	return api.CreateTransfer201JSONResponse{
+		Body:    toAPITransfer(created),
+		Headers: api.CreateTransfer201ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TransfersHandler) DeleteTransfer(ctx context.Context, request api.DeleteTransferRequestObject) (api.DeleteTransferResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	err := h.service.Delete(ctx, request.TransferId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DeleteTransfer404JSONResponse{
+				Body:    api.Error{Message: "transfer not found"},
+				Headers: api.DeleteTransfer404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("delete transfer: db error", zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("delete transfer: deleted", zap.Int64("transfer_id", request.TransferId))
+
+	return api.DeleteTransfer204Response{
+		Headers: api.DeleteTransfer204ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TransfersHandler) GetTransfer(ctx context.Context, request api.GetTransferRequestObject) (api.GetTransferResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	transfer, err := h.service.Get(ctx, request.TransferId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetTransfer404JSONResponse{
+				Body:    api.Error{Message: "transfer not found"},
+				Headers: api.GetTransfer404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		h.logger.Error("get transfer: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.GetTransfer200JSONResponse{
+		Body:    toAPITransfer(transfer),
+		Headers: api.GetTransfer200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TransfersHandler) ListTransfers(ctx context.Context, request api.ListTransfersRequestObject) (api.ListTransfersResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	list, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error("list transfers: db error", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]api.Transfer, 0, len(list))
+	for _, t := range list {
+		items = append(items, toAPITransfer(t))
+	}
+
+	return api.ListTransfers200JSONResponse{
+		Body:    api.TransferList{Items: items},
+		Headers: api.ListTransfers200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func (h *TransfersHandler) UpdateTransfer(ctx context.Context, request api.UpdateTransferRequestObject) (api.UpdateTransferResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	logger := h.logger.With(zap.String("request_id", requestID))
+	if request.Body == nil {
+		logger.Warn("update transfer: missing request body")
+		return api.UpdateTransfer400JSONResponse{
+			Body:    api.Error{Message: "missing request body"},
+			Headers: api.UpdateTransfer400ResponseHeaders{XRequestID: requestID},
+		}, nil
+	}
+
+	updated, err := h.service.Update(ctx, request.TransferId, transferInput(*request.Body))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.UpdateTransfer404JSONResponse{
+				Body:    api.Error{Message: "transfer not found"},
+				Headers: api.UpdateTransfer404ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if msg, ok := transferValidationMessage(err); ok {
+			return api.UpdateTransfer400JSONResponse{
+				Body:    api.Error{Message: msg},
+				Headers: api.UpdateTransfer400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		if db.IsForeignKeyViolation(err) {
+			return api.UpdateTransfer400JSONResponse{
+				Body:    api.Error{Message: "account not found"},
+				Headers: api.UpdateTransfer400ResponseHeaders{XRequestID: requestID},
+			}, nil
+		}
+		logger.Error("update transfer: db error", zap.Error(err))
+		return nil, err
+	}
+
+	return api.UpdateTransfer200JSONResponse{
+		Body:    toAPITransfer(updated),
+		Headers: api.UpdateTransfer200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
+
+func transferInput(body api.TransferCreate) transfers.CreateInput {
+	return transfers.CreateInput{
+		FromAccountID: body.FromAccountId,
+		ToAccountID:   body.ToAccountId,
+		TransferDate:  body.TransferDate.Time,
+		AmountCents:   body.AmountCents,
+		Currency:      currencyValue(body.Currency),
+		Description:   body.Description,
+	}
+}
+
+// transferValidationMessage maps transfer rule violations to a client message.
+func transferValidationMessage(err error) (string, bool) {
+	if errors.Is(err, transfers.ErrInvalidAmount) ||
+		errors.Is(err, transfers.ErrSameAccount) ||
+		errors.Is(err, transfers.ErrMissingAccount) ||
+		errors.Is(err, transfers.ErrSplitLeg) {
+		return err.Error(), true
+	}
+	return "", false
+}
+
+func toAPITransfer(t transfers.Transfer) api.Transfer {
+	return api.Transfer{
+		Id:                  t.ID,
+		FromAccountId:       t.FromAccountID,
+		ToAccountId:         t.ToAccountID,
+		TransferDate:        types.Date{Time: t.TransferDate},
+		AmountCents:         t.AmountCents,
+		Currency:            t.Currency,
+		CurrencyExponent:    t.CurrencyExponent,
+		Description:         t.Description,
+		DebitTransactionId:  t.DebitTransactionID,
+		CreditTransactionId: t.CreditTransactionID,
+		CreatedAt:           t.CreatedAt,
+	}
+}
@@ -0,0 +1,208 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type trashedTransactionResponse struct {
+	Transaction transactionResponse `json:"transaction"`
+	DeletedAt   time.Time           `json:"deleted_at"`
+	PurgeAt     time.Time           `json:"purge_at"`
+}
+
+type trashedCategoryResponse struct {
+	Category  categoryResponse `json:"category"`
+	DeletedAt time.Time        `json:"deleted_at"`
+	PurgeAt   time.Time        `json:"purge_at"`
+}
+
+type trashResponse struct {
+	Transactions []trashedTransactionResponse `json:"transactions"`
+	Categories   []trashedCategoryResponse    `json:"categories"`
+}
+
+func TestTrash(t *testing.T) {
+	// Subtests share state and must not be run in isolation or parallel.
+	category := createTestCategory(t, "Trash-2055")
+	kept := createTestTaggedTransaction(t, `{"transaction_date":"2055-01-05","amount_cents":-1000,"category_id":`+itoa(category.ID)+`}`)
+	trashed := createTestTaggedTransaction(t, `{"transaction_date":"2055-01-06","amount_cents":-2500,"category_id":`+itoa(category.ID)+`}`)
+
+	t.Run("delete moves the transaction to the trash", func(t *testing.T) {
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(trashed.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete status = %d, want 204", resp.StatusCode)
+		}
+
+		getResp := doRequest(t, http.MethodGet, testServer.URL+"/transactions/"+itoa(trashed.ID), nil)
+		getResp.Body.Close()
+		if getResp.StatusCode != http.StatusNotFound {
+			t.Fatalf("get status = %d, want 404", getResp.StatusCode)
+		}
+
+		entry, ok := findTrashedTransaction(getTestTrash(t), trashed.ID)
+		if !ok {
+			t.Fatalf("transaction %d missing from the trash", trashed.ID)
+		}
+		if got := entry.PurgeAt.Sub(entry.DeletedAt); got != 30*24*time.Hour {
+			t.Fatalf("purge_at is %s after deleted_at, want 720h", got)
+		}
+	})
+
+	t.Run("trashed transactions are left out of lists and analytics", func(t *testing.T) {
+		list := listTransactionsInRange(t, "2055-01-01", "2055-01-31")
+		if len(list.Items) != 1 || list.Items[0].ID != kept.ID {
+			t.Fatalf("listed %+v, want only transaction %d", list.Items, kept.ID)
+		}
+
+		summary := getTestSummary(t, "?year=2055")
+		row, ok := findSummaryRow(summary.Spending.Rows, category.ID)
+		if !ok || row.Values[0] != 1000 {
+			t.Fatalf("january row = %+v, want 1000", row)
+		}
+	})
+
+	t.Run("restore brings the transaction back", func(t *testing.T) {
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(trashed.ID)+"/restore", nil)
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("restore status = %d, want 200", resp.StatusCode)
+		}
+
+		got := getTestTransaction(t, trashed.ID)
+		if got.AmountCents != -2500 {
+			t.Fatalf("restored amount = %d, want -2500", got.AmountCents)
+		}
+		if _, ok := findTrashedTransaction(getTestTrash(t), trashed.ID); ok {
+			t.Fatalf("restored transaction still in the trash")
+		}
+
+		again := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(trashed.ID)+"/restore", nil)
+		again.Body.Close()
+		if again.StatusCode != http.StatusNotFound {
+			t.Fatalf("second restore status = %d, want 404", again.StatusCode)
+		}
+	})
+
+	t.Run("transfer legs are trashed and restored together", func(t *testing.T) {
+		checking := createTestAccount(t, "Trash-Checking", "checking")
+		savings := createTestAccount(t, "Trash-Savings", "savings")
+		body := []byte(`{"from_account_id":` + itoa(checking.ID) + `,"to_account_id":` + itoa(savings.ID) + `,"transfer_date":"2055-02-01","amount_cents":5000}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transfers", body)
+		var transfer transferResponse
+		if err := json.NewDecoder(resp.Body).Decode(&transfer); err != nil {
+			t.Fatalf("decode transfer: %v", err)
+		}
+		resp.Body.Close()
+
+		del := doRequest(t, http.MethodDelete, testServer.URL+"/transactions/"+itoa(transfer.DebitTransactionID), nil)
+		del.Body.Close()
+		if del.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete leg status = %d, want 204", del.StatusCode)
+		}
+		gone := doRequest(t, http.MethodGet, testServer.URL+"/transfers/"+itoa(transfer.ID), nil)
+		gone.Body.Close()
+		if gone.StatusCode != http.StatusNotFound {
+			t.Fatalf("get trashed transfer status = %d, want 404", gone.StatusCode)
+		}
+
+		restore := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(transfer.CreditTransactionID)+"/restore", nil)
+		restore.Body.Close()
+		if restore.StatusCode != http.StatusOK {
+			t.Fatalf("restore leg status = %d, want 200", restore.StatusCode)
+		}
+		debit := getTestTransaction(t, transfer.DebitTransactionID)
+		if debit.TransferID == nil || *debit.TransferID != transfer.ID {
+			t.Fatalf("debit leg not restored with its transfer: %+v", debit)
+		}
+	})
+
+	t.Run("deleted transfers go to the trash", func(t *testing.T) {
+		checking := createTestAccount(t, "Trash-Checking-2", "checking")
+		savings := createTestAccount(t, "Trash-Savings-2", "savings")
+		body := []byte(`{"from_account_id":` + itoa(checking.ID) + `,"to_account_id":` + itoa(savings.ID) + `,"transfer_date":"2055-02-02","amount_cents":2500}`)
+		resp := doRequest(t, http.MethodPost, testServer.URL+"/transfers", body)
+		var transfer transferResponse
+		if err := json.NewDecoder(resp.Body).Decode(&transfer); err != nil {
+			t.Fatalf("decode transfer: %v", err)
+		}
+		resp.Body.Close()
+
+		del := doRequest(t, http.MethodDelete, testServer.URL+"/transfers/"+itoa(transfer.ID), nil)
+		del.Body.Close()
+		if del.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete transfer status = %d, want 204", del.StatusCode)
+		}
+		again := doRequest(t, http.MethodDelete, testServer.URL+"/transfers/"+itoa(transfer.ID), nil)
+		again.Body.Close()
+		if again.StatusCode != http.StatusNotFound {
+			t.Fatalf("second delete status = %d, want 404", again.StatusCode)
+		}
+
+		restore := doRequest(t, http.MethodPost, testServer.URL+"/transactions/"+itoa(transfer.DebitTransactionID)+"/restore", nil)
+		restore.Body.Close()
+		if restore.StatusCode != http.StatusOK {
+			t.Fatalf("restore leg status = %d, want 200", restore.StatusCode)
+		}
+		back := doRequest(t, http.MethodGet, testServer.URL+"/transfers/"+itoa(transfer.ID), nil)
+		back.Body.Close()
+		if back.StatusCode != http.StatusOK {
+			t.Fatalf("get restored transfer status = %d, want 200", back.StatusCode)
+		}
+	})
+
+	t.Run("deleted categories go to the trash", func(t *testing.T) {
+		unused := createTestCategory(t, "Trash-Unused-2055")
+		resp := doRequest(t, http.MethodDelete, testServer.URL+"/categories/"+itoa(unused.ID), nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete category status = %d, want 204", resp.StatusCode)
+		}
+
+		if listContainsCategory(t, "?include_archived=true", unused.ID) {
+			t.Fatalf("trashed category still listed")
+		}
+		found := false
+		for _, c := range getTestTrash(t).Categories {
+			if c.Category.ID == unused.ID {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("category %d missing from the trash", unused.ID)
+		}
+
+		// The name is free again once the old category is in the trash.
+		createTestCategory(t, "Trash-Unused-2055")
+	})
+}
+
+func getTestTrash(t *testing.T) trashResponse {
+	t.Helper()
+
+	resp := doRequest(t, http.MethodGet, testServer.URL+"/trash", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("trash status = %d, want 200", resp.StatusCode)
+	}
+	var trash trashResponse
+	if err := json.NewDecoder(resp.Body).Decode(&trash); err != nil {
+		t.Fatalf("decode trash: %v", err)
+	}
+
+	return trash
+}
+
+func findTrashedTransaction(trash trashResponse, id int64) (trashedTransactionResponse, bool) {
+	for _, entry := range trash.Transactions {
+		if entry.Transaction.ID == id {
+			return entry, true
+		}
+	}
+
+	return trashedTransactionResponse{}, false
+}
@@ -0,0 +1,52 @@
+package httpapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/api"
+	"zankowitch.com/go-db-app/internal/trash"
+)
+
+type TrashHandler struct {
+	service *trash.Service
+	logger  *zap.Logger
+}
+
+func NewTrashHandler(service *trash.Service, logger *zap.Logger) *TrashHandler {
+	return &TrashHandler{service: service, logger: logger}
+}
+
+func (h *TrashHandler) GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error) {
+	requestID := requestIDFromContext(ctx)
+	contents, err := h.service.List(ctx)
+	if err != nil {
+		h.logger.Error("get trash: db error", zap.Error(err))
+		return nil, err
+	}
+
+	body := api.Trash{
+		Transactions: make([]api.TrashedTransaction, 0, len(contents.Transactions)),
+		Categories:   make([]api.TrashedCategory, 0, len(contents.Categories)),
+	}
+	for _, t := range contents.Transactions {
+		body.Transactions = append(body.Transactions, api.TrashedTransaction{
+			Transaction: toAPITransaction(t),
+			DeletedAt:   *t.DeletedAt,
+			PurgeAt:     h.service.PurgeAt(*t.DeletedAt),
+		})
+	}
+	for _, c := range contents.Categories {
+		body.Categories = append(body.Categories, api.TrashedCategory{
+			Category:  toAPICategory(c),
+			DeletedAt: *c.DeletedAt,
+			PurgeAt:   h.service.PurgeAt(*c.DeletedAt),
+		})
+	}
+
+	return api.GetTrash200JSONResponse{
+		Body:    body,
+		Headers: api.GetTrash200ResponseHeaders{XRequestID: requestID},
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	middleware "github.com/oapi-codegen/nethttp-middleware"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -15,6 +16,14 @@ import (
 	"zankowitch.com/go-db-app/internal/logging"
 )
 
+func init() {
+	// Statement files are parsed by the handlers, which report malformed rows
+	// individually; the default CSV decoder would reject the whole request.
+	openapi3filter.RegisterBodyDecoder("text/csv", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/x-ofx", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/xml", openapi3filter.FileBodyDecoder)
+}
+
 func NewMux(healthHandler http.Handler, transactionsHandler api.StrictServerInterface) (*http.ServeMux, error) {
 	mux := http.NewServeMux()
 	mux.Handle("/healthz", healthHandler)
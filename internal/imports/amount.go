@@ -0,0 +1,128 @@
+package imports
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// maxAmountDigits is the number of integer digits the NUMERIC(18,4) amount
+// column can hold.
+const maxAmountDigits = 14
+
+// unknownExponent is the number of decimals amounts are parsed with when the
+// file does not name their currency. No currency has more, so Import can
+// rescale them to the base currency's minor units.
+const unknownExponent = 4
+
+// ParseAmount parses a bank formatted amount such as "-1,234.56",
+// "1.234,56", "(12.00)" or "12.00-" into signed minor units with exponent
+// decimals: cents for 2, yen for 0 or fils for 3. The separator that is not
+// the decimal separator is treated as a thousands separator.
+func ParseAmount(s string, decimalSep rune, exponent int) (int64, error) {
+	raw := strings.TrimSpace(s)
+	if raw == "" {
+		return 0, errors.New("amount is empty")
+	}
+
+	negative := false
+	if strings.HasPrefix(raw, "(") && strings.HasSuffix(raw, ")") {
+		negative = true
+		raw = strings.TrimSpace(raw[1 : len(raw)-1])
+	}
+	if strings.HasSuffix(raw, "-") {
+		negative = !negative
+		raw = strings.TrimSpace(strings.TrimSuffix(raw, "-"))
+	}
+	if strings.HasPrefix(raw, "-") {
+		negative = !negative
+		raw = strings.TrimSpace(strings.TrimPrefix(raw, "-"))
+	} else if strings.HasPrefix(raw, "+") {
+		raw = strings.TrimSpace(strings.TrimPrefix(raw, "+"))
+	}
+
+	thousandsSep := ","
+	if decimalSep == ',' {
+		thousandsSep = "."
+	}
+	raw = strings.NewReplacer(thousandsSep, "", " ", "", "\u00a0", "", "'", "").Replace(raw)
+	if raw == "" {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	whole, frac, _ := strings.Cut(raw, string(decimalSep))
+	if whole == "" {
+		whole = "0"
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > exponent {
+		return 0, fmt.Errorf("amount %q has more than %d decimals", s, exponent)
+	}
+	frac += strings.Repeat("0", exponent-len(frac))
+
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	if len(strings.TrimLeft(whole, "0")) > maxAmountDigits {
+		return 0, fmt.Errorf("amount %q is out of range", s)
+	}
+	amount, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("amount %q is out of range", s)
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+// amountExponent returns the decimals amounts in currency are parsed with,
+// unknownExponent when the file does not name it.
+func amountExponent(currency string) int {
+	if currency == "" {
+		return unknownExponent
+	}
+	return transactions.MinorUnitExponent(currency)
+}
+
+// rescaleAmount converts an amount with from decimals into one with to
+// decimals. It reports false when to cannot represent the amount exactly.
+func rescaleAmount(amount int64, from, to int) (int64, bool) {
+	for ; from > to; from-- {
+		if amount%10 != 0 {
+			return 0, false
+		}
+		amount /= 10
+	}
+	for ; from < to; from++ {
+		amount *= 10
+	}
+	return amount, true
+}
+
+// parseCurrency upper-cases an ISO 4217 code such as "usd". An empty code is
+// kept empty, which imports the amount in the base currency.
+func parseCurrency(s string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if code == "" {
+		return "", nil
+	}
+	if len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", fmt.Errorf("invalid currency %q", s)
+	}
+	return code, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
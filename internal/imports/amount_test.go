@@ -0,0 +1,80 @@
+package imports
+
+import "testing"
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in         string
+		decimalSep rune
+		exponent   int
+		want       int64
+		wantErr    bool
+	}{
+		{in: "12.34", decimalSep: '.', exponent: 2, want: 1234},
+		{in: "-1,234.56", decimalSep: '.', exponent: 2, want: -123456},
+		{in: "1.234,56", decimalSep: ',', exponent: 2, want: 123456},
+		{in: "-12,5", decimalSep: ',', exponent: 2, want: -1250},
+		{in: "(7.00)", decimalSep: '.', exponent: 2, want: -700},
+		{in: "7.00-", decimalSep: '.', exponent: 2, want: -700},
+		{in: "+3", decimalSep: '.', exponent: 2, want: 300},
+		{in: ".5", decimalSep: '.', exponent: 2, want: 50},
+		{in: "1.005", decimalSep: '.', exponent: 2, wantErr: true},
+		{in: "abc", decimalSep: '.', exponent: 2, wantErr: true},
+		{in: "-", decimalSep: '.', exponent: 2, wantErr: true},
+		{in: "", decimalSep: '.', exponent: 2, wantErr: true},
+		{in: "99999999999999.99", decimalSep: '.', exponent: 2, want: 9999999999999999},
+		{in: "100000000000000.00", decimalSep: '.', exponent: 2, wantErr: true},
+		{in: "1,500", decimalSep: '.', exponent: 0, want: 1500},
+		{in: "1.500", decimalSep: ',', exponent: 0, want: 1500},
+		{in: "-1500.00", decimalSep: '.', exponent: 0, want: -1500},
+		{in: "1500.5", decimalSep: '.', exponent: 0, wantErr: true},
+		{in: "1.234", decimalSep: '.', exponent: 3, want: 1234},
+		{in: "1.234,5", decimalSep: ',', exponent: 3, want: 1234500},
+		{in: "-0.5", decimalSep: '.', exponent: 3, want: -500},
+		{in: "1.2345", decimalSep: '.', exponent: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.in, tt.decimalSep, tt.exponent)
+		if tt.wantErr {
+			if err == nil {
+				t.Fatalf("ParseAmount(%q, %d) = %d, want error", tt.in, tt.exponent, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseAmount(%q, %d): %v", tt.in, tt.exponent, err)
+		}
+		if got != tt.want {
+			t.Fatalf("ParseAmount(%q, %d) = %d, want %d", tt.in, tt.exponent, got, tt.want)
+		}
+	}
+}
+
+func TestRescaleAmount(t *testing.T) {
+	if got, ok := rescaleAmount(-123400, 4, 2); !ok || got != -1234 {
+		t.Fatalf("rescaleAmount(-123400, 4, 2) = %d, %v, want -1234", got, ok)
+	}
+	if got, ok := rescaleAmount(15, 0, 3); !ok || got != 15000 {
+		t.Fatalf("rescaleAmount(15, 0, 3) = %d, %v, want 15000", got, ok)
+	}
+	if _, ok := rescaleAmount(123450, 4, 0); ok {
+		t.Fatal("rescaleAmount(123450, 4, 0) dropped decimals")
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	tests := map[string]string{"": "", "usd": "USD", " EUR ": "EUR"}
+	for in, want := range tests {
+		got, err := parseCurrency(in)
+		if err != nil || got != want {
+			t.Fatalf("parseCurrency(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+
+	for _, in := range []string{"US", "EURO", "U$D"} {
+		if _, err := parseCurrency(in); err == nil {
+			t.Fatalf("parseCurrency(%q) accepted an invalid code", in)
+		}
+	}
+}
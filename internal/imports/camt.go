@@ -0,0 +1,227 @@
+package imports
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type camtAccount struct {
+	IBAN    string `xml:"Id>IBAN"`
+	OtherID string `xml:"Id>Othr>Id"`
+}
+
+type camtEntry struct {
+	NtryRef string `xml:"NtryRef"`
+	Amount  struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	Indicator string `xml:"CdtDbtInd"`
+	Reversal  bool   `xml:"RvslInd"`
+	Status    struct {
+		Text string `xml:",chardata"`
+		Code string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookingDate string `xml:"BookgDt>Dt"`
+	BookingTime string `xml:"BookgDt>DtTm"`
+	ValueDate   string `xml:"ValDt>Dt"`
+	AcctSvcrRef string `xml:"AcctSvcrRef"`
+	AddtlInfo   string `xml:"AddtlNtryInf"`
+	Details     []struct {
+		AcctSvcrRef  string   `xml:"Refs>AcctSvcrRef"`
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+		Structured   []string `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+		Creditor     string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorPty  string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Debtor       string   `xml:"RltdPties>Dbtr>Nm"`
+		DebtorPty    string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+// ParseCAMT reads an ISO 20022 camt.053 end-of-day statement or camt.052
+// intraday report and returns one entry per booked Ntry. Debit entries become
+// negative amounts in the Amt currency, remittance information becomes the
+// description and the bank's entry reference is kept as ExternalID, scoped by
+// the account IBAN.
+func ParseCAMT(r io.Reader) ([]Entry, error) {
+	decoder := xml.NewDecoder(r)
+
+	entries := make([]Entry, 0)
+	var account *string
+	sawStatement := false
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read camt: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "BkToCstmrStmt", "BkToCstmrAcctRpt":
+			sawStatement = true
+		case "Stmt", "Rpt":
+			account = nil
+		case "Acct":
+			var acct camtAccount
+			if err := decoder.DecodeElement(&acct, &start); err != nil {
+				return nil, fmt.Errorf("read camt account: %w", err)
+			}
+			account = camtAccountID(acct)
+		case "Ntry":
+			line, _ := decoder.InputPos()
+			var ntry camtEntry
+			if err := decoder.DecodeElement(&ntry, &start); err != nil {
+				return nil, fmt.Errorf("read camt entry on line %d: %w", line, err)
+			}
+			entries = append(entries, camtToEntry(ntry, line, account))
+		}
+	}
+
+	if !sawStatement {
+		return nil, errors.New("not a camt.052 or camt.053 document")
+	}
+
+	return entries, nil
+}
+
+func camtToEntry(ntry camtEntry, line int, account *string) Entry {
+	entry := Entry{Line: line}
+
+	status := firstNonEmpty(ntry.Status.Code, ntry.Status.Text)
+	if status != "" && status != "BOOK" {
+		entry.SkipReason = fmt.Sprintf("entry status %s is not booked", status)
+		return entry
+	}
+
+	date, err := camtDate(ntry)
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	currency, err := parseCurrency(ntry.Amount.Currency)
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+	amount, err := ParseAmount(ntry.Amount.Value, '.', amountExponent(currency))
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+	switch strings.TrimSpace(ntry.Indicator) {
+	case "DBIT":
+		amount = -amount
+	case "CRDT":
+	default:
+		entry.Err = fmt.Errorf("invalid CdtDbtInd %q", ntry.Indicator)
+		return entry
+	}
+	if ntry.Reversal {
+		amount = -amount
+	}
+
+	entry.Input.TransactionDate = date
+	entry.Input.AmountCents = amount
+	entry.Input.Currency = currency
+	entry.Input.Description = camtDescription(ntry)
+	if ref := camtReference(ntry); ref != "" {
+		entry.Input.ExternalAccount = account
+		entry.Input.ExternalID = &ref
+	}
+
+	return entry
+}
+
+func camtDate(ntry camtEntry) (time.Time, error) {
+	for _, v := range []string{ntry.BookingDate, ntry.BookingTime, ntry.ValueDate} {
+		v = strings.TrimSpace(v)
+		if len(v) < 10 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", v[:10])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid booking date %q", v)
+		}
+		return date, nil
+	}
+	return time.Time{}, errors.New("missing booking date")
+}
+
+func camtDescription(ntry camtEntry) *string {
+	parts := make([]string, 0)
+	counterparty := ""
+	for _, d := range ntry.Details {
+		for _, v := range d.Unstructured {
+			if v = strings.TrimSpace(v); v != "" {
+				parts = append(parts, v)
+			}
+		}
+		for _, v := range d.Structured {
+			if v = strings.TrimSpace(v); v != "" {
+				parts = append(parts, v)
+			}
+		}
+		if counterparty == "" {
+			if strings.TrimSpace(ntry.Indicator) == "CRDT" {
+				counterparty = firstNonEmpty(d.Debtor, d.DebtorPty)
+			} else {
+				counterparty = firstNonEmpty(d.Creditor, d.CreditorPty)
+			}
+		}
+	}
+
+	description := strings.Join(parts, " ")
+	if description == "" {
+		description = strings.TrimSpace(ntry.AddtlInfo)
+	}
+	if description == "" {
+		description = counterparty
+	}
+	if description == "" {
+		return nil
+	}
+	return &description
+}
+
+func camtReference(ntry camtEntry) string {
+	if ref := strings.TrimSpace(ntry.AcctSvcrRef); ref != "" {
+		return ref
+	}
+	if ref := strings.TrimSpace(ntry.NtryRef); ref != "" {
+		return ref
+	}
+	if len(ntry.Details) == 1 {
+		return strings.TrimSpace(ntry.Details[0].AcctSvcrRef)
+	}
+	return ""
+}
+
+func camtAccountID(acct camtAccount) *string {
+	id := firstNonEmpty(acct.IBAN, acct.OtherID)
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
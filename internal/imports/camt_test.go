@@ -0,0 +1,137 @@
+package imports
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const camt053Statement = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG-1</MsgId></GrpHdr>
+    <Stmt>
+      <Id>STMT-1</Id>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id></Acct>
+      <Ntry>
+        <Amt Ccy="EUR">42.50</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2026-03-02</Dt></BookgDt>
+        <AcctSvcrRef>REF-1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RmtInf><Ustrd>Invoice 123</Ustrd><Ustrd>March</Ustrd></RmtInf>
+          <RltdPties><Cdtr><Nm>Power Co</Nm></Cdtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="CHF">2500.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><DtTm>2026-03-03T08:15:00</DtTm></BookgDt>
+        <AcctSvcrRef>REF-2</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Dbtr><Nm>Employer GmbH</Nm></Dbtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+const camt052Report = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.052.001.08">
+  <BkToCstmrAcctRpt>
+    <Rpt>
+      <Acct><Id><Othr><Id>ACC-77</Id></Othr></Id></Acct>
+      <Ntry>
+        <Amt Ccy="EUR">10.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2026-03-04</Dt></BookgDt>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">10.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <RvslInd>true</RvslInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2026-03-04</Dt></BookgDt>
+        <NtryRef>N-9</NtryRef>
+        <AddtlNtryInf>Card reversal</AddtlNtryInf>
+      </Ntry>
+    </Rpt>
+  </BkToCstmrAcctRpt>
+</Document>
+`
+
+func TestParseCAMT053(t *testing.T) {
+	entries, err := ParseCAMT(strings.NewReader(camt053Statement))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+
+	debit := entries[0]
+	if debit.Err != nil {
+		t.Fatalf("debit error: %v", debit.Err)
+	}
+	if debit.Input.AmountCents != -4250 {
+		t.Fatalf("debit amount = %d, want -4250", debit.Input.AmountCents)
+	}
+	if !debit.Input.TransactionDate.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("debit date = %v", debit.Input.TransactionDate)
+	}
+	if debit.Input.Description == nil || *debit.Input.Description != "Invoice 123 March" {
+		t.Fatalf("debit description = %v", debit.Input.Description)
+	}
+	if debit.Input.ExternalID == nil || *debit.Input.ExternalID != "REF-1" {
+		t.Fatalf("debit external id = %v", debit.Input.ExternalID)
+	}
+	if debit.Input.ExternalAccount == nil || *debit.Input.ExternalAccount != "DE89370400440532013000" {
+		t.Fatalf("debit external account = %v", debit.Input.ExternalAccount)
+	}
+
+	credit := entries[1]
+	if credit.Input.AmountCents != 250000 {
+		t.Fatalf("credit amount = %d, want 250000", credit.Input.AmountCents)
+	}
+	if debit.Input.Currency != "EUR" || credit.Input.Currency != "CHF" {
+		t.Fatalf("currencies = %q, %q, want EUR, CHF", debit.Input.Currency, credit.Input.Currency)
+	}
+	if credit.Input.Description == nil || *credit.Input.Description != "Employer GmbH" {
+		t.Fatalf("credit description = %v", credit.Input.Description)
+	}
+}
+
+func TestParseCAMT052(t *testing.T) {
+	entries, err := ParseCAMT(strings.NewReader(camt052Report))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+
+	if entries[0].SkipReason == "" {
+		t.Fatalf("expected pending entry to be skipped")
+	}
+
+	reversal := entries[1]
+	if reversal.Err != nil {
+		t.Fatalf("reversal error: %v", reversal.Err)
+	}
+	if reversal.Input.AmountCents != 1000 {
+		t.Fatalf("reversal amount = %d, want 1000", reversal.Input.AmountCents)
+	}
+	if reversal.Input.ExternalAccount == nil || *reversal.Input.ExternalAccount != "ACC-77" {
+		t.Fatalf("reversal external account = %v", reversal.Input.ExternalAccount)
+	}
+}
+
+func TestParseCAMTRejectsOtherDocuments(t *testing.T) {
+	if _, err := ParseCAMT(strings.NewReader(`<Document><CstmrCdtTrfInitn/></Document>`)); err == nil {
+		t.Fatalf("expected error for non camt.052/053 document")
+	}
+}
@@ -0,0 +1,158 @@
+package imports
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// CSVOptions maps statement columns, identified by their header, onto
+// transaction fields. CategoryColumn is optional.
+type CSVOptions struct {
+	DateColumn        string
+	AmountColumn      string
+	DescriptionColumn string
+	CategoryColumn    string
+	DateLayout        string
+	Delimiter         rune
+	DecimalSeparator  rune
+}
+
+var dateLayouts = map[string]string{
+	"YYYY-MM-DD": "2006-01-02",
+	"DD/MM/YYYY": "02/01/2006",
+	"MM/DD/YYYY": "01/02/2006",
+	"DD.MM.YYYY": "02.01.2006",
+	"DD-MM-YYYY": "02-01-2006",
+}
+
+// DateLayout returns the Go time layout for a user facing date format such as
+// "DD/MM/YYYY".
+func DateLayout(format string) (string, bool) {
+	layout, ok := dateLayouts[format]
+	return layout, ok
+}
+
+// ParseCSV reads a statement with a header row and returns one entry per data
+// row. Row level problems are reported on the entry; an error is only returned
+// when the file itself cannot be used (unreadable header, missing columns).
+func ParseCSV(r io.Reader, opts CSVOptions) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = opts.Delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv file is empty")
+		}
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	dateIdx, err := columnIndex(header, opts.DateColumn)
+	if err != nil {
+		return nil, err
+	}
+	amountIdx, err := columnIndex(header, opts.AmountColumn)
+	if err != nil {
+		return nil, err
+	}
+	descriptionIdx := -1
+	if opts.DescriptionColumn != "" {
+		descriptionIdx, err = columnIndex(header, opts.DescriptionColumn)
+		if err != nil {
+			return nil, err
+		}
+	}
+	categoryIdx := -1
+	if opts.CategoryColumn != "" {
+		categoryIdx, err = columnIndex(header, opts.CategoryColumn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entries := make([]Entry, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				entries = append(entries, Entry{Line: parseErr.StartLine, Err: parseErr.Err})
+				continue
+			}
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		entry := Entry{Line: line}
+
+		if isBlankRecord(record) {
+			entry.SkipReason = "empty row"
+			entries = append(entries, entry)
+			continue
+		}
+
+		dateValue := field(record, dateIdx)
+		date, err := time.Parse(opts.DateLayout, dateValue)
+		if err != nil {
+			entry.Err = fmt.Errorf("invalid date %q", dateValue)
+			entries = append(entries, entry)
+			continue
+		}
+
+		amount, err := ParseAmount(field(record, amountIdx), opts.DecimalSeparator, unknownExponent)
+		if err != nil {
+			entry.Err = err
+			entries = append(entries, entry)
+			continue
+		}
+
+		entry.Input.TransactionDate = date
+		entry.Input.AmountCents = amount
+		if description := field(record, descriptionIdx); description != "" {
+			entry.Input.Description = &description
+		}
+		if category := field(record, categoryIdx); category != "" {
+			entry.CategoryName = &category
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func columnIndex(header []string, name string) (int, error) {
+	want := strings.ToLower(strings.TrimSpace(name))
+	for i, h := range header {
+		h = strings.TrimPrefix(h, "\ufeff")
+		if strings.ToLower(strings.TrimSpace(h)) == want {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("column %q not found in csv header", name)
+}
+
+func field(record []string, idx int) string {
+	if idx < 0 || idx >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[idx])
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
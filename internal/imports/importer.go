@@ -0,0 +1,173 @@
+package imports
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/categorization"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/payees"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type Importer struct {
+	db           *sql.DB
+	transactions *transactions.Repository
+	categories   *categories.Repository
+	rules        *categorization.Repository
+	payees       *payees.Repository
+}
+
+func NewImporter(db *sql.DB, transactions *transactions.Repository, categories *categories.Repository, rules *categorization.Repository, payees *payees.Repository) *Importer {
+	return &Importer{db: db, transactions: transactions, categories: categories, rules: rules, payees: payees}
+}
+
+// Import creates a transaction for every valid entry inside a single database
+// transaction. Rows that fail validation are reported and do not abort the
+// import; database errors do, and nothing is written in that case. Rows
+// without a category are categorized by the categorization rules, and rows are
+// linked to the payee whose patterns match their description. Rows whose file
+// names no currency are imported in the base currency.
+func (i *Importer) Import(ctx context.Context, entries []Entry, dryRun bool) (Result, error) {
+	result := Result{DryRun: dryRun, Rows: make([]RowResult, 0, len(entries))}
+
+	err := db.InTx(ctx, i.db, func(tx *sql.Tx) error {
+		categoriesByName, err := i.categoriesByName(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		rules, err := i.rules.WithTx(tx).ListApplicable(ctx)
+		if err != nil {
+			return err
+		}
+		matcher, err := categorization.NewMatcher(rules, i.transactions.BaseCurrency())
+		if err != nil {
+			return err
+		}
+
+		payeeList, err := i.payees.WithTx(tx).List(ctx)
+		if err != nil {
+			return err
+		}
+		payeeMatcher, err := payees.NewMatcher(payeeList)
+		if err != nil {
+			return err
+		}
+
+		txRepo := i.transactions.WithTx(tx)
+		seen := make(map[string]bool)
+		for _, entry := range entries {
+			if entry.Err != nil {
+				result.add(failedRow(entry.Line, entry.Err.Error()))
+				continue
+			}
+			if entry.SkipReason != "" {
+				result.add(skippedRow(entry.Line, entry.SkipReason))
+				continue
+			}
+
+			in := entry.Input
+			if in.Currency == "" {
+				base := i.transactions.BaseCurrency()
+				amount, ok := rescaleAmount(in.AmountCents, unknownExponent, transactions.MinorUnitExponent(base))
+				if !ok {
+					result.add(failedRow(entry.Line, fmt.Sprintf("amount has more decimals than %s allows", base)))
+					continue
+				}
+				in.Currency = base
+				in.AmountCents = amount
+			}
+			if entry.CategoryName != nil {
+				category, ok := categoriesByName[normalizeName(*entry.CategoryName)]
+				if !ok {
+					result.add(failedRow(entry.Line, fmt.Sprintf("unknown category %q", *entry.CategoryName)))
+					continue
+				}
+				if category.ArchivedAt != nil {
+					result.add(failedRow(entry.Line, fmt.Sprintf("category %q is archived", *entry.CategoryName)))
+					continue
+				}
+				in.CategoryID = &category.ID
+			}
+			matcher.Categorize(&in)
+			payeeMatcher.Normalize(&in)
+
+			if in.ExternalID != nil {
+				key := externalKey(in.ExternalAccount, *in.ExternalID)
+				if seen[key] {
+					result.add(skippedRow(entry.Line, fmt.Sprintf("duplicate external id %q in file", *in.ExternalID)))
+					continue
+				}
+				seen[key] = true
+
+				exists, err := txRepo.ExternalIDExists(ctx, in.ExternalAccount, *in.ExternalID)
+				if err != nil {
+					return err
+				}
+				if exists {
+					result.add(skippedRow(entry.Line, fmt.Sprintf("external id %q already imported", *in.ExternalID)))
+					continue
+				}
+			}
+
+			if dryRun {
+				result.add(RowResult{Line: entry.Line, Status: RowCreated})
+				continue
+			}
+
+			created, err := txRepo.Create(ctx, in)
+			if err != nil {
+				return fmt.Errorf("import line %d: %w", entry.Line, err)
+			}
+			result.add(RowResult{Line: entry.Line, Status: RowCreated, TransactionID: &created.ID})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+func (i *Importer) categoriesByName(ctx context.Context, tx *sql.Tx) (map[string]categories.Category, error) {
+	list, err := i.categories.WithTx(tx).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]categories.Category, len(list))
+	for _, c := range list {
+		key := normalizeName(c.Name)
+		// An active category wins over an archived one with the same name.
+		if existing, ok := byName[key]; ok && existing.ArchivedAt == nil && c.ArchivedAt != nil {
+			continue
+		}
+		byName[key] = c
+	}
+	return byName, nil
+}
+
+func externalKey(account *string, id string) string {
+	if account == nil {
+		return "\x00" + id
+	}
+	return *account + "\x00" + id
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func failedRow(line int, message string) RowResult {
+	return RowResult{Line: line, Status: RowFailed, Message: &message}
+}
+
+func skippedRow(line int, message string) RowResult {
+	return RowResult{Line: line, Status: RowSkipped, Message: &message}
+}
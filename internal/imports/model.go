@@ -0,0 +1,53 @@
+package imports
+
+import "zankowitch.com/go-db-app/internal/transactions"
+
+// Entry is one parsed statement row waiting to be imported. Line is the
+// 1-based line number in the source file. Err is set when the row could not be
+// parsed; CategoryName is resolved against existing categories on import. The
+// amount is in minor units of the entry's currency, or has unknownExponent
+// decimals when the file does not name one.
+type Entry struct {
+	Line         int
+	Input        transactions.CreateInput
+	CategoryName *string
+	SkipReason   string
+	Err          error
+}
+
+type RowStatus string
+
+const (
+	RowCreated RowStatus = "created"
+	RowSkipped RowStatus = "skipped"
+	RowFailed  RowStatus = "failed"
+)
+
+type RowResult struct {
+	Line          int
+	Status        RowStatus
+	TransactionID *int64
+	Message       *string
+}
+
+// Result reports the outcome of every imported row. In dry-run mode the
+// counters describe what would have happened and no transaction ids are set.
+type Result struct {
+	DryRun  bool
+	Created int
+	Skipped int
+	Failed  int
+	Rows    []RowResult
+}
+
+func (r *Result) add(row RowResult) {
+	switch row.Status {
+	case RowCreated:
+		r.Created++
+	case RowSkipped:
+		r.Skipped++
+	case RowFailed:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, row)
+}
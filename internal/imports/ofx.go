@@ -0,0 +1,194 @@
+package imports
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+)
+
+// ParseOFX reads an OFX 1.x (SGML) or 2.x (XML) statement, including QFX
+// files, and returns one entry per STMTTRN. Each entry carries the bank's
+// FITID as ExternalID, scoped by the statement account, so re-importing an
+// overlapping statement can skip rows that already exist. Amounts are in the
+// statement's CURDEF currency.
+func ParseOFX(r io.Reader) ([]Entry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read ofx: %w", err)
+	}
+
+	content := string(data)
+	start := strings.Index(content, "<OFX>")
+	if start < 0 {
+		start = strings.Index(content, "<ofx>")
+	}
+	if start < 0 {
+		return nil, errors.New("not an OFX file: missing <OFX> element")
+	}
+
+	var entries []Entry
+	var bankID, acctID, curDef string
+	var txn map[string]string
+	var txnLine int
+	inTxn := false
+	emitTxn := func() {
+		entries = append(entries, ofxEntry(txn, txnLine, ofxAccount(bankID, acctID), curDef))
+		inTxn = false
+	}
+
+	line := 1 + strings.Count(content[:start], "\n")
+	rest := content[start:]
+	for {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			break
+		}
+		line += strings.Count(rest[:open], "\n")
+		rest = rest[open:]
+
+		closeIdx := strings.IndexByte(rest, '>')
+		if closeIdx < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(rest[1:closeIdx]))
+		tagLine := line
+		line += strings.Count(rest[:closeIdx], "\n")
+		rest = rest[closeIdx+1:]
+
+		if strings.HasPrefix(tag, "?") || strings.HasPrefix(tag, "!") {
+			continue
+		}
+
+		if name, ok := strings.CutPrefix(tag, "/"); ok {
+			switch name {
+			case "STMTTRN", "BANKTRANLIST":
+				if inTxn {
+					emitTxn()
+				}
+			}
+			continue
+		}
+
+		valueEnd := strings.IndexByte(rest, '<')
+		if valueEnd < 0 {
+			valueEnd = len(rest)
+		}
+		value := strings.TrimSpace(html.UnescapeString(rest[:valueEnd]))
+
+		switch {
+		case tag == "STMTTRN":
+			if inTxn {
+				emitTxn()
+			}
+			txn = make(map[string]string)
+			txnLine = tagLine
+			inTxn = true
+		case tag == "STMTRS" || tag == "CCSTMTRS":
+			curDef = ""
+		case tag == "BANKACCTFROM" || tag == "CCACCTFROM":
+			bankID, acctID = "", ""
+		case value == "":
+		case inTxn:
+			txn[tag] = value
+		case tag == "BANKID":
+			bankID = value
+		case tag == "ACCTID":
+			acctID = value
+		case tag == "CURDEF":
+			curDef = value
+		}
+	}
+
+	if inTxn {
+		emitTxn()
+	}
+
+	return entries, nil
+}
+
+func ofxEntry(fields map[string]string, line int, account *string, curDef string) Entry {
+	entry := Entry{Line: line}
+
+	date, err := parseOFXDate(fields["DTPOSTED"])
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	currency, err := parseCurrency(curDef)
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	amount, err := parseOFXAmount(fields["TRNAMT"], amountExponent(currency))
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	entry.Input.TransactionDate = date
+	entry.Input.AmountCents = amount
+	entry.Input.Currency = currency
+	entry.Input.Description = ofxDescription(fields["NAME"], fields["MEMO"])
+	if fitID := fields["FITID"]; fitID != "" {
+		entry.Input.ExternalAccount = account
+		entry.Input.ExternalID = &fitID
+	}
+
+	return entry
+}
+
+// parseOFXDate accepts the OFX datetime format YYYYMMDD[HHMMSS[.XXX]][[TZ]]
+// and keeps the calendar date only.
+func parseOFXDate(v string) (time.Time, error) {
+	if len(v) < 8 {
+		return time.Time{}, fmt.Errorf("invalid DTPOSTED %q", v)
+	}
+	date, err := time.Parse("20060102", v[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DTPOSTED %q", v)
+	}
+	return date, nil
+}
+
+func parseOFXAmount(v string, exponent int) (int64, error) {
+	if v == "" {
+		return 0, errors.New("missing TRNAMT")
+	}
+	decimalSep := '.'
+	if strings.Contains(v, ",") && !strings.Contains(v, ".") {
+		decimalSep = ','
+	}
+	return ParseAmount(v, decimalSep, exponent)
+}
+
+func ofxDescription(name, memo string) *string {
+	var description string
+	switch {
+	case name != "" && memo != "" && !strings.EqualFold(name, memo):
+		description = name + " - " + memo
+	case name != "":
+		description = name
+	default:
+		description = memo
+	}
+	if description == "" {
+		return nil
+	}
+	return &description
+}
+
+func ofxAccount(bankID, acctID string) *string {
+	if acctID == "" {
+		return nil
+	}
+	account := acctID
+	if bankID != "" {
+		account = bankID + "/" + acctID
+	}
+	return &account
+}
@@ -0,0 +1,147 @@
+package imports
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+
+<OFX>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<STMTRS>
+<CURDEF>USD
+<BANKACCTFROM>
+<BANKID>121000248
+<ACCTID>4001
+<ACCTTYPE>CHECKING
+</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20260101
+<DTEND>20260131
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260105120000.000[-5:EST]
+<TRNAMT>-42.10
+<FITID>A-1
+<NAME>GROCERY &amp; CO
+<MEMO>card 1234
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260110
+<TRNAMT>1500.00
+<FITID>A-2
+<NAME>PAYROLL
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <CCSTMTRS>
+        <CURDEF>EUR</CURDEF>
+        <CCACCTFROM><ACCTID>9999</ACCTID></CCACCTFROM>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20260203</DTPOSTED>
+            <TRNAMT>-9,99</TRNAMT>
+            <FITID>CC-1</FITID>
+            <MEMO>Streaming</MEMO>
+          </STMTTRN>
+          <STMTTRN>
+            <DTPOSTED>bad</DTPOSTED>
+            <TRNAMT>-1.00</TRNAMT>
+            <FITID>CC-2</FITID>
+          </STMTTRN>
+        </BANKTRANLIST>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFXSGML(t *testing.T) {
+	entries, err := ParseOFX(strings.NewReader(sgmlStatement))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+
+	first := entries[0]
+	if first.Err != nil {
+		t.Fatalf("first entry error: %v", first.Err)
+	}
+	if first.Line != 19 {
+		t.Fatalf("first line = %d, want 19", first.Line)
+	}
+	if !first.Input.TransactionDate.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("first date = %v", first.Input.TransactionDate)
+	}
+	if first.Input.AmountCents != -4210 {
+		t.Fatalf("first amount = %d, want -4210", first.Input.AmountCents)
+	}
+	if first.Input.Currency != "USD" {
+		t.Fatalf("first currency = %q, want USD", first.Input.Currency)
+	}
+	if first.Input.Description == nil || *first.Input.Description != "GROCERY & CO - card 1234" {
+		t.Fatalf("first description = %v", first.Input.Description)
+	}
+	if first.Input.ExternalID == nil || *first.Input.ExternalID != "A-1" {
+		t.Fatalf("first external id = %v", first.Input.ExternalID)
+	}
+	if first.Input.ExternalAccount == nil || *first.Input.ExternalAccount != "121000248/4001" {
+		t.Fatalf("first external account = %v", first.Input.ExternalAccount)
+	}
+
+	if entries[1].Input.AmountCents != 150000 {
+		t.Fatalf("second amount = %d, want 150000", entries[1].Input.AmountCents)
+	}
+}
+
+func TestParseOFXXML(t *testing.T) {
+	entries, err := ParseOFX(strings.NewReader(xmlStatement))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+
+	if entries[0].Err != nil {
+		t.Fatalf("first entry error: %v", entries[0].Err)
+	}
+	if entries[0].Input.AmountCents != -999 {
+		t.Fatalf("first amount = %d, want -999", entries[0].Input.AmountCents)
+	}
+	if entries[0].Input.Currency != "EUR" {
+		t.Fatalf("first currency = %q, want EUR", entries[0].Input.Currency)
+	}
+	if entries[0].Input.ExternalAccount == nil || *entries[0].Input.ExternalAccount != "9999" {
+		t.Fatalf("first external account = %v", entries[0].Input.ExternalAccount)
+	}
+	if entries[1].Err == nil {
+		t.Fatalf("expected invalid date error on second entry")
+	}
+}
+
+func TestParseOFXRejectsOtherFiles(t *testing.T) {
+	if _, err := ParseOFX(strings.NewReader("date,amount\n2026-01-01,1.00\n")); err == nil {
+		t.Fatalf("expected error for non-OFX input")
+	}
+}
@@ -0,0 +1,96 @@
+package payees
+
+import (
+	"regexp"
+	"sort"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Matcher maps descriptions to payees. Build one per request or import so the
+// patterns are read and compiled once.
+type Matcher struct {
+	payees []compiledPayee
+}
+
+type compiledPayee struct {
+	id       int64
+	patterns []*regexp.Regexp
+}
+
+// NewMatcher compiles the patterns of payees. Payees are tried in ID order and
+// their patterns in the order they were given; the first match wins.
+func NewMatcher(payees []Payee) (*Matcher, error) {
+	compiled := make([]compiledPayee, 0, len(payees))
+	for _, p := range payees {
+		if len(p.Patterns) == 0 {
+			continue
+		}
+		c := compiledPayee{id: p.ID, patterns: make([]*regexp.Regexp, 0, len(p.Patterns))}
+		for _, pattern := range p.Patterns {
+			re, err := compilePattern(pattern)
+			if err != nil {
+				return nil, err
+			}
+			c.patterns = append(c.patterns, re)
+		}
+		compiled = append(compiled, c)
+	}
+	sort.Slice(compiled, func(i, j int) bool { return compiled[i].id < compiled[j].id })
+
+	return &Matcher{payees: compiled}, nil
+}
+
+// Match returns the ID of the first payee with a pattern matching description.
+func (m *Matcher) Match(description *string) (int64, bool) {
+	if description == nil {
+		return 0, false
+	}
+	for _, p := range m.payees {
+		for _, re := range p.patterns {
+			if re.MatchString(*description) {
+				return p.id, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Normalize sets in.PayeeID from the first matching pattern and reports
+// whether it did. Transactions that already have a payee, and transfer legs,
+// are left alone.
+func (m *Matcher) Normalize(in *transactions.CreateInput) bool {
+	if in.PayeeID != nil || in.TransferID != nil {
+		return false
+	}
+
+	payeeID, ok := m.Match(in.Description)
+	if !ok {
+		return false
+	}
+	in.PayeeID = &payeeID
+	return true
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, ErrInvalidPattern
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, ErrInvalidPattern
+	}
+	return re, nil
+}
+
+func validate(name string, patterns []string) error {
+	if name == "" {
+		return ErrBlankName
+	}
+	for _, p := range patterns {
+		if _, err := compilePattern(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package payees
+
+import (
+	"errors"
+	"testing"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMatcherFirstMatchWins(t *testing.T) {
+	m, err := NewMatcher([]Payee{
+		{ID: 3, Name: "Amazon Prime", Patterns: []string{`^AMZN PRIME`}},
+		{ID: 1, Name: "Amazon", Patterns: []string{`^AMZN MKTP`, `^AMAZON\b`}},
+		{ID: 2, Name: "Bakery", Patterns: []string{`bakery`}},
+		{ID: 4, Name: "Manual"},
+	})
+	if err != nil {
+		t.Fatalf("new matcher: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		description *string
+		wantPayee   int64
+	}{
+		{"bank reference", ptr("AMZN MKTP DE*1A2B3"), 1},
+		{"second pattern", ptr("Amazon EU SARL"), 1},
+		{"case-insensitive", ptr("The Corner BAKERY"), 2},
+		{"own pattern", ptr("AMZN PRIME DE"), 3},
+		{"no match", ptr("rent"), 0},
+		{"no description", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payeeID, ok := m.Match(tt.description)
+			if tt.wantPayee == 0 {
+				if ok {
+					t.Fatalf("matched payee %d, want none", payeeID)
+				}
+				return
+			}
+			if !ok || payeeID != tt.wantPayee {
+				t.Fatalf("matched payee %d (%v), want %d", payeeID, ok, tt.wantPayee)
+			}
+		})
+	}
+}
+
+func TestMatcherTriesPayeesInIDOrder(t *testing.T) {
+	m, err := NewMatcher([]Payee{
+		{ID: 9, Patterns: []string{`AMZN`}},
+		{ID: 5, Patterns: []string{`AMZN MKTP`}},
+	})
+	if err != nil {
+		t.Fatalf("new matcher: %v", err)
+	}
+
+	if payeeID, _ := m.Match(ptr("AMZN MKTP DE")); payeeID != 5 {
+		t.Fatalf("matched payee %d, want 5", payeeID)
+	}
+}
+
+func TestNormalizeKeepsExistingPayee(t *testing.T) {
+	m, err := NewMatcher([]Payee{{ID: 1, Patterns: []string{`rent`}}})
+	if err != nil {
+		t.Fatalf("new matcher: %v", err)
+	}
+
+	in := transactions.CreateInput{Description: ptr("rent"), PayeeID: ptr(int64(5))}
+	if m.Normalize(&in) || *in.PayeeID != 5 {
+		t.Fatalf("payee = %d, want 5 kept", *in.PayeeID)
+	}
+
+	in = transactions.CreateInput{Description: ptr("rent"), TransferID: ptr(int64(2))}
+	if m.Normalize(&in) || in.PayeeID != nil {
+		t.Fatal("transfer leg was given a payee")
+	}
+
+	in = transactions.CreateInput{Description: ptr("rent")}
+	if !m.Normalize(&in) || *in.PayeeID != 1 {
+		t.Fatal("transaction without payee was not normalized")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := validate("", nil); !errors.Is(err, ErrBlankName) {
+		t.Fatalf("err = %v, want ErrBlankName", err)
+	}
+	if err := validate("Amazon", []string{"("}); !errors.Is(err, ErrInvalidPattern) {
+		t.Fatalf("err = %v, want ErrInvalidPattern", err)
+	}
+	if err := validate("Amazon", []string{""}); !errors.Is(err, ErrInvalidPattern) {
+		t.Fatalf("err = %v, want ErrInvalidPattern", err)
+	}
+	if err := validate("Amazon", []string{`^AMZN\s`}); err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+}
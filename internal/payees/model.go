@@ -0,0 +1,64 @@
+package payees
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBlankName      = errors.New("name must not be blank")
+	ErrInvalidPattern = errors.New("patterns must be non-empty regular expressions")
+	ErrMergeIntoSelf  = errors.New("a payee cannot be merged into itself")
+	ErrTargetNotFound = errors.New("target payee not found")
+)
+
+// Payee is who a transaction was paid to or received from. Patterns are
+// case-insensitive Go regular expressions that map raw bank descriptions,
+// such as "AMZN MKTP DE*1A2B3", to the payee. Names are unique ignoring case.
+type Payee struct {
+	ID        int64
+	Name      string
+	Patterns  []string
+	CreatedAt time.Time
+}
+
+type CreateInput struct {
+	Name     string
+	Patterns []string
+}
+
+// UpdateInput renames the payee and replaces its patterns.
+type UpdateInput struct {
+	Name     string
+	Patterns []string
+}
+
+// MergeResult is the payee left after a merge, with how many transactions and
+// patterns moved to it from the merged payee.
+type MergeResult struct {
+	Target            Payee
+	TransactionsMoved int64
+	PatternsMoved     int64
+}
+
+// Assignment is a transaction without a payee that a pattern links to one.
+type Assignment struct {
+	TransactionID int64
+	Description   *string
+	PayeeID       int64
+}
+
+// ApplyResult lists the transactions linked to a payee by a run over existing
+// rows. In dry-run mode nothing is written.
+type ApplyResult struct {
+	DryRun  bool
+	Changes []Assignment
+}
+
+// TopPayee is a payee's spending over a date range. Transfer legs are left out.
+type TopPayee struct {
+	PayeeID          int64
+	Name             string
+	SpendingCents    int64
+	TransactionCount int64
+}
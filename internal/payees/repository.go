@@ -0,0 +1,294 @@
+package payees
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// patternsColumn aggregates the patterns of each row of the payees relation as
+// a JSON array, in the order they are tried.
+const patternsColumn = `COALESCE((
+	SELECT jsonb_agg(pp.pattern ORDER BY pp.position, pp.id)
+	FROM payee_patterns pp
+	WHERE pp.payee_id = payees.id
+), '[]'::jsonb)`
+
+const payeeColumns = `id, name, ` + patternsColumn + `, created_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPayee(row rowScanner) (Payee, error) {
+	var p Payee
+	var patterns []byte
+	if err := row.Scan(&p.ID, &p.Name, &patterns, &p.CreatedAt); err != nil {
+		return Payee{}, err
+	}
+
+	p.Patterns = make([]string, 0)
+	if err := json.Unmarshal(patterns, &p.Patterns); err != nil {
+		return Payee{}, err
+	}
+
+	return p, nil
+}
+
+// insertPatternsFrom stores the patterns in JSON array placeholder param, in
+// order, for every row of the named CTE.
+func insertPatternsFrom(cte, param string) string {
+	return `
+		INSERT INTO payee_patterns (payee_id, position, pattern)
+		SELECT ` + cte + `.id, p.position, p.pattern
+		FROM ` + cte + `, jsonb_array_elements_text(` + param + `::jsonb) WITH ORDINALITY AS p(pattern, position)
+	`
+}
+
+// Create stores a payee and its patterns. A name already used, ignoring case,
+// is a unique violation.
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Payee, error) {
+	patterns := patternsOrEmpty(in.Patterns)
+	encoded, err := json.Marshal(patterns)
+	if err != nil {
+		return Payee{}, err
+	}
+
+	// The statement's snapshot does not see the inserted patterns, so they
+	// are set from the input after the scan.
+	query := `
+		WITH created AS (
+			INSERT INTO payees (name)
+			VALUES ($1)
+			RETURNING *
+		), patterns AS (` + insertPatternsFrom("created", "$2") + `)
+		SELECT ` + payeeColumns + `
+		FROM created AS payees
+	`
+
+	p, err := scanPayee(r.db.QueryRowContext(ctx, query, in.Name, string(encoded)))
+	if err != nil {
+		return Payee{}, err
+	}
+	p.Patterns = patterns
+	return p, nil
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Payee, error) {
+	const query = `
+		SELECT ` + payeeColumns + `
+		FROM payees
+		WHERE id = $1
+	`
+
+	return scanPayee(r.db.QueryRowContext(ctx, query, id))
+}
+
+// GetForUpdate is Get that also locks the payee row until the end of the
+// surrounding transaction.
+func (r *Repository) GetForUpdate(ctx context.Context, id int64) (Payee, error) {
+	const query = `
+		SELECT ` + payeeColumns + `
+		FROM payees
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	return scanPayee(r.db.QueryRowContext(ctx, query, id))
+}
+
+// List returns every payee sorted by name, ignoring case.
+func (r *Repository) List(ctx context.Context) ([]Payee, error) {
+	const query = `
+		SELECT ` + payeeColumns + `
+		FROM payees
+		ORDER BY lower(name) ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Payee, 0)
+	for rows.Next() {
+		p, err := scanPayee(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Update renames a payee and replaces its patterns. Transactions already
+// linked to the payee keep it.
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Payee, error) {
+	patterns := patternsOrEmpty(in.Patterns)
+	encoded, err := json.Marshal(patterns)
+	if err != nil {
+		return Payee{}, err
+	}
+
+	query := `
+		WITH updated AS (
+			UPDATE payees
+			SET name = $1
+			WHERE id = $2
+			RETURNING *
+		), cleared AS (
+			DELETE FROM payee_patterns
+			WHERE payee_id IN (SELECT id FROM updated)
+		), patterns AS (` + insertPatternsFrom("updated", "$3") + `)
+		SELECT ` + payeeColumns + `
+		FROM updated AS payees
+	`
+
+	p, err := scanPayee(r.db.QueryRowContext(ctx, query, in.Name, id, string(encoded)))
+	if err != nil {
+		return Payee{}, err
+	}
+	p.Patterns = patterns
+	return p, nil
+}
+
+// Delete removes a payee and its patterns. Its transactions are kept without
+// a payee.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM payees WHERE id = $1`
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Reassign moves the transactions and patterns of payee source to payee
+// target. The moved patterns are tried after the target's own.
+func (r *Repository) Reassign(ctx context.Context, source, target int64) (transactionsMoved, patternsMoved int64, err error) {
+	const moveTransactions = `UPDATE transactions SET payee_id = $1 WHERE payee_id = $2`
+
+	res, err := r.db.ExecContext(ctx, moveTransactions, target, source)
+	if err != nil {
+		return 0, 0, err
+	}
+	if transactionsMoved, err = res.RowsAffected(); err != nil {
+		return 0, 0, err
+	}
+
+	const movePatterns = `
+		UPDATE payee_patterns
+		SET payee_id = $1,
+			position = position + (SELECT COALESCE(MAX(position), 0) FROM payee_patterns WHERE payee_id = $1)
+		WHERE payee_id = $2
+	`
+
+	res, err = r.db.ExecContext(ctx, movePatterns, target, source)
+	if err != nil {
+		return 0, 0, err
+	}
+	if patternsMoved, err = res.RowsAffected(); err != nil {
+		return 0, 0, err
+	}
+
+	return transactionsMoved, patternsMoved, nil
+}
+
+// spendingFilter selects the transactions TopPayees ranks payees by, dated
+// between $1 and $2.
+const spendingFilter = `
+	t.payee_id IS NOT NULL
+	AND t.amount < 0
+	AND t.transfer_id IS NULL
+	AND t.deleted_at IS NULL
+	AND ($1::date IS NULL OR t.transaction_date >= $1)
+	AND ($2::date IS NULL OR t.transaction_date <= $2)
+`
+
+// TopPayees ranks payees by spending on transactions dated between from and
+// to, both inclusive and optional, and returns the first limit. Spending is in
+// minor units of the base currency. Income, transfer legs and trashed
+// transactions are left out, as are payees with no spending in the range. It
+// returns transactions.ErrMissingRate when a transaction cannot be converted.
+func (r *Repository) TopPayees(ctx context.Context, from, to *time.Time, limit int) ([]TopPayee, error) {
+	if err := transactions.CheckRates(ctx, r.db, r.baseCurrency, spendingFilter, 3, from, to, r.baseCurrency); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT
+			p.id,
+			p.name,
+			(SUM(-t.amount * exchange_factor(t.currency, $3::text, t.transaction_date)) * ` + transactions.ScaleOfParam(3) + `)::bigint AS spending,
+			COUNT(t.id)
+		FROM transactions t
+		JOIN payees p ON p.id = t.payee_id
+		WHERE ` + spendingFilter + `
+		GROUP BY p.id, p.name
+		ORDER BY spending DESC, p.id ASC
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, from, to, r.baseCurrency, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	top := make([]TopPayee, 0)
+	for rows.Next() {
+		var p TopPayee
+		if err := rows.Scan(&p.PayeeID, &p.Name, &p.SpendingCents, &p.TransactionCount); err != nil {
+			return nil, err
+		}
+		top = append(top, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return top, nil
+}
+
+func patternsOrEmpty(patterns []string) []string {
+	if patterns == nil {
+		return []string{}
+	}
+	return patterns
+}
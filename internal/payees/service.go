@@ -0,0 +1,174 @@
+package payees
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service manages payees and links new and existing transactions to them
+// through their patterns.
+type Service struct {
+	db           *sql.DB
+	repo         *Repository
+	transactions *transactions.Repository
+}
+
+func NewService(db *sql.DB, repo *Repository, transactions *transactions.Repository) *Service {
+	return &Service{db: db, repo: repo, transactions: transactions}
+}
+
+func (s *Service) Create(ctx context.Context, in CreateInput) (Payee, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if err := validate(in.Name, in.Patterns); err != nil {
+		return Payee{}, err
+	}
+
+	return s.repo.Create(ctx, in)
+}
+
+func (s *Service) Get(ctx context.Context, id int64) (Payee, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context) ([]Payee, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *Service) Update(ctx context.Context, id int64, in UpdateInput) (Payee, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if err := validate(in.Name, in.Patterns); err != nil {
+		return Payee{}, err
+	}
+
+	return s.repo.Update(ctx, id, in)
+}
+
+// Delete removes the payee. Its transactions lose their reference to it in the
+// same database transaction.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		return s.repo.WithTx(tx).Delete(ctx, id)
+	})
+}
+
+// Merge folds payee id into payee targetID: its transactions and patterns move
+// to the target and it is deleted. A missing source is sql.ErrNoRows; a
+// missing target is ErrTargetNotFound.
+func (s *Service) Merge(ctx context.Context, id, targetID int64) (MergeResult, error) {
+	if id == targetID {
+		return MergeResult{}, ErrMergeIntoSelf
+	}
+
+	var result MergeResult
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		repo := s.repo.WithTx(tx)
+
+		// Both rows are locked in id order so concurrent merges cannot
+		// deadlock.
+		first, second := id, targetID
+		if second < first {
+			first, second = second, first
+		}
+		for _, lockID := range []int64{first, second} {
+			if _, err := repo.GetForUpdate(ctx, lockID); err != nil {
+				if errors.Is(err, sql.ErrNoRows) && lockID == targetID {
+					return ErrTargetNotFound
+				}
+				return err
+			}
+		}
+
+		moved, patterns, err := repo.Reassign(ctx, id, targetID)
+		if err != nil {
+			return err
+		}
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		target, err := repo.Get(ctx, targetID)
+		if err != nil {
+			return err
+		}
+
+		result = MergeResult{Target: target, TransactionsMoved: moved, PatternsMoved: patterns}
+		return nil
+	})
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	return result, nil
+}
+
+// Normalize fills in.PayeeID from the patterns when the transaction has no
+// payee; see Matcher.Normalize.
+func (s *Service) Normalize(ctx context.Context, in *transactions.CreateInput) error {
+	if in.PayeeID != nil || in.TransferID != nil {
+		return nil
+	}
+
+	matcher, err := s.matcher(ctx, s.repo)
+	if err != nil {
+		return err
+	}
+
+	matcher.Normalize(in)
+	return nil
+}
+
+// Apply runs the patterns over the existing transactions without a payee.
+// With dryRun it only reports what would change.
+func (s *Service) Apply(ctx context.Context, dryRun bool) (ApplyResult, error) {
+	result := ApplyResult{DryRun: dryRun, Changes: make([]Assignment, 0)}
+
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		matcher, err := s.matcher(ctx, s.repo.WithTx(tx))
+		if err != nil {
+			return err
+		}
+
+		txRepo := s.transactions.WithTx(tx)
+		candidates, err := txRepo.ListWithoutPayee(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range candidates {
+			payeeID, ok := matcher.Match(t.Description)
+			if !ok {
+				continue
+			}
+			if !dryRun {
+				if err := txRepo.SetPayee(ctx, t.ID, payeeID); err != nil {
+					return err
+				}
+			}
+			result.Changes = append(result.Changes, Assignment{
+				TransactionID: t.ID,
+				Description:   t.Description,
+				PayeeID:       payeeID,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) matcher(ctx context.Context, repo *Repository) (*Matcher, error) {
+	list, err := repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewMatcher(list)
+}
@@ -0,0 +1,79 @@
+package periodic
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Job is one run of a periodic task. A failure is logged and the next tick
+// runs the job again.
+type Job func(ctx context.Context) error
+
+// Runner calls a Job once at startup and then every interval until the fx
+// application stops.
+type Runner struct {
+	name     string
+	interval time.Duration
+	job      Job
+	logger   *zap.Logger
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewRunner registers the runner on lc. name prefixes its log messages.
+func NewRunner(name string, interval time.Duration, job Job, logger *zap.Logger, lc fx.Lifecycle) *Runner {
+	r := &Runner{name: name, interval: interval, job: job, logger: logger}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			r.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return r.stop(ctx)
+		},
+	})
+
+	return r
+}
+
+func (r *Runner) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			r.runOnce(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (r *Runner) stop(ctx context.Context) error {
+	r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	if err := r.job(ctx); err != nil && ctx.Err() == nil {
+		r.logger.Error(r.name+": run failed", zap.Error(err))
+	}
+}
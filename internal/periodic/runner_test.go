@@ -0,0 +1,38 @@
+package periodic
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+)
+
+func TestRunnerRunsAtStartAndEveryInterval(t *testing.T) {
+	lc := fxtest.NewLifecycle(t)
+	var runs atomic.Int32
+	job := func(ctx context.Context) error {
+		runs.Add(1)
+		return errors.New("keeps running after a failure")
+	}
+	NewRunner("test runner", 5*time.Millisecond, job, zap.NewNop(), lc)
+
+	lc.RequireStart()
+	deadline := time.Now().Add(2 * time.Second)
+	for runs.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	lc.RequireStop()
+
+	got := runs.Load()
+	if got < 3 {
+		t.Fatalf("runs = %d, want at least 3", got)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if runs.Load() != got {
+		t.Fatalf("job ran after stop: %d runs, want %d", runs.Load(), got)
+	}
+}
@@ -0,0 +1,163 @@
+package rates
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ParseXML reads the ECB euro foreign exchange reference rates in their XML
+// format (eurofxref-daily.xml, eurofxref-hist.xml): Cube elements with a time
+// attribute holding one Cube per currency and rate.
+func ParseXML(r io.Reader) ([]Rate, error) {
+	decoder := xml.NewDecoder(r)
+
+	rates := make([]Rate, 0)
+	var date time.Time
+	sawDate := false
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read rates xml: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Cube" {
+			continue
+		}
+
+		attrs := make(map[string]string, len(start.Attr))
+		for _, a := range start.Attr {
+			attrs[a.Name.Local] = a.Value
+		}
+
+		if v, ok := attrs["time"]; ok {
+			date, err = time.Parse(time.DateOnly, strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("invalid Cube time %q", v)
+			}
+			sawDate = true
+			continue
+		}
+		currency, hasCurrency := attrs["currency"]
+		value, hasRate := attrs["rate"]
+		if !hasCurrency || !hasRate {
+			continue
+		}
+		if !sawDate {
+			return nil, fmt.Errorf("rate for %s outside a dated Cube", currency)
+		}
+
+		rate, err := parseRate(currency, value)
+		if err != nil {
+			return nil, err
+		}
+		rate.Date = date
+		rates = append(rates, rate)
+	}
+
+	if !sawDate {
+		return nil, errors.New("not an ECB reference rates document: no dated Cube element")
+	}
+
+	return rates, nil
+}
+
+// ParseCSV reads the ECB reference rates in their CSV format
+// (eurofxref.csv, eurofxref-hist.csv): a Date column followed by one column
+// per currency. Empty and "N/A" cells, for currencies not quoted that day,
+// are skipped.
+func ParseCSV(r io.Reader) ([]Rate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty rates csv")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read rates csv header: %w", err)
+	}
+	if len(header) == 0 || !strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(header[0], "\ufeff")), "Date") {
+		return nil, errors.New("rates csv must start with a Date column")
+	}
+
+	currencies := make([]string, len(header))
+	for i, name := range header[1:] {
+		currencies[i+1] = strings.TrimSpace(name)
+	}
+
+	rates := make([]Rate, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read rates csv: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(record[0]))
+		if err != nil {
+			if date, err = time.Parse("2 January 2006", strings.TrimSpace(record[0])); err != nil {
+				return nil, fmt.Errorf("line %d: invalid date %q", line, record[0])
+			}
+		}
+
+		for i, value := range record[1:] {
+			currency := ""
+			if i+1 < len(currencies) {
+				currency = currencies[i+1]
+			}
+			value = strings.TrimSpace(value)
+			if value == "" || strings.EqualFold(value, "N/A") {
+				continue
+			}
+			if currency == "" {
+				return nil, fmt.Errorf("line %d: rate %q has no currency column", line, value)
+			}
+
+			rate, err := parseRate(currency, value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			rate.Date = date
+			rates = append(rates, rate)
+		}
+	}
+
+	return rates, nil
+}
+
+func parseRate(currency, value string) (Rate, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyCode.MatchString(currency) {
+		return Rate{}, fmt.Errorf("invalid currency %q", currency)
+	}
+	if currency == transactions.PivotCurrency {
+		return Rate{}, fmt.Errorf("rates are quoted against %s and cannot include it", transactions.PivotCurrency)
+	}
+
+	rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || rate <= 0 {
+		return Rate{}, fmt.Errorf("invalid %s rate %q", currency, value)
+	}
+
+	return Rate{Currency: currency, Rate: rate}, nil
+}
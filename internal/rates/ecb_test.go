@@ -0,0 +1,100 @@
+package rates
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const ecbXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2026-01-05'>
+			<Cube currency='USD' rate='1.0956'/>
+			<Cube currency='JPY' rate='159.2'/>
+		</Cube>
+		<Cube time='2026-01-02'>
+			<Cube currency='USD' rate='1.0941'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestParseXML(t *testing.T) {
+	rates, err := ParseXML(strings.NewReader(ecbXML))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	want := []Rate{
+		{Currency: "USD", Date: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Rate: 1.0956},
+		{Currency: "JPY", Date: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Rate: 159.2},
+		{Currency: "USD", Date: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), Rate: 1.0941},
+	}
+	if len(rates) != len(want) {
+		t.Fatalf("rates = %+v, want %d", rates, len(want))
+	}
+	for i, w := range want {
+		if rates[i] != w {
+			t.Fatalf("rate %d = %+v, want %+v", i, rates[i], w)
+		}
+	}
+}
+
+func TestParseXMLRejectsOtherDocuments(t *testing.T) {
+	if _, err := ParseXML(strings.NewReader(`<Document><Cube currency="USD" rate="1.1"/></Document>`)); err == nil {
+		t.Fatal("undated rate was accepted")
+	}
+	if _, err := ParseXML(strings.NewReader(`<Document/>`)); err == nil {
+		t.Fatal("document without rates was accepted")
+	}
+	if _, err := ParseXML(strings.NewReader(`<Cube><Cube time="2026-01-05"><Cube currency="USD" rate="-1"/></Cube></Cube>`)); err == nil {
+		t.Fatal("negative rate was accepted")
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	const hist = "Date,USD,JPY,CYP,\n" +
+		"2026-01-05,1.0956,159.2,N/A,\n" +
+		"2026-01-02,1.0941,,N/A,\n"
+
+	rates, err := ParseCSV(strings.NewReader(hist))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(rates) != 3 {
+		t.Fatalf("rates = %+v, want 3", rates)
+	}
+	if last := rates[2]; last.Currency != "USD" || last.Rate != 1.0941 || last.Date.Day() != 2 {
+		t.Fatalf("last rate = %+v, want USD 1.0941 on the 2nd", last)
+	}
+
+	const daily = "Date, USD, JPY, \n05 January 2026, 1.0956, 159.2, \n"
+	rates, err = ParseCSV(strings.NewReader(daily))
+	if err != nil {
+		t.Fatalf("parse daily: %v", err)
+	}
+	if len(rates) != 2 || rates[1].Currency != "JPY" || !rates[1].Date.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("daily rates = %+v", rates)
+	}
+}
+
+func TestParseCSVErrors(t *testing.T) {
+	tests := map[string]string{
+		"no date column": "Currency,Rate\nUSD,1.1\n",
+		"bad date":       "Date,USD\nyesterday,1.1\n",
+		"bad rate":       "Date,USD\n2026-01-05,abc\n",
+		"euro":           "Date,EUR\n2026-01-05,1\n",
+		"bad currency":   "Date,US\n2026-01-05,1.1\n",
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseCSV(strings.NewReader(input)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
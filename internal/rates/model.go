@@ -0,0 +1,30 @@
+package rates
+
+import "time"
+
+// Rate is the ECB-style reference rate of Currency on Date: how many units of
+// Currency one euro buys. It applies until the next published date, so
+// weekends and holidays use the last working day's rate.
+type Rate struct {
+	Currency string
+	Date     time.Time
+	Rate     float64
+}
+
+// Query filters listed rates. Every field is optional; the date bounds are
+// inclusive.
+type Query struct {
+	Currency *string
+	From     *time.Time
+	To       *time.Time
+}
+
+// ImportResult counts the rates read from a file and the currencies they
+// cover. Rates already stored for a currency and date are replaced.
+type ImportResult struct {
+	DryRun     bool
+	Imported   int
+	Currencies []string
+	From       *time.Time
+	To         *time.Time
+}
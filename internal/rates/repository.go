@@ -0,0 +1,113 @@
+package rates
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+type Repository struct {
+	db db.DBTX
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx}
+}
+
+// Import stores rates in one statement, replacing the rates already stored
+// for the same currency and date. When a file lists a currency and date more
+// than once, the last rate wins. A dry run only counts them.
+func (r *Repository) Import(ctx context.Context, rates []Rate, dryRun bool) (ImportResult, error) {
+	type key struct {
+		currency string
+		date     time.Time
+	}
+	latest := make(map[key]float64, len(rates))
+	for _, rate := range rates {
+		latest[key{rate.Currency, rate.Date}] = rate.Rate
+	}
+
+	result := ImportResult{DryRun: dryRun, Imported: len(latest), Currencies: make([]string, 0)}
+	currencies := make([]string, 0, len(latest))
+	dates := make([]string, 0, len(latest))
+	values := make([]string, 0, len(latest))
+	seen := make(map[string]bool)
+	for k, rate := range latest {
+		currencies = append(currencies, k.currency)
+		dates = append(dates, k.date.Format(time.DateOnly))
+		values = append(values, strconv.FormatFloat(rate, 'f', -1, 64))
+
+		if !seen[k.currency] {
+			seen[k.currency] = true
+			result.Currencies = append(result.Currencies, k.currency)
+		}
+		if result.From == nil || k.date.Before(*result.From) {
+			date := k.date
+			result.From = &date
+		}
+		if result.To == nil || k.date.After(*result.To) {
+			date := k.date
+			result.To = &date
+		}
+	}
+	sort.Strings(result.Currencies)
+
+	if dryRun || len(latest) == 0 {
+		return result, nil
+	}
+
+	const query = `
+		INSERT INTO exchange_rates (currency, rate_date, rate)
+		SELECT currency, rate_date::date, rate::numeric
+		FROM unnest($1::text[], $2::text[], $3::text[]) AS r(currency, rate_date, rate)
+		ON CONFLICT (currency, rate_date) DO UPDATE SET rate = EXCLUDED.rate
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, currencies, dates, values); err != nil {
+		return ImportResult{}, err
+	}
+
+	return result, nil
+}
+
+// List returns the stored rates matching q, by currency and then date.
+func (r *Repository) List(ctx context.Context, q Query) ([]Rate, error) {
+	const query = `
+		SELECT currency, rate_date, rate::float8
+		FROM exchange_rates
+		WHERE ($1::text IS NULL OR currency = $1)
+			AND ($2::date IS NULL OR rate_date >= $2)
+			AND ($3::date IS NULL OR rate_date <= $3)
+		ORDER BY currency ASC, rate_date ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, q.Currency, q.From, q.To)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Rate, 0)
+	for rows.Next() {
+		var rate Rate
+		if err := rows.Scan(&rate.Currency, &rate.Date, &rate.Rate); err != nil {
+			return nil, err
+		}
+		list = append(list, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
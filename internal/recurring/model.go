@@ -0,0 +1,81 @@
+package recurring
+
+import (
+	"errors"
+	"time"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+var (
+	ErrInvalidFrequency = errors.New("frequency must be daily, weekly, monthly or yearly")
+	ErrInvalidEvery     = errors.New("every must be at least 1")
+	ErrEndBeforeStart   = errors.New("end_date must not be before start_date")
+)
+
+// Rule generates a transaction every Every days, weeks, months or years from
+// StartDate until EndDate (inclusive, nil for no end). Monthly and yearly
+// rules keep the start date's day, clamped to the end of shorter months.
+// NextDate is the first occurrence that has not been booked yet. LastError
+// is set while the rule cannot be booked, e.g. because its category was
+// archived; runs keep retrying it.
+type Rule struct {
+	ID          int64
+	Frequency   Frequency
+	Every       int
+	StartDate   time.Time
+	EndDate     *time.Time
+	NextDate    time.Time
+	AmountCents int64
+	AccountID   *int64
+	CategoryID  *int64
+	Description *string
+	CreatedAt   time.Time
+	LastError   *string
+	LastErrorAt *time.Time
+}
+
+type CreateInput struct {
+	Frequency   Frequency
+	Every       int
+	StartDate   time.Time
+	EndDate     *time.Time
+	AmountCents int64
+	AccountID   *int64
+	CategoryID  *int64
+	Description *string
+}
+
+// UpdateInput replaces the rule. When ApplyFrom is set, transactions already
+// generated for occurrences on or after that date are updated as well.
+type UpdateInput struct {
+	Frequency   Frequency
+	Every       int
+	StartDate   time.Time
+	EndDate     *time.Time
+	AmountCents int64
+	AccountID   *int64
+	CategoryID  *int64
+	Description *string
+	ApplyFrom   *time.Time
+}
+
+// RunResult reports what a scheduler run booked, and which rules could not
+// be booked.
+type RunResult struct {
+	RulesRun            int
+	TransactionsCreated int
+	Failures            []RuleFailure
+}
+
+type RuleFailure struct {
+	RuleID int64
+	Err    error
+}
@@ -0,0 +1,281 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Repository stores recurring rules. Their amounts are in the base currency,
+// which is the currency their occurrences are booked in.
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+// ruleColumns reads rules with the base currency in placeholder baseParam.
+func ruleColumns(baseParam int) string {
+	return `id, frequency, every, start_date, end_date, next_date, (amount * ` + transactions.ScaleOfParam(baseParam) + `)::bigint, account_id, category_id, description, created_at, last_error, last_error_at`
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRule(row rowScanner) (Rule, error) {
+	var r Rule
+	var endDate, lastErrorAt sql.NullTime
+	var accountID, categoryID sql.NullInt64
+	err := row.Scan(
+		&r.ID,
+		&r.Frequency,
+		&r.Every,
+		&r.StartDate,
+		&endDate,
+		&r.NextDate,
+		&r.AmountCents,
+		&accountID,
+		&categoryID,
+		&r.Description,
+		&r.CreatedAt,
+		&r.LastError,
+		&lastErrorAt,
+	)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	if endDate.Valid {
+		r.EndDate = &endDate.Time
+	}
+	if accountID.Valid {
+		r.AccountID = &accountID.Int64
+	}
+	if categoryID.Valid {
+		r.CategoryID = &categoryID.Int64
+	}
+	if lastErrorAt.Valid {
+		r.LastErrorAt = &lastErrorAt.Time
+	}
+
+	return r, nil
+}
+
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Rule, error) {
+	query := `
+		INSERT INTO recurring_rules (frequency, every, start_date, end_date, next_date, amount, account_id, category_id, description)
+		VALUES ($1, $2, $3, $4, $3, $5::numeric / ` + transactions.ScaleOfParam(9) + `, $6, $7, $8)
+		RETURNING ` + ruleColumns(9)
+
+	return scanRule(r.db.QueryRowContext(
+		ctx,
+		query,
+		in.Frequency,
+		in.Every,
+		in.StartDate,
+		in.EndDate,
+		in.AmountCents,
+		in.AccountID,
+		in.CategoryID,
+		in.Description,
+		r.baseCurrency,
+	))
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(2) + `
+		FROM recurring_rules
+		WHERE id = $1
+	`
+
+	return scanRule(r.db.QueryRowContext(ctx, query, id, r.baseCurrency))
+}
+
+// GetForUpdate is Get with a row lock, for use inside a transaction.
+func (r *Repository) GetForUpdate(ctx context.Context, id int64) (Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(2) + `
+		FROM recurring_rules
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	return scanRule(r.db.QueryRowContext(ctx, query, id, r.baseCurrency))
+}
+
+// LockDue locks the rule if it has an occurrence due on or before through.
+// It returns sql.ErrNoRows when the rule is not due or is being run by
+// another instance, so concurrent schedulers never book the same rule.
+func (r *Repository) LockDue(ctx context.Context, id int64, through time.Time) (Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(3) + `
+		FROM recurring_rules
+		WHERE id = $1
+			AND next_date <= $2
+			AND (end_date IS NULL OR next_date <= end_date)
+		FOR UPDATE SKIP LOCKED
+	`
+
+	return scanRule(r.db.QueryRowContext(ctx, query, id, through, r.baseCurrency))
+}
+
+func (r *Repository) List(ctx context.Context) ([]Rule, error) {
+	query := `
+		SELECT ` + ruleColumns(1) + `
+		FROM recurring_rules
+		ORDER BY next_date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]Rule, 0)
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// ListDueIDs returns the rules with an occurrence due on or before through.
+func (r *Repository) ListDueIDs(ctx context.Context, through time.Time) ([]int64, error) {
+	const query = `
+		SELECT id
+		FROM recurring_rules
+		WHERE next_date <= $1
+			AND (end_date IS NULL OR next_date <= end_date)
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, through)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// LastOccurrence returns the latest occurrence booked for the rule, or nil
+// when none has been.
+func (r *Repository) LastOccurrence(ctx context.Context, id int64) (*time.Time, error) {
+	const query = `SELECT MAX(occurrence_date) FROM transactions WHERE recurring_rule_id = $1`
+
+	var last sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&last); err != nil {
+		return nil, err
+	}
+	if !last.Valid {
+		return nil, nil
+	}
+
+	return &last.Time, nil
+}
+
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput, nextDate time.Time) (Rule, error) {
+	query := `
+		UPDATE recurring_rules
+		SET frequency = $1,
+			every = $2,
+			start_date = $3,
+			end_date = $4,
+			next_date = $5,
+			amount = $6::numeric / ` + transactions.ScaleOfParam(11) + `,
+			account_id = $7,
+			category_id = $8,
+			description = $9,
+			last_error = NULL,
+			last_error_at = NULL
+		WHERE id = $10
+		RETURNING ` + ruleColumns(11)
+
+	return scanRule(r.db.QueryRowContext(
+		ctx,
+		query,
+		in.Frequency,
+		in.Every,
+		in.StartDate,
+		in.EndDate,
+		nextDate,
+		in.AmountCents,
+		in.AccountID,
+		in.CategoryID,
+		in.Description,
+		id,
+		r.baseCurrency,
+	))
+}
+
+// SetNextDate moves the rule to its next occurrence after a successful run,
+// which also clears the last error.
+func (r *Repository) SetNextDate(ctx context.Context, id int64, next time.Time) error {
+	const query = `UPDATE recurring_rules SET next_date = $1, last_error = NULL, last_error_at = NULL WHERE id = $2`
+
+	_, err := r.db.ExecContext(ctx, query, next, id)
+	return err
+}
+
+// SetLastError records why a run could not book the rule.
+func (r *Repository) SetLastError(ctx context.Context, id int64, message string) error {
+	const query = `UPDATE recurring_rules SET last_error = $1, last_error_at = now() WHERE id = $2`
+
+	_, err := r.db.ExecContext(ctx, query, message, id)
+	return err
+}
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM recurring_rules WHERE id = $1`
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
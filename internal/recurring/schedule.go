@@ -0,0 +1,100 @@
+package recurring
+
+import "time"
+
+func validate(frequency Frequency, every int, start time.Time, end *time.Time) error {
+	switch frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	default:
+		return ErrInvalidFrequency
+	}
+	if every < 1 {
+		return ErrInvalidEvery
+	}
+	if end != nil && end.Before(start) {
+		return ErrEndBeforeStart
+	}
+	return nil
+}
+
+// occurrence returns the n-th occurrence (0-based) of the schedule. It is
+// always computed from the start date, so month-end clamping never drifts.
+func occurrence(frequency Frequency, every int, start time.Time, n int) time.Time {
+	switch frequency {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, n*every)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n*every)
+	case FrequencyMonthly:
+		return addMonthsClamped(start, n*every)
+	case FrequencyYearly:
+		return addMonthsClamped(start, 12*n*every)
+	}
+	return start
+}
+
+func addMonthsClamped(start time.Time, months int) time.Time {
+	first := time.Date(start.Year(), start.Month()+time.Month(months), 1, 0, 0, 0, 0, start.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := start.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, start.Location())
+}
+
+// indexOnOrAfter returns the index of the first occurrence on or after d. It
+// estimates the index from the calendar distance, which never overshoots, and
+// then steps forward over at most one or two occurrences.
+func indexOnOrAfter(frequency Frequency, every int, start, d time.Time) int {
+	if !d.After(start) {
+		return 0
+	}
+
+	months := (d.Year()-start.Year())*12 + int(d.Month()-start.Month())
+	days := int(d.Sub(start).Hours() / 24)
+	var n int
+	switch frequency {
+	case FrequencyDaily:
+		n = days / every
+	case FrequencyWeekly:
+		n = days / (7 * every)
+	case FrequencyMonthly:
+		n = months / every
+	case FrequencyYearly:
+		n = months / (12 * every)
+	}
+
+	for occurrence(frequency, every, start, n).Before(d) {
+		n++
+	}
+	return n
+}
+
+// firstOnOrAfter returns the first occurrence on or after d.
+func firstOnOrAfter(frequency Frequency, every int, start, d time.Time) time.Time {
+	return occurrence(frequency, every, start, indexOnOrAfter(frequency, every, start, d))
+}
+
+// dueDates returns the occurrences from next through through, stopping at end.
+func dueDates(frequency Frequency, every int, start, next, through time.Time, end *time.Time) []time.Time {
+	dates := make([]time.Time, 0)
+	n := indexOnOrAfter(frequency, every, start, next.AddDate(0, 0, 1))
+	for d := next; !d.After(through); d, n = occurrence(frequency, every, start, n), n+1 {
+		if end != nil && d.After(*end) {
+			break
+		}
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+// Occurrences returns the occurrences of a schedule after after and through
+// through, stopping at end. Projections use it to look ahead without booking.
+func Occurrences(frequency Frequency, every int, start, after, through time.Time, end *time.Time) []time.Time {
+	next := start
+	if !after.Before(start) {
+		next = firstOnOrAfter(frequency, every, start, after.AddDate(0, 0, 1))
+	}
+	return dueDates(frequency, every, start, next, through, end)
+}
@@ -0,0 +1,81 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestOccurrenceClampsMonthEnd(t *testing.T) {
+	start := date(2026, time.January, 31)
+	want := []time.Time{
+		date(2026, time.January, 31),
+		date(2026, time.February, 28),
+		date(2026, time.March, 31),
+		date(2026, time.April, 30),
+	}
+	for n, w := range want {
+		if got := occurrence(FrequencyMonthly, 1, start, n); !got.Equal(w) {
+			t.Fatalf("occurrence %d = %s, want %s", n, got.Format(time.DateOnly), w.Format(time.DateOnly))
+		}
+	}
+
+	leap := date(2028, time.February, 29)
+	if got := occurrence(FrequencyYearly, 1, leap, 1); !got.Equal(date(2029, time.February, 28)) {
+		t.Fatalf("yearly leap occurrence = %s", got.Format(time.DateOnly))
+	}
+}
+
+func TestDueDates(t *testing.T) {
+	start := date(2026, time.January, 2)
+
+	got := dueDates(FrequencyWeekly, 2, start, start, date(2026, time.February, 13), nil)
+	want := []time.Time{date(2026, time.January, 2), date(2026, time.January, 16), date(2026, time.January, 30), date(2026, time.February, 13)}
+	if len(got) != len(want) {
+		t.Fatalf("due dates = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Fatalf("due date %d = %s, want %s", i, got[i].Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+
+	end := date(2026, time.March, 1)
+	got = dueDates(FrequencyMonthly, 1, start, date(2026, time.February, 2), date(2026, time.December, 31), &end)
+	if len(got) != 1 || !got[0].Equal(date(2026, time.February, 2)) {
+		t.Fatalf("due dates with end = %v", got)
+	}
+}
+
+func TestFirstOnOrAfter(t *testing.T) {
+	start := date(2026, time.January, 1)
+	if got := firstOnOrAfter(FrequencyMonthly, 1, start, date(2026, time.March, 2)); !got.Equal(date(2026, time.April, 1)) {
+		t.Fatalf("first on or after = %s", got.Format(time.DateOnly))
+	}
+	if got := firstOnOrAfter(FrequencyDaily, 3, start, start); !got.Equal(start) {
+		t.Fatalf("first on or after start = %s", got.Format(time.DateOnly))
+	}
+}
+
+func TestIndexOnOrAfterMatchesScan(t *testing.T) {
+	starts := []time.Time{date(2024, time.January, 31), date(2025, time.March, 15), date(2028, time.February, 29)}
+	for _, frequency := range []Frequency{FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly} {
+		for _, every := range []int{1, 2, 3} {
+			for _, start := range starts {
+				for d := start.AddDate(0, 0, -3); d.Before(start.AddDate(6, 0, 0)); d = d.AddDate(0, 0, 1) {
+					want := 0
+					for occurrence(frequency, every, start, want).Before(d) {
+						want++
+					}
+					if got := indexOnOrAfter(frequency, every, start, d); got != want {
+						t.Fatalf("%s every %d from %s: index on or after %s = %d, want %d",
+							frequency, every, start.Format(time.DateOnly), d.Format(time.DateOnly), got, want)
+					}
+				}
+			}
+		}
+	}
+}
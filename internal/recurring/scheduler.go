@@ -0,0 +1,46 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/periodic"
+)
+
+// Scheduler runs Service.Run once at startup, which catches up on anything
+// missed while the service was down, and then every cfg.RecurringInterval.
+type Scheduler struct {
+	service *Service
+	logger  *zap.Logger
+}
+
+func NewScheduler(cfg config.Config, service *Service, logger *zap.Logger, lc fx.Lifecycle) *Scheduler {
+	s := &Scheduler{service: service, logger: logger}
+	periodic.NewRunner("recurring scheduler", cfg.RecurringInterval, s.runOnce, logger, lc)
+	return s
+}
+
+func (s *Scheduler) runOnce(ctx context.Context) error {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	result, err := s.service.Run(ctx, today)
+	if err != nil {
+		return err
+	}
+	for _, f := range result.Failures {
+		s.logger.Error("recurring scheduler: rule failed", zap.Int64("rule_id", f.RuleID), zap.Error(f.Err))
+	}
+	if result.TransactionsCreated > 0 {
+		s.logger.Info(
+			"recurring scheduler: booked occurrences",
+			zap.Int("rules", result.RulesRun),
+			zap.Int("transactions", result.TransactionsCreated),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service manages recurring rules and books their due occurrences through
+// transactions.Repository.Create.
+type Service struct {
+	db           *sql.DB
+	repo         *Repository
+	transactions *transactions.Repository
+}
+
+func NewService(db *sql.DB, repo *Repository, transactions *transactions.Repository) *Service {
+	return &Service{db: db, repo: repo, transactions: transactions}
+}
+
+// Create adds a rule. It returns transactions.ErrArchivedCategory or
+// transactions.ErrDeletedCategory when the rule could never be booked.
+func (s *Service) Create(ctx context.Context, in CreateInput) (Rule, error) {
+	if err := validate(in.Frequency, in.Every, in.StartDate, in.EndDate); err != nil {
+		return Rule{}, err
+	}
+	if err := s.transactions.CheckCategory(ctx, in.CategoryID); err != nil {
+		return Rule{}, err
+	}
+
+	return s.repo.Create(ctx, in)
+}
+
+func (s *Service) Get(ctx context.Context, id int64) (Rule, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context) ([]Rule, error) {
+	return s.repo.List(ctx)
+}
+
+// Delete removes the rule. Its transactions lose their reference to it in the
+// same database transaction.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		return s.repo.WithTx(tx).Delete(ctx, id)
+	})
+}
+
+// Update replaces the rule and returns it with the number of generated
+// transactions that were updated (only when in.ApplyFrom is set). The next
+// occurrence is recomputed from the new schedule, after the last one already
+// booked, and the rule's last error is cleared. Like Create, it rejects an
+// archived or trashed category, but only when the category changes.
+func (s *Service) Update(ctx context.Context, id int64, in UpdateInput) (Rule, int64, error) {
+	if err := validate(in.Frequency, in.Every, in.StartDate, in.EndDate); err != nil {
+		return Rule{}, 0, err
+	}
+
+	var updated Rule
+	var changed int64
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		repo := s.repo.WithTx(tx)
+		current, err := repo.GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !sameID(current.CategoryID, in.CategoryID) {
+			if err := s.transactions.WithTx(tx).CheckCategory(ctx, in.CategoryID); err != nil {
+				return err
+			}
+		}
+
+		last, err := repo.LastOccurrence(ctx, id)
+		if err != nil {
+			return err
+		}
+		from := in.StartDate
+		if last != nil && !last.Before(from) {
+			from = last.AddDate(0, 0, 1)
+		}
+		next := firstOnOrAfter(in.Frequency, in.Every, in.StartDate, from)
+
+		updated, err = repo.Update(ctx, id, in, next)
+		if err != nil {
+			return err
+		}
+
+		if in.ApplyFrom != nil {
+			changed, err = s.transactions.WithTx(tx).UpdateOccurrences(ctx, id, *in.ApplyFrom, transactions.OccurrenceUpdate{
+				AccountID:   in.AccountID,
+				CategoryID:  in.CategoryID,
+				AmountCents: in.AmountCents,
+				Description: in.Description,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Rule{}, 0, err
+	}
+
+	return updated, changed, nil
+}
+
+// Run books every occurrence due on or before through, including the ones
+// missed while the service was down. Each rule is booked in its own database
+// transaction together with its new next date, and the (rule, occurrence)
+// unique index backs that up, so an occurrence is booked exactly once even
+// with several instances running. A failing rule is rolled back and reported
+// in the result without stopping the others, and its error is kept on the
+// rule until a later run books it.
+func (s *Service) Run(ctx context.Context, through time.Time) (RunResult, error) {
+	ids, err := s.repo.ListDueIDs(ctx, through)
+	if err != nil {
+		return RunResult{}, err
+	}
+
+	result := RunResult{Failures: make([]RuleFailure, 0)}
+	for _, id := range ids {
+		created, err := s.runRule(ctx, id, through)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			result.Failures = append(result.Failures, RuleFailure{RuleID: id, Err: err})
+			if err := s.repo.SetLastError(ctx, id, err.Error()); err != nil {
+				return result, err
+			}
+			continue
+		}
+		if created > 0 {
+			result.RulesRun++
+			result.TransactionsCreated += created
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) runRule(ctx context.Context, id int64, through time.Time) (int, error) {
+	created := 0
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		repo := s.repo.WithTx(tx)
+		rule, err := repo.LockDue(ctx, id, through)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		txRepo := s.transactions.WithTx(tx)
+		dates := dueDates(rule.Frequency, rule.Every, rule.StartDate, rule.NextDate, through, rule.EndDate)
+		for _, d := range dates {
+			_, err := txRepo.Create(ctx, transactions.CreateInput{
+				TransactionDate: d,
+				AccountID:       rule.AccountID,
+				CategoryID:      rule.CategoryID,
+				AmountCents:     rule.AmountCents,
+				Description:     rule.Description,
+				RecurringRuleID: &rule.ID,
+				OccurrenceDate:  &d,
+			})
+			if err != nil {
+				return err
+			}
+			created++
+		}
+		if len(dates) == 0 {
+			return nil
+		}
+
+		next := firstOnOrAfter(rule.Frequency, rule.Every, rule.StartDate, dates[len(dates)-1].AddDate(0, 0, 1))
+		return repo.SetNextDate(ctx, rule.ID, next)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
+
+func sameID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,193 @@
+package suggestions
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// classifier is a multinomial naive Bayes model over description tokens and
+// an amount bucket. It remembers what every transaction contributed so a
+// transaction can be relearned or forgotten without retraining.
+type classifier struct {
+	docs     map[int64]int
+	counts   map[int64]map[string]int
+	totals   map[int64]int
+	vocab    map[string]int
+	examples map[int64]example
+}
+
+type example struct {
+	categoryID int64
+	tokens     []string
+}
+
+type score struct {
+	categoryID  int64
+	probability float64
+}
+
+func newClassifier() *classifier {
+	return &classifier{
+		docs:     make(map[int64]int),
+		counts:   make(map[int64]map[string]int),
+		totals:   make(map[int64]int),
+		vocab:    make(map[string]int),
+		examples: make(map[int64]example),
+	}
+}
+
+// learn records transaction id as an example of categoryID, replacing what it
+// contributed before.
+func (c *classifier) learn(id, categoryID int64, description string, amountCents int64) {
+	c.forget(id)
+
+	tokens := features(description, amountCents)
+	if len(tokens) == 0 {
+		return
+	}
+
+	c.docs[categoryID]++
+	if c.counts[categoryID] == nil {
+		c.counts[categoryID] = make(map[string]int)
+	}
+	for _, tok := range tokens {
+		c.counts[categoryID][tok]++
+		c.totals[categoryID]++
+		c.vocab[tok]++
+	}
+	c.examples[id] = example{categoryID: categoryID, tokens: tokens}
+}
+
+func (c *classifier) forget(id int64) {
+	ex, ok := c.examples[id]
+	if !ok {
+		return
+	}
+	delete(c.examples, id)
+
+	c.docs[ex.categoryID]--
+	for _, tok := range ex.tokens {
+		c.counts[ex.categoryID][tok]--
+		if c.counts[ex.categoryID][tok] == 0 {
+			delete(c.counts[ex.categoryID], tok)
+		}
+		c.totals[ex.categoryID]--
+		c.vocab[tok]--
+		if c.vocab[tok] == 0 {
+			delete(c.vocab, tok)
+		}
+	}
+	if c.docs[ex.categoryID] == 0 {
+		delete(c.docs, ex.categoryID)
+		delete(c.counts, ex.categoryID)
+		delete(c.totals, ex.categoryID)
+	}
+}
+
+// predict ranks the categories accepted by keep, most probable first. It
+// returns nothing when no description token has been seen in training, since
+// the amount alone says little about a merchant.
+func (c *classifier) predict(description string, amountCents int64, keep func(int64) bool) []score {
+	known := make([]string, 0)
+	seenWord := false
+	for _, tok := range features(description, amountCents) {
+		if c.vocab[tok] == 0 {
+			continue
+		}
+		known = append(known, tok)
+		if !strings.HasPrefix(tok, amountPrefix) {
+			seenWord = true
+		}
+	}
+	if !seenWord {
+		return nil
+	}
+
+	var docs int
+	for categoryID, n := range c.docs {
+		if keep(categoryID) {
+			docs += n
+		}
+	}
+	vocab := float64(len(c.vocab))
+
+	scores := make([]score, 0, len(c.docs))
+	for categoryID, n := range c.docs {
+		if !keep(categoryID) {
+			continue
+		}
+		logP := math.Log(float64(n) / float64(docs))
+		denominator := float64(c.totals[categoryID]) + vocab
+		for _, tok := range known {
+			logP += math.Log((float64(c.counts[categoryID][tok]) + 1) / denominator)
+		}
+		scores = append(scores, score{categoryID: categoryID, probability: logP})
+	}
+	if len(scores) == 0 {
+		return nil
+	}
+
+	// Turn log-likelihoods into probabilities that sum to one.
+	maxLog := math.Inf(-1)
+	for _, s := range scores {
+		maxLog = math.Max(maxLog, s.probability)
+	}
+	var sum float64
+	for i := range scores {
+		scores[i].probability = math.Exp(scores[i].probability - maxLog)
+		sum += scores[i].probability
+	}
+	for i := range scores {
+		scores[i].probability /= sum
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].probability != scores[j].probability {
+			return scores[i].probability > scores[j].probability
+		}
+		return scores[i].categoryID < scores[j].categoryID
+	})
+	return scores
+}
+
+const amountPrefix = "amount:"
+
+// features splits the description into lower-case words, dropping numbers
+// and single characters (card numbers, dates and references change on every
+// row), and adds one token for the sign and order of magnitude of the amount.
+func features(description string, amountCents int64) []string {
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words)+1)
+	for _, w := range words {
+		if len([]rune(w)) < 2 || isNumber(w) {
+			continue
+		}
+		tokens = append(tokens, w)
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	sign := "+"
+	if amountCents < 0 {
+		sign = "-"
+		amountCents = -amountCents
+	}
+	magnitude := len(strconv.FormatInt(amountCents/100, 10))
+	return append(tokens, amountPrefix+sign+strconv.Itoa(magnitude))
+}
+
+func isNumber(w string) bool {
+	for _, r := range w {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package suggestions
+
+import "testing"
+
+func keepAll(int64) bool { return true }
+
+func TestPredictRanksByHistory(t *testing.T) {
+	c := newClassifier()
+	c.learn(1, 10, "CARD 1234 CARREFOUR MARKET", -5230)
+	c.learn(2, 10, "Carrefour City 17/03", -1899)
+	c.learn(3, 10, "LIDL 0042", -3410)
+	c.learn(4, 20, "SNCF voyages", -8900)
+	c.learn(5, 20, "SNCF TER 2211", -1250)
+	c.learn(6, 30, "Salary ACME", 250000)
+
+	scores := c.predict("carrefour express 9981", -2450, keepAll)
+	if len(scores) != 3 || scores[0].categoryID != 10 {
+		t.Fatalf("scores = %+v, want category 10 first", scores)
+	}
+	var sum float64
+	for _, s := range scores {
+		sum += s.probability
+	}
+	if sum < 0.999 || sum > 1.001 {
+		t.Fatalf("probabilities sum to %f, want 1", sum)
+	}
+
+	if scores := c.predict("sncf", -4000, func(id int64) bool { return id != 20 }); len(scores) == 0 || scores[0].categoryID == 20 {
+		t.Fatalf("scores = %+v, want category 20 left out", scores)
+	}
+
+	if scores := c.predict("unknown merchant", -4000, keepAll); scores != nil {
+		t.Fatalf("scores = %+v, want none for unseen words", scores)
+	}
+}
+
+func TestLearnReplacesAndForgetRemoves(t *testing.T) {
+	c := newClassifier()
+	c.learn(1, 10, "Boulangerie Paul", -450)
+	c.learn(1, 20, "Boulangerie Paul", -450)
+
+	if _, ok := c.docs[10]; ok {
+		t.Fatal("relearning kept the old category")
+	}
+	if scores := c.predict("paul", -300, keepAll); len(scores) != 1 || scores[0].categoryID != 20 {
+		t.Fatalf("scores = %+v, want category 20", scores)
+	}
+
+	c.forget(1)
+	if len(c.vocab) != 0 || len(c.docs) != 0 || len(c.examples) != 0 {
+		t.Fatalf("forget left state behind: %+v", c)
+	}
+}
@@ -0,0 +1,8 @@
+package suggestions
+
+// Suggestion is a guessed category with the model's probability for it. The
+// probabilities of all suggestions for one query add up to at most one.
+type Suggestion struct {
+	CategoryID  int64
+	Probability float64
+}
@@ -0,0 +1,122 @@
+package suggestions
+
+import (
+	"context"
+	"sync"
+
+	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service suggests categories from a naive Bayes model of the categorized
+// transactions. The model is trained from the database on first use. Single
+// transactions are then learned incrementally through Learn and Forget; bulk
+// changes (imports, rule runs, merges) call Invalidate and the next
+// suggestion retrains from scratch.
+type Service struct {
+	transactions *transactions.Repository
+	categories   *categories.Repository
+
+	mu    sync.Mutex
+	model *classifier
+	stale bool
+}
+
+func NewService(transactions *transactions.Repository, categories *categories.Repository) *Service {
+	return &Service{transactions: transactions, categories: categories}
+}
+
+// Suggest returns up to limit categories for a transaction, most probable
+// first. Archived and deleted categories are never suggested.
+func (s *Service) Suggest(ctx context.Context, description string, amountCents int64, limit int) ([]Suggestion, error) {
+	active, err := s.categories.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[int64]bool, len(active))
+	for _, c := range active {
+		keep[c.ID] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.model == nil || s.stale {
+		if err := s.train(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	scores := s.model.predict(description, amountCents, func(id int64) bool { return keep[id] })
+	if len(scores) > limit {
+		scores = scores[:limit]
+	}
+
+	suggestions := make([]Suggestion, 0, len(scores))
+	for _, sc := range scores {
+		suggestions = append(suggestions, Suggestion{CategoryID: sc.categoryID, Probability: sc.probability})
+	}
+	return suggestions, nil
+}
+
+// Learn updates the model after t was created or changed. Transactions that
+// no longer qualify as examples are forgotten.
+func (s *Service) Learn(t transactions.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.model == nil {
+		return
+	}
+	if !trainable(t) {
+		s.model.forget(t.ID)
+		return
+	}
+	s.model.learn(t.ID, *t.CategoryID, *t.Description, t.AmountCents)
+}
+
+// Forget removes a deleted transaction from the model.
+func (s *Service) Forget(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.model != nil {
+		s.model.forget(id)
+	}
+}
+
+// Invalidate makes the next suggestion retrain the model from the database.
+func (s *Service) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stale = true
+}
+
+// train rebuilds the model. The caller holds s.mu, so no Learn or Forget can
+// be lost between reading the rows and swapping the model in.
+func (s *Service) train(ctx context.Context) error {
+	rows, err := s.transactions.ListCategorized(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := newClassifier()
+	for _, t := range rows {
+		if trainable(t) {
+			model.learn(t.ID, *t.CategoryID, *t.Description, t.AmountCents)
+		}
+	}
+
+	s.model = model
+	s.stale = false
+	return nil
+}
+
+// trainable reports whether t is a hand-categorized example: it has a
+// description and a single category, and is neither a transfer leg nor booked
+// by a recurring rule.
+func trainable(t transactions.Transaction) bool {
+	return t.CategoryID != nil && t.Description != nil && len(t.Splits) == 0 &&
+		t.TransferID == nil && t.RecurringRuleID == nil
+}
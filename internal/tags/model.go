@@ -0,0 +1,34 @@
+package tags
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrBlankName = errors.New("name must not be blank")
+
+// Tag is a free-form label. Unlike categories, a transaction can carry any
+// number of tags. Names are unique ignoring case.
+type Tag struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
+
+type CreateInput struct {
+	Name string
+}
+
+type UpdateInput struct {
+	Name string
+}
+
+// Total sums the tagged transactions of a date range. A transaction counts
+// towards each of its tags; transfer legs are left out.
+type Total struct {
+	TagID            int64
+	Name             string
+	SpendingCents    int64
+	IncomeCents      int64
+	TransactionCount int64
+}
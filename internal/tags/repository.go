@@ -0,0 +1,195 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+type Repository struct {
+	db           db.DBTX
+	baseCurrency string
+}
+
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
+}
+
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
+
+const tagColumns = `id, name, created_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTag(row rowScanner) (Tag, error) {
+	var t Tag
+	if err := row.Scan(&t.ID, &t.Name, &t.CreatedAt); err != nil {
+		return Tag{}, err
+	}
+	return t, nil
+}
+
+// Create stores a tag under its trimmed name. A name already used, ignoring
+// case, is a unique violation.
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Tag, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return Tag{}, ErrBlankName
+	}
+
+	const query = `
+		INSERT INTO tags (name)
+		VALUES ($1)
+		RETURNING ` + tagColumns
+
+	return scanTag(r.db.QueryRowContext(ctx, query, name))
+}
+
+func (r *Repository) Get(ctx context.Context, id int64) (Tag, error) {
+	const query = `
+		SELECT ` + tagColumns + `
+		FROM tags
+		WHERE id = $1
+	`
+
+	return scanTag(r.db.QueryRowContext(ctx, query, id))
+}
+
+// List returns every tag sorted by name, ignoring case.
+func (r *Repository) List(ctx context.Context) ([]Tag, error) {
+	const query = `
+		SELECT ` + tagColumns + `
+		FROM tags
+		ORDER BY lower(name) ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Tag, 0)
+	for rows.Next() {
+		t, err := scanTag(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Update renames a tag. Tagged transactions follow the new name.
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Tag, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return Tag{}, ErrBlankName
+	}
+
+	const query = `
+		UPDATE tags
+		SET name = $1
+		WHERE id = $2
+		RETURNING ` + tagColumns
+
+	return scanTag(r.db.QueryRowContext(ctx, query, name, id))
+}
+
+// Delete removes a tag from every transaction and deletes it.
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	const query = `DELETE FROM tags WHERE id = $1`
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// taggedFilter selects the transactions Totals sums, dated between $1 and $2.
+const taggedFilter = `
+	t.transfer_id IS NULL
+	AND t.deleted_at IS NULL
+	AND ($1::date IS NULL OR t.transaction_date >= $1)
+	AND ($2::date IS NULL OR t.transaction_date <= $2)
+`
+
+// Totals sums spending and income per tag for transactions dated between from
+// and to, both inclusive and optional, in minor units of the base currency.
+// Every tag is listed, with zeros when nothing in the range carries it. It
+// returns transactions.ErrMissingRate when a transaction cannot be converted.
+func (r *Repository) Totals(ctx context.Context, from, to *time.Time) ([]Total, error) {
+	if err := transactions.CheckRates(ctx, r.db, r.baseCurrency, taggedFilter+` AND EXISTS (
+		SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = t.id
+	)`, 3, from, to, r.baseCurrency); err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH converted AS (
+			SELECT
+				tt.tag_id,
+				t.id,
+				t.amount * exchange_factor(t.currency, $3::text, t.transaction_date) AS amount
+			FROM transaction_tags tt
+			JOIN transactions t ON t.id = tt.transaction_id
+			WHERE ` + taggedFilter + `
+		)
+		SELECT
+			g.id,
+			g.name,
+			(COALESCE(SUM(CASE WHEN c.amount < 0 THEN -c.amount ELSE 0 END), 0) * ` + transactions.ScaleOfParam(3) + `)::bigint,
+			(COALESCE(SUM(CASE WHEN c.amount > 0 THEN c.amount ELSE 0 END), 0) * ` + transactions.ScaleOfParam(3) + `)::bigint,
+			COUNT(c.id)
+		FROM tags g
+		LEFT JOIN converted c ON c.tag_id = g.id
+		GROUP BY g.id, g.name
+		ORDER BY lower(g.name) ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, from, to, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make([]Total, 0)
+	for rows.Next() {
+		var t Total
+		if err := rows.Scan(&t.TagID, &t.Name, &t.SpendingCents, &t.IncomeCents, &t.TransactionCount); err != nil {
+			return nil, err
+		}
+		totals = append(totals, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
@@ -1,6 +1,37 @@
 package transactions
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+)
+
+// analyticsYear selects the transactions t of the year ($1) and account ($2)
+// that count as income or spending. Trashed transactions never do.
+const analyticsYear = `
+	t.transaction_date >= make_date($1, 1, 1)
+	AND t.transaction_date < make_date($1 + 1, 1, 1)
+	AND ($2::bigint IS NULL OR t.account_id = $2)
+	AND t.transfer_id IS NULL
+	AND t.deleted_at IS NULL
+`
+
+// analyticsLines is the common head of the per-category queries. It expands
+// split transactions into their lines, keeps unsplit transactions whole,
+// applies analyticsYear and converts amounts into the base currency ($3).
+var analyticsLines = `
+	WITH lines AS (
+		SELECT
+			t.transaction_date,
+			COALESCE(s.category_id, t.category_id) AS category_id,
+			COALESCE(s.amount, t.amount) * (` + rateFactor(3) + `) AS amount
+		FROM transactions t
+		LEFT JOIN transaction_splits s ON s.transaction_id = t.id
+		WHERE ` + analyticsYear + `
+	)
+`
 
 type MonthlyCategoryTotal struct {
 	CategoryID  int64
@@ -8,22 +39,30 @@ type MonthlyCategoryTotal struct {
 	AmountCents int64
 }
 
-func (r *Repository) ListMonthlySpendingByCategory(ctx context.Context, year int) ([]MonthlyCategoryTotal, error) {
-	const query = `
+// ListMonthlySpendingByCategory sums spending per category and month, by split
+// line for split transactions. A nil accountID aggregates every account; the
+// other monthly queries take it the same way. Transfer legs are excluded,
+// since moving money between accounts is neither income nor spending.
+// Amounts are converted into the base currency at the rate of each
+// transaction's date; ErrMissingRate is returned when a rate is missing.
+func (r *Repository) ListMonthlySpendingByCategory(ctx context.Context, year int, accountID *int64) ([]MonthlyCategoryTotal, error) {
+	if err := CheckRates(ctx, r.db, r.baseCurrency, analyticsYear, 3, year, accountID, r.baseCurrency); err != nil {
+		return nil, err
+	}
+
+	query := analyticsLines + `
 		SELECT
 			category_id,
 			EXTRACT(MONTH FROM transaction_date)::int AS month,
-			(SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END) * 100)::bigint AS amount_cents
-		FROM transactions
+			(SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END) * ` + ScaleOf("$3::text") + `)::bigint AS amount_cents
+		FROM lines
 		WHERE category_id IS NOT NULL
-			AND transaction_date >= make_date($1, 1, 1)
-			AND transaction_date < make_date($1 + 1, 1, 1)
 		GROUP BY category_id, month
 		HAVING SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END) <> 0
 		ORDER BY category_id, month
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, year)
+	rows, err := r.db.QueryContext(ctx, query, year, accountID, r.baseCurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -44,22 +83,24 @@ func (r *Repository) ListMonthlySpendingByCategory(ctx context.Context, year int
 	return results, nil
 }
 
-func (r *Repository) ListMonthlyIncomeByCategory(ctx context.Context, year int) ([]MonthlyCategoryTotal, error) {
-	const query = `
+func (r *Repository) ListMonthlyIncomeByCategory(ctx context.Context, year int, accountID *int64) ([]MonthlyCategoryTotal, error) {
+	if err := CheckRates(ctx, r.db, r.baseCurrency, analyticsYear, 3, year, accountID, r.baseCurrency); err != nil {
+		return nil, err
+	}
+
+	query := analyticsLines + `
 		SELECT
 			category_id,
 			EXTRACT(MONTH FROM transaction_date)::int AS month,
-			(SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END) * 100)::bigint AS amount_cents
-		FROM transactions
+			(SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END) * ` + ScaleOf("$3::text") + `)::bigint AS amount_cents
+		FROM lines
 		WHERE category_id IS NOT NULL
-			AND transaction_date >= make_date($1, 1, 1)
-			AND transaction_date < make_date($1 + 1, 1, 1)
 		GROUP BY category_id, month
 		HAVING SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END) <> 0
 		ORDER BY category_id, month
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, year)
+	rows, err := r.db.QueryContext(ctx, query, year, accountID, r.baseCurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -85,19 +126,22 @@ type MonthlyNetTotal struct {
 	AmountCents int64
 }
 
-func (r *Repository) ListMonthlyNetTotals(ctx context.Context, year int) ([]MonthlyNetTotal, error) {
-	const query = `
+func (r *Repository) ListMonthlyNetTotals(ctx context.Context, year int, accountID *int64) ([]MonthlyNetTotal, error) {
+	if err := CheckRates(ctx, r.db, r.baseCurrency, analyticsYear, 3, year, accountID, r.baseCurrency); err != nil {
+		return nil, err
+	}
+
+	query := `
 		SELECT
-			EXTRACT(MONTH FROM transaction_date)::int AS month,
-			(SUM(amount) * 100)::bigint AS amount_cents
-		FROM transactions
-		WHERE transaction_date >= make_date($1, 1, 1)
-			AND transaction_date < make_date($1 + 1, 1, 1)
+			EXTRACT(MONTH FROM t.transaction_date)::int AS month,
+			(SUM(t.amount * (` + rateFactor(3) + `)) * ` + ScaleOf("$3::text") + `)::bigint AS amount_cents
+		FROM transactions t
+		WHERE ` + analyticsYear + `
 		GROUP BY month
 		ORDER BY month
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, year)
+	rows, err := r.db.QueryContext(ctx, query, year, accountID, r.baseCurrency)
 	if err != nil {
 		return nil, err
 	}
@@ -117,3 +161,112 @@ func (r *Repository) ListMonthlyNetTotals(ctx context.Context, year int) ([]Mont
 
 	return results, nil
 }
+
+// SumBefore returns the sum of all transactions dated before the given day,
+// in the base currency. Transfers are included, since they move money in and
+// out of accounts.
+func (r *Repository) SumBefore(ctx context.Context, before time.Time, accountID *int64) (int64, error) {
+	const where = `t.transaction_date < $1 AND ($2::bigint IS NULL OR t.account_id = $2) AND t.deleted_at IS NULL`
+	if err := CheckRates(ctx, r.db, r.baseCurrency, where, 3, before, accountID, r.baseCurrency); err != nil {
+		return 0, err
+	}
+
+	query := `
+		SELECT (COALESCE(SUM(t.amount * (` + rateFactor(3) + `)), 0) * ` + ScaleOf("$3::text") + `)::bigint
+		FROM transactions t
+		WHERE ` + where
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, query, before, accountID, r.baseCurrency).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// ListRangeInBase is ListRange with every transaction converted into the base
+// currency at the rate of its date, split lines included. ErrMissingRate is
+// returned when a rate is missing.
+func (r *Repository) ListRangeInBase(ctx context.Context, filter ListFilter) ([]Transaction, error) {
+	list, err := r.ListRange(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0)
+	for _, t := range list {
+		if t.Currency != r.baseCurrency {
+			ids = append(ids, t.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return list, nil
+	}
+
+	query := `
+		SELECT t.id, ((` + rateFactor(2) + `) * ` + ScaleOf("$2::text") + ` / ` + ScaleOf("t.currency") + `)::float8
+		FROM transactions t
+		WHERE t.id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ids, r.baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	factors := make(map[int64]sql.NullFloat64, len(ids))
+	for rows.Next() {
+		var id int64
+		var factor sql.NullFloat64
+		if err := rows.Scan(&id, &factor); err != nil {
+			return nil, err
+		}
+		factors[id] = factor
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, t := range list {
+		factor, ok := factors[t.ID]
+		if !ok {
+			continue
+		}
+		if !factor.Valid {
+			return nil, fmt.Errorf("%w to convert %s into %s on %s", ErrMissingRate, t.Currency, r.baseCurrency, t.TransactionDate.Format(time.DateOnly))
+		}
+
+		list[i].AmountCents = convertCents(t.AmountCents, factor.Float64)
+		list[i].Currency = r.baseCurrency
+		list[i].CurrencyExponent = MinorUnitExponent(r.baseCurrency)
+		if len(t.Splits) > 0 {
+			splits := make([]Split, len(t.Splits))
+			for j, s := range t.Splits {
+				s.AmountCents = convertCents(s.AmountCents, factor.Float64)
+				splits[j] = s
+			}
+			list[i].Splits = splits
+		}
+	}
+
+	return list, nil
+}
+
+func convertCents(cents int64, factor float64) int64 {
+	return int64(math.Round(float64(cents) * factor))
+}
+
+// FirstYear returns the year of the earliest transaction, or nil when there
+// are none. Callers that accumulate the yearly queries over all history start
+// from it.
+func (r *Repository) FirstYear(ctx context.Context) (*int, error) {
+	const query = `SELECT EXTRACT(YEAR FROM MIN(transaction_date))::int FROM transactions WHERE deleted_at IS NULL`
+
+	var year *int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&year); err != nil {
+		return nil, err
+	}
+
+	return year, nil
+}
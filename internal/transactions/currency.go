@@ -0,0 +1,89 @@
+package transactions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+// PivotCurrency is the currency exchange rates are quoted against: a rate is
+// the number of units of a currency per one euro, as published by the ECB.
+// The exchange_rate SQL function assumes it too.
+const PivotCurrency = "EUR"
+
+// ErrMissingRate is returned by analytics when a transaction cannot be
+// converted into the base currency because no exchange rate was published on
+// or before its date. The wrapping error names the currency and date.
+var ErrMissingRate = errors.New("missing exchange rate")
+
+// MinorUnitExponent returns the ISO 4217 minor unit exponent of currency, the
+// number of decimals its amounts have: AmountCents is the amount times ten to
+// that power. It mirrors the currency_exponent SQL function.
+func MinorUnitExponent(currency string) int {
+	switch currency {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG",
+		"RWF", "UGX", "UYI", "VND", "VUV", "XAF", "XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	case "CLF", "UYW":
+		return 4
+	default:
+		return 2
+	}
+}
+
+// transactionScale is the factor between an amount of the transactions row
+// and its minor units.
+const transactionScale = `10::numeric ^ currency_exponent(transactions.currency)`
+
+// ScaleOf is the factor between an amount in the currency expression and its
+// minor units.
+func ScaleOf(currency string) string {
+	return `10::numeric ^ currency_exponent(` + currency + `)`
+}
+
+// ScaleOfParam is ScaleOf for the currency code in placeholder param, such as
+// the base currency of the tables holding amounts in it.
+func ScaleOfParam(param int) string {
+	return ScaleOf(fmt.Sprintf("$%d::text", param))
+}
+
+// rateFactor is the factor converting an amount of the transactions row t
+// into the currency in placeholder param, at the rates of the transaction
+// date. It is NULL when a rate is missing.
+func rateFactor(param int) string {
+	return fmt.Sprintf("exchange_factor(t.currency, $%d::text, t.transaction_date)", param)
+}
+
+// CheckRates returns ErrMissingRate for the earliest transaction matching
+// where that cannot be converted into baseCurrency, which args pass in
+// placeholder baseParam. where refers to the transactions row as t and to
+// args by position. Every report converting into the base currency checks
+// its rows with it first.
+func CheckRates(ctx context.Context, conn db.DBTX, baseCurrency, where string, baseParam int, args ...any) error {
+	query := `
+		SELECT t.currency, t.transaction_date
+		FROM transactions t
+		WHERE ` + where + `
+			AND (` + rateFactor(baseParam) + `) IS NULL
+		ORDER BY t.transaction_date ASC
+		LIMIT 1
+	`
+
+	var currency string
+	var date time.Time
+	err := conn.QueryRowContext(ctx, query, args...).Scan(&currency, &date)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w to convert %s into %s on %s", ErrMissingRate, currency, baseCurrency, date.Format(time.DateOnly))
+}
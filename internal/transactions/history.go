@@ -0,0 +1,74 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Change is one audit_log entry of a transaction. Before and After hold the
+// transactions row as JSON, with its split lines as splits and the names of
+// its tags as tags, and with the amounts in minor units as amount_cents;
+// Before is nil for a create and After for a purge. Entries recorded before
+// lines and tags were audited have neither. RequestID is nil for changes
+// made outside a request, e.g. by the schedulers.
+type Change struct {
+	ID        int64
+	Action    string
+	RequestID *string
+	ChangedAt time.Time
+	Before    json.RawMessage
+	After     json.RawMessage
+}
+
+// auditedRow turns the transactions row JSON in column into the form Change
+// reports, replacing the stored amounts with amount_cents.
+func auditedRow(column string) string {
+	currency := column + `->>'currency'`
+	return `CASE WHEN ` + column + ` IS NOT NULL THEN
+		(` + column + ` - 'amount' - 'splits') || jsonb_build_object(
+			'amount_cents', ((` + column + `->>'amount')::numeric * ` + ScaleOf(currency) + `)::bigint,
+			'currency_exponent', currency_exponent(` + currency + `)
+		) || CASE WHEN ` + column + ` ? 'splits' THEN jsonb_build_object('splits', (
+			SELECT COALESCE(jsonb_agg((l.line - 'amount') || jsonb_build_object(
+				'amount_cents', ((l.line->>'amount')::numeric * ` + ScaleOf(currency) + `)::bigint
+			) ORDER BY l.n), '[]'::jsonb)
+			FROM jsonb_array_elements(` + column + `->'splits') WITH ORDINALITY AS l(line, n)
+		)) ELSE '{}'::jsonb END
+	END`
+}
+
+// History returns the recorded changes of the transaction, oldest first. It
+// also covers transactions that have since been purged.
+func (r *Repository) History(ctx context.Context, id int64) ([]Change, error) {
+	query := `
+		SELECT id, action, request_id, changed_at, ` + auditedRow("before") + `, ` + auditedRow("after") + `
+		FROM audit_log
+		WHERE entity = 'transaction' AND entity_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Change, 0)
+	for rows.Next() {
+		var c Change
+		var before, after []byte
+		if err := rows.Scan(&c.ID, &c.Action, &c.RequestID, &c.ChangedAt, &before, &after); err != nil {
+			return nil, err
+		}
+		c.Before = before
+		c.After = after
+		list = append(list, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
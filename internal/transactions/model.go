@@ -1,27 +1,159 @@
 package transactions
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
-// AmountCents represents monetary values in cents (can be negative).
+// ErrSplitSum is returned when the split lines of a transaction do not add up
+// to its amount.
+var ErrSplitSum = errors.New("split amounts must add up to the transaction amount")
+
+// ErrArchivedCategory is returned when a transaction or split line is assigned
+// to an archived category it was not already in.
+var ErrArchivedCategory = errors.New("category is archived")
+
+// ErrDeletedCategory is returned when a transaction or split line is assigned
+// to a category in the trash it was not already in.
+var ErrDeletedCategory = errors.New("category is in the trash")
+
+// ErrVersionMismatch is returned when a transaction was changed since the
+// version the caller read.
+var ErrVersionMismatch = errors.New("transaction was changed since it was read")
+
+// AmountCents represents monetary values in the minor units of Currency, an
+// ISO 4217 code (can be negative): cents for most currencies, yen for JPY and
+// fils for BHD. CurrencyExponent is the number of minor unit decimals.
+// ExternalID is the bank's identifier (e.g. an OFX FITID) for imported rows,
+// scoped by ExternalAccount. TransferID is set on both legs of a transfer
+// between accounts; such rows are left out of income and spending analytics.
+// When Splits is not empty, analytics attribute the amount to the split lines'
+// categories instead of CategoryID. RecurringRuleID links transactions booked
+// by a recurring rule. PayeeID is the normalized merchant the description
+// refers to. Tags holds the names of the transaction's tags. DeletedAt is set
+// while the transaction is in the trash. Version goes up with every update of
+// the row and backs the API's ETag.
 type Transaction struct {
-	ID              int64
-	TransactionDate time.Time
-	CategoryID      *int64
-	AmountCents     int64
-	Description     *string
-	CreatedAt       time.Time
+	ID               int64
+	TransactionDate  time.Time
+	AccountID        *int64
+	CategoryID       *int64
+	AmountCents      int64
+	Currency         string
+	CurrencyExponent int
+	Description      *string
+	ExternalAccount  *string
+	ExternalID       *string
+	TransferID       *int64
+	RecurringRuleID  *int64
+	PayeeID          *int64
+	Splits           []Split
+	Tags             []string
+	CreatedAt        time.Time
+	DeletedAt        *time.Time
+	Version          int64
+}
+
+// CheckVersion returns ErrVersionMismatch when version is set and is not the
+// transaction's current one.
+func (t Transaction) CheckVersion(version *int64) error {
+	if version != nil && *version != t.Version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// Split is one category line of a split transaction.
+type Split struct {
+	CategoryID  int64
+	AmountCents int64
+	Description *string
 }
 
+// CreateInput is a new transaction. An empty Currency is the base currency.
 type CreateInput struct {
 	TransactionDate time.Time
+	AccountID       *int64
 	CategoryID      *int64
 	AmountCents     int64
+	Currency        string
 	Description     *string
+	ExternalAccount *string
+	ExternalID      *string
+	TransferID      *int64
+	RecurringRuleID *int64
+	OccurrenceDate  *time.Time
+	PayeeID         *int64
+	Splits          []Split
+	Tags            []string
 }
 
+// UpdateInput replaces the transaction, including its split lines. Tags
+// replaces its tags when not nil; nil leaves them unchanged. An empty
+// Currency leaves the currency unchanged too.
 type UpdateInput struct {
 	TransactionDate time.Time
+	AccountID       *int64
 	CategoryID      *int64
 	AmountCents     int64
+	Currency        string
 	Description     *string
+	PayeeID         *int64
+	Splits          []Split
+	Tags            []string
+}
+
+// OccurrenceUpdate is applied to transactions generated by a recurring rule
+// when the rule is edited.
+type OccurrenceUpdate struct {
+	AccountID   *int64
+	CategoryID  *int64
+	AmountCents int64
+	Description *string
+}
+
+// ValidateSplits checks that splits, when present, add up to amountCents.
+func ValidateSplits(amountCents int64, splits []Split) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	var total int64
+	for _, s := range splits {
+		total += s.AmountCents
+	}
+	if total != amountCents {
+		return ErrSplitSum
+	}
+	return nil
+}
+
+// TagMode decides whether a tag filter matches transactions with any or with
+// all of the given tags.
+type TagMode string
+
+const (
+	TagModeAny TagMode = "any"
+	TagModeAll TagMode = "all"
+)
+
+// ListFilter narrows list and export queries. Type is "spending" or "income";
+// any other value leaves the sign unfiltered. Tags are matched by name,
+// ignoring case, according to TagMode.
+type ListFilter struct {
+	FromDate   *time.Time
+	ToDate     *time.Time
+	AccountID  *int64
+	CategoryID *int64
+	PayeeID    *int64
+	Type       *string
+	Tags       []string
+	TagMode    TagMode
+}
+
+// ExportRow is a transaction together with the name of its category, as
+// written by exports.
+type ExportRow struct {
+	Transaction
+	CategoryName *string
 }
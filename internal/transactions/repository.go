@@ -6,103 +6,233 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
 )
 
 type Repository struct {
-	db *sql.DB
+	db           db.DBTX
+	baseCurrency string
 }
 
-func NewRepository(db *sql.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *sql.DB, cfg config.Config) *Repository {
+	return &Repository{db: db, baseCurrency: cfg.BaseCurrency}
 }
 
-func (r *Repository) Create(ctx context.Context, in CreateInput) (Transaction, error) {
-	const query = `
-		INSERT INTO transactions (transaction_date, category_id, amount, description)
-		VALUES ($1, $2, $3::numeric / 100, $4)
-		RETURNING id, transaction_date, category_id, (amount * 100)::bigint, description, created_at
-	`
+// WithTx returns a copy of the repository that runs its queries inside tx.
+func (r *Repository) WithTx(tx *sql.Tx) *Repository {
+	return &Repository{db: tx, baseCurrency: r.baseCurrency}
+}
 
+// BaseCurrency returns the currency transactions are created in when none is
+// given and that analytics report in.
+func (r *Repository) BaseCurrency() string {
+	return r.baseCurrency
+}
+
+const transactionColumns = `id, transaction_date, account_id, category_id, (amount * ` + transactionScale + `)::bigint, currency, currency_exponent(currency), description, external_account, external_id, transfer_id, recurring_rule_id, payee_id, created_at, deleted_at, version, ` + splitsColumn + `, ` + tagsColumn
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTransaction(row rowScanner) (Transaction, error) {
 	var t Transaction
-	var categoryID sql.NullInt64
-	err := r.db.QueryRowContext(
-		ctx,
-		query,
-		in.TransactionDate,
-		in.CategoryID,
-		in.AmountCents,
-		in.Description,
-	).Scan(
+	var accountID, categoryID, transferID, recurringRuleID, payeeID sql.NullInt64
+	var splits, tags []byte
+	err := row.Scan(
 		&t.ID,
 		&t.TransactionDate,
+		&accountID,
 		&categoryID,
 		&t.AmountCents,
+		&t.Currency,
+		&t.CurrencyExponent,
 		&t.Description,
+		&t.ExternalAccount,
+		&t.ExternalID,
+		&transferID,
+		&recurringRuleID,
+		&payeeID,
 		&t.CreatedAt,
+		&t.DeletedAt,
+		&t.Version,
+		&splits,
+		&tags,
 	)
 	if err != nil {
 		return Transaction{}, err
 	}
 
+	t.Splits, err = decodeSplits(splits)
+	if err != nil {
+		return Transaction{}, err
+	}
+	t.Tags, err = decodeTags(tags)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	t.AccountID = nullableInt64(accountID)
 	t.CategoryID = nullableInt64(categoryID)
+	t.TransferID = nullableInt64(transferID)
+	t.RecurringRuleID = nullableInt64(recurringRuleID)
+	t.PayeeID = nullableInt64(payeeID)
 
 	return t, nil
 }
 
-func (r *Repository) Get(ctx context.Context, id int64) (Transaction, error) {
-	const query = `
-		SELECT id, transaction_date, category_id, (amount * 100)::bigint, description, created_at
-		FROM transactions
-		WHERE id = $1
+// Create inserts the transaction and its split lines in one statement. It
+// returns ErrSplitSum when the lines do not add up to the amount, and
+// ErrArchivedCategory or ErrDeletedCategory when any of its categories is
+// archived or trashed.
+func (r *Repository) Create(ctx context.Context, in CreateInput) (Transaction, error) {
+	if err := ValidateSplits(in.AmountCents, in.Splits); err != nil {
+		return Transaction{}, err
+	}
+	if err := r.checkArchived(ctx, nil, in.CategoryID, in.Splits); err != nil {
+		return Transaction{}, err
+	}
+	tags, tagKeys, err := r.resolveTags(ctx, in.Tags)
+	if err != nil {
+		return Transaction{}, err
+	}
+	splits, err := encodeSplits(in.Splits)
+	if err != nil {
+		return Transaction{}, err
+	}
+	currency := in.Currency
+	if currency == "" {
+		currency = r.baseCurrency
+	}
+
+	query := `
+		WITH inserted AS (
+			INSERT INTO transactions (transaction_date, category_id, amount, description, external_account, external_id, account_id, transfer_id, recurring_rule_id, occurrence_date, payee_id, currency)
+			VALUES ($1, $2, $3::numeric / ` + ScaleOf("$14::text") + `, $4, $5, $6, $7, $8, $9, $10, $13, $14)
+			RETURNING *
+		), lines AS (` + insertSplitsFrom("inserted", "$11") + `
+		), tagged AS (` + insertTagsFrom("inserted", "$12") + `)
+		SELECT ` + transactionColumns + `
+		FROM inserted AS transactions
 	`
 
-	var t Transaction
-	var categoryID sql.NullInt64
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&t.ID,
-		&t.TransactionDate,
-		&categoryID,
-		&t.AmountCents,
-		&t.Description,
-		&t.CreatedAt,
-	)
+	var created Transaction
+	err = db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		var err error
+		created, err = scanTransaction(tx.QueryRowContext(
+			ctx,
+			query,
+			in.TransactionDate,
+			in.CategoryID,
+			in.AmountCents,
+			in.Description,
+			in.ExternalAccount,
+			in.ExternalID,
+			in.AccountID,
+			in.TransferID,
+			in.RecurringRuleID,
+			in.OccurrenceDate,
+			splits,
+			tagKeys,
+			in.PayeeID,
+			currency,
+		))
+		return err
+	})
 	if err != nil {
 		return Transaction{}, err
 	}
 
-	t.CategoryID = nullableInt64(categoryID)
+	// The statement's snapshot does not include the lines and tags it inserted.
+	created.Splits = splitsOrEmpty(in.Splits)
+	created.Tags = tagsOrEmpty(tags)
+	return created, nil
+}
 
-	return t, nil
+func (r *Repository) Get(ctx context.Context, id int64) (Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	return scanTransaction(r.db.QueryRowContext(ctx, query, id))
 }
 
-func (r *Repository) ListAfter(ctx context.Context, limit int, fromDate *time.Time, toDate *time.Time, categoryID *int64, txType *string, afterDate *time.Time, afterID *int64) ([]Transaction, error) {
-	const baseQuery = `
-		SELECT id, transaction_date, category_id, (amount * 100)::bigint, description, created_at
+// GetForUpdate reads a transaction and locks it until the surrounding database
+// transaction ends. It must be called on a repository bound with WithTx.
+func (r *Repository) GetForUpdate(ctx context.Context, id int64) (Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
 		FROM transactions
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 
-	clauses := make([]string, 0, 2)
-	args := make([]any, 0, 4)
+	return scanTransaction(r.db.QueryRowContext(ctx, query, id))
+}
 
-	if fromDate != nil {
-		clauses = append(clauses, fmt.Sprintf("transaction_date >= $%d", len(args)+1))
-		args = append(args, *fromDate)
-	}
-	if toDate != nil {
-		clauses = append(clauses, fmt.Sprintf("transaction_date <= $%d", len(args)+1))
-		args = append(args, *toDate)
+// ListByTransfer returns the legs of a transfer, debit first.
+func (r *Repository) ListByTransfer(ctx context.Context, transferID int64) ([]Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE transfer_id = $1 AND deleted_at IS NULL
+		ORDER BY amount ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, transferID)
+	if err != nil {
+		return nil, err
 	}
-	if categoryID != nil {
-		clauses = append(clauses, fmt.Sprintf("category_id = $%d", len(args)+1))
-		args = append(args, *categoryID)
-	}
-	if txType != nil {
-		if *txType == "spending" {
-			clauses = append(clauses, "amount < 0")
-		} else if *txType == "income" {
-			clauses = append(clauses, "amount > 0")
+	defer rows.Close()
+
+	legs := make([]Transaction, 0, 2)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
 		}
+		legs = append(legs, t)
 	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return legs, nil
+}
+
+// ExternalIDExists reports whether a transaction imported from the given
+// external account (nil when unknown) already carries externalID. Trashed
+// transactions count, so a re-import does not bring them back.
+func (r *Repository) ExternalIDExists(ctx context.Context, externalAccount *string, externalID string) (bool, error) {
+	const query = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM transactions
+			WHERE COALESCE(external_account, '') = COALESCE($1, '')
+				AND external_id = $2
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, externalAccount, externalID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *Repository) ListAfter(ctx context.Context, limit int, filter ListFilter, afterDate *time.Time, afterID *int64) ([]Transaction, error) {
+	baseQuery := `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+	`
+
+	clauses, args := filter.clauses(nil)
 	if afterDate != nil && afterID != nil {
 		clauses = append(clauses, fmt.Sprintf("(transaction_date, id) < ($%d, $%d)", len(args)+1, len(args)+2))
 		args = append(args, *afterDate, *afterID)
@@ -123,19 +253,10 @@ func (r *Repository) ListAfter(ctx context.Context, limit int, fromDate *time.Ti
 
 	transactions := make([]Transaction, 0)
 	for rows.Next() {
-		var t Transaction
-		var categoryID sql.NullInt64
-		if err := rows.Scan(
-			&t.ID,
-			&t.TransactionDate,
-			&categoryID,
-			&t.AmountCents,
-			&t.Description,
-			&t.CreatedAt,
-		); err != nil {
+		t, err := scanTransaction(rows)
+		if err != nil {
 			return nil, err
 		}
-		t.CategoryID = nullableInt64(categoryID)
 		transactions = append(transactions, t)
 	}
 
@@ -146,46 +267,495 @@ func (r *Repository) ListAfter(ctx context.Context, limit int, fromDate *time.Ti
 	return transactions, nil
 }
 
-func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Transaction, error) {
+// ListRange returns every transaction matching filter, oldest first. Callers
+// bound it with a date range.
+func (r *Repository) ListRange(ctx context.Context, filter ListFilter) ([]Transaction, error) {
+	baseQuery := `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+	`
+
+	clauses, args := filter.clauses(nil)
+	query := baseQuery
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY transaction_date ASC, id ASC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := make([]Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// ListUncategorized returns the transactions with neither a category nor
+// split lines, leaving out transfer legs, oldest first.
+func (r *Repository) ListUncategorized(ctx context.Context) ([]Transaction, error) {
+	const query = `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE category_id IS NULL
+			AND transfer_id IS NULL
+			AND deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id)
+		ORDER BY transaction_date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := make([]Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// ListCategorized returns the unsplit transactions that have a category and a
+// description, leaving out transfer legs and rows booked by recurring rules.
+func (r *Repository) ListCategorized(ctx context.Context) ([]Transaction, error) {
+	const query = `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE category_id IS NOT NULL
+			AND description IS NOT NULL
+			AND transfer_id IS NULL
+			AND recurring_rule_id IS NULL
+			AND deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id)
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := make([]Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// SetCategory assigns a category to a transaction that has none yet.
+func (r *Repository) SetCategory(ctx context.Context, id, categoryID int64) error {
+	const query = `UPDATE transactions SET category_id = $1 WHERE id = $2 AND category_id IS NULL AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, categoryID, id)
+	return err
+}
+
+// ListWithoutPayee returns the transactions that have a description but no
+// payee, leaving out transfer legs, oldest first.
+func (r *Repository) ListWithoutPayee(ctx context.Context) ([]Transaction, error) {
+	const query = `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE payee_id IS NULL
+			AND description IS NOT NULL
+			AND transfer_id IS NULL
+			AND deleted_at IS NULL
+		ORDER BY transaction_date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := make([]Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// SetPayee links a transaction that has no payee yet to payeeID.
+func (r *Repository) SetPayee(ctx context.Context, id, payeeID int64) error {
+	const query = `UPDATE transactions SET payee_id = $1 WHERE id = $2 AND payee_id IS NULL AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, payeeID, id)
+	return err
+}
+
+// ListByIDs returns the transactions with the given ids, oldest first. Unknown
+// and trashed ids are skipped.
+func (r *Repository) ListByIDs(ctx context.Context, ids []int64) ([]Transaction, error) {
+	const query = `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE id = ANY($1::bigint[]) AND deleted_at IS NULL
+		ORDER BY transaction_date ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transactions := make([]Transaction, 0, len(ids))
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// ClearExternalID removes the transaction's external id, so that another row
+// can take it over.
+func (r *Repository) ClearExternalID(ctx context.Context, id int64) error {
 	const query = `
 		UPDATE transactions
-		SET transaction_date = $1,
-			category_id = $2,
-			amount = $3::numeric / 100,
-			description = $4
-		WHERE id = $5
-		RETURNING id, transaction_date, category_id, (amount * 100)::bigint, description, created_at
+		SET external_account = NULL, external_id = NULL
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// FillMissing copies the account, payee, description and external ids of
+// from onto the transaction id wherever it has none, and adds the tags of
+// from. The category is copied only when the transaction has neither a
+// category nor split lines.
+func (r *Repository) FillMissing(ctx context.Context, id int64, from Transaction) (Transaction, error) {
+	query := `
+		WITH updated AS (
+			UPDATE transactions
+			SET account_id = COALESCE(account_id, $1),
+				description = COALESCE(description, $2),
+				external_account = CASE WHEN external_id IS NULL AND $4::text IS NOT NULL THEN $3 ELSE external_account END,
+				external_id = COALESCE(external_id, $4),
+				payee_id = COALESCE(payee_id, $8),
+				category_id = CASE
+					WHEN category_id IS NULL
+						AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id)
+					THEN $5
+					ELSE category_id
+				END
+			WHERE id = $6 AND deleted_at IS NULL
+			RETURNING *
+		), tagged AS (` + insertTagsFrom("updated", "$7") + `)
+		SELECT id FROM updated
 	`
 
-	var t Transaction
-	var categoryID sql.NullInt64
 	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		from.AccountID,
+		from.Description,
+		from.ExternalAccount,
+		from.ExternalID,
+		from.CategoryID,
+		id,
+		lowerAll(from.Tags),
+		from.PayeeID,
+	).Scan(&id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	// Read back in a new statement so the added tags are visible.
+	return r.Get(ctx, id)
+}
+
+// Export calls fn for every transaction matching filter, oldest first, while
+// the rows are read from the database so large exports are never held in
+// memory. Iteration stops at the first error returned by fn.
+func (r *Repository) Export(ctx context.Context, filter ListFilter, fn func(ExportRow) error) error {
+	baseQuery := `
+		SELECT transactions.id, transactions.transaction_date, transactions.account_id, transactions.category_id,
+			(transactions.amount * ` + transactionScale + `)::bigint, transactions.currency, currency_exponent(transactions.currency), transactions.description,
+			transactions.external_account, transactions.external_id, transactions.transfer_id, transactions.created_at, c.name
+		FROM transactions
+		LEFT JOIN categories c ON c.id = transactions.category_id
+	`
+
+	clauses, args := filter.clauses(nil)
+	query := baseQuery
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+	query += " ORDER BY transactions.transaction_date ASC, transactions.id ASC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row ExportRow
+		var accountID, categoryID, transferID sql.NullInt64
+		if err := rows.Scan(
+			&row.ID,
+			&row.TransactionDate,
+			&accountID,
+			&categoryID,
+			&row.AmountCents,
+			&row.Currency,
+			&row.CurrencyExponent,
+			&row.Description,
+			&row.ExternalAccount,
+			&row.ExternalID,
+			&transferID,
+			&row.CreatedAt,
+			&row.CategoryName,
+		); err != nil {
+			return err
+		}
+		row.AccountID = nullableInt64(accountID)
+		row.CategoryID = nullableInt64(categoryID)
+		row.TransferID = nullableInt64(transferID)
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ExportCurrencies returns the currencies of the transactions matching
+// filter, in alphabetical order.
+func (r *Repository) ExportCurrencies(ctx context.Context, filter ListFilter) ([]string, error) {
+	clauses, args := filter.clauses(nil)
+	query := `SELECT DISTINCT transactions.currency FROM transactions WHERE ` + strings.Join(clauses, " AND ") + ` ORDER BY 1`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	currencies := make([]string, 0)
+	for rows.Next() {
+		var currency string
+		if err := rows.Scan(&currency); err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return currencies, nil
+}
+
+// UpdateOccurrences applies in to the transactions booked by the recurring
+// rule for occurrences on or after from, and returns how many were changed.
+// The amount is in the base currency, like the rule's, so the occurrences are
+// moved back to it. Transactions that were split by hand are left alone,
+// since a new amount would no longer match their lines.
+func (r *Repository) UpdateOccurrences(ctx context.Context, ruleID int64, from time.Time, in OccurrenceUpdate) (int64, error) {
+	if err := r.checkArchived(ctx, nil, in.CategoryID, nil); err != nil {
+		return 0, err
+	}
+
+	query := `
+		UPDATE transactions
+		SET amount = $1::numeric / ` + ScaleOf("$7::text") + `,
+			currency = $7::text,
+			account_id = $2,
+			category_id = $3,
+			description = $4
+		WHERE recurring_rule_id = $5
+			AND occurrence_date >= $6
+			AND deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id
+			)
+	`
+
+	res, err := r.db.ExecContext(ctx, query, in.AmountCents, in.AccountID, in.CategoryID, in.Description, ruleID, from, r.baseCurrency)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// CheckCategory returns ErrArchivedCategory or ErrDeletedCategory when new
+// transactions cannot be filed under categoryID.
+func (r *Repository) CheckCategory(ctx context.Context, categoryID *int64) error {
+	return r.checkArchived(ctx, nil, categoryID, nil)
+}
+
+// checkArchived returns ErrArchivedCategory when categoryID or a split line
+// refers to an archived category, and ErrDeletedCategory when it refers to a
+// trashed one. Categories the existing transaction (transactionID) already
+// uses are allowed, so old rows stay editable.
+func (r *Repository) checkArchived(ctx context.Context, transactionID, categoryID *int64, splits []Split) error {
+	ids := make([]int64, 0, len(splits)+1)
+	if categoryID != nil {
+		ids = append(ids, *categoryID)
+	}
+	for _, s := range splits {
+		ids = append(ids, s.CategoryID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	const query = `
+		SELECT
+			COALESCE(bool_or(deleted_at IS NOT NULL), false),
+			COALESCE(bool_or(archived_at IS NOT NULL), false)
+		FROM categories
+		WHERE id = ANY($1::bigint[])
+			AND (archived_at IS NOT NULL OR deleted_at IS NOT NULL)
+			AND id NOT IN (
+				SELECT category_id FROM transactions WHERE id = $2 AND category_id IS NOT NULL
+				UNION
+				SELECT category_id FROM transaction_splits WHERE transaction_id = $2
+			)
+	`
+
+	var deleted, archived bool
+	if err := r.db.QueryRowContext(ctx, query, ids, transactionID).Scan(&deleted, &archived); err != nil {
+		return err
+	}
+	if deleted {
+		return ErrDeletedCategory
+	}
+	if archived {
+		return ErrArchivedCategory
+	}
+	return nil
+}
+
+// Update replaces the transaction and its split lines in one statement. It
+// returns ErrSplitSum when the lines do not add up to the amount, and
+// ErrArchivedCategory or ErrDeletedCategory when it moves into an archived or
+// trashed category.
+func (r *Repository) Update(ctx context.Context, id int64, in UpdateInput) (Transaction, error) {
+	if err := ValidateSplits(in.AmountCents, in.Splits); err != nil {
+		return Transaction{}, err
+	}
+	if err := r.checkArchived(ctx, &id, in.CategoryID, in.Splits); err != nil {
+		return Transaction{}, err
+	}
+	tags, tagKeys, err := r.resolveTags(ctx, in.Tags)
+	if err != nil {
+		return Transaction{}, err
+	}
+	splits, err := encodeSplits(in.Splits)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	query := `
+		WITH updated AS (
+			UPDATE transactions
+			SET transaction_date = $1,
+				category_id = $2,
+				amount = $3::numeric / ` + ScaleOf("COALESCE(NULLIF($10::text, ''), currency)") + `,
+				description = $4,
+				account_id = $5,
+				payee_id = $9,
+				currency = COALESCE(NULLIF($10::text, ''), currency)
+			WHERE id = $6 AND deleted_at IS NULL
+			RETURNING *
+		), cleared AS (
+			DELETE FROM transaction_splits
+			WHERE transaction_id IN (SELECT id FROM updated)
+		), lines AS (` + insertSplitsFrom("updated", "$7") + `
+		), untagged AS (` + deleteTagsFrom("updated", "$8") + `
+		), tagged AS (` + insertTagsFrom("updated", "$8") + `)
+		SELECT ` + transactionColumns + `
+		FROM updated AS transactions
+	`
+
+	updated, err := scanTransaction(r.db.QueryRowContext(
 		ctx,
 		query,
 		in.TransactionDate,
 		in.CategoryID,
 		in.AmountCents,
 		in.Description,
+		in.AccountID,
 		id,
-	).Scan(
-		&t.ID,
-		&t.TransactionDate,
-		&categoryID,
-		&t.AmountCents,
-		&t.Description,
-		&t.CreatedAt,
-	)
+		splits,
+		tagKeys,
+		in.PayeeID,
+		in.Currency,
+	))
 	if err != nil {
 		return Transaction{}, err
 	}
 
-	t.CategoryID = nullableInt64(categoryID)
-
-	return t, nil
+	// The statement's snapshot still holds the previous lines and tags.
+	updated.Splits = splitsOrEmpty(in.Splits)
+	if in.Tags != nil {
+		updated.Tags = tagsOrEmpty(tags)
+	}
+	return updated, nil
 }
 
+// Delete moves the transaction to the trash. It stays there, out of every
+// listing and report, until it is restored or purged.
 func (r *Repository) Delete(ctx context.Context, id int64) error {
-	const query = `DELETE FROM transactions WHERE id = $1`
+	const query = `UPDATE transactions SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
 
 	res, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
@@ -203,6 +773,53 @@ func (r *Repository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// clauses returns the WHERE conditions for the filter, always leaving out
+// trashed transactions, numbering placeholders after the ones already in
+// args. Column names are qualified with the transactions table so the clauses
+// also work in joined queries.
+func (f ListFilter) clauses(args []any) ([]string, []any) {
+	clauses := make([]string, 0, 6)
+	clauses = append(clauses, "transactions.deleted_at IS NULL")
+
+	if f.FromDate != nil {
+		clauses = append(clauses, fmt.Sprintf("transactions.transaction_date >= $%d", len(args)+1))
+		args = append(args, *f.FromDate)
+	}
+	if f.ToDate != nil {
+		clauses = append(clauses, fmt.Sprintf("transactions.transaction_date <= $%d", len(args)+1))
+		args = append(args, *f.ToDate)
+	}
+	if f.AccountID != nil {
+		clauses = append(clauses, fmt.Sprintf("transactions.account_id = $%d", len(args)+1))
+		args = append(args, *f.AccountID)
+	}
+	if f.CategoryID != nil {
+		// Split transactions match any of their lines' categories.
+		clauses = append(clauses, fmt.Sprintf(`(transactions.category_id = $%[1]d OR EXISTS (
+			SELECT 1 FROM transaction_splits s
+			WHERE s.transaction_id = transactions.id AND s.category_id = $%[1]d
+		))`, len(args)+1))
+		args = append(args, *f.CategoryID)
+	}
+	if f.PayeeID != nil {
+		clauses = append(clauses, fmt.Sprintf("transactions.payee_id = $%d", len(args)+1))
+		args = append(args, *f.PayeeID)
+	}
+	if len(f.Tags) > 0 {
+		clauses = append(clauses, tagClause(f.TagMode, len(args)+1))
+		args = append(args, lowerAll(f.Tags))
+	}
+	if f.Type != nil {
+		if *f.Type == "spending" {
+			clauses = append(clauses, "transactions.amount < 0")
+		} else if *f.Type == "income" {
+			clauses = append(clauses, "transactions.amount > 0")
+		}
+	}
+
+	return clauses, args
+}
+
 func nullableInt64(v sql.NullInt64) *int64 {
 	if !v.Valid {
 		return nil
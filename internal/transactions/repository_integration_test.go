@@ -12,6 +12,8 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"zankowitch.com/go-db-app/internal/config"
 )
 
 func TestRepositoryCRUD(t *testing.T) {
@@ -20,7 +22,7 @@ func TestRepositoryCRUD(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	t.Cleanup(cleanup)
 
-	repo := NewRepository(db)
+	repo := NewRepository(db, config.Config{BaseCurrency: "EUR"})
 	ctx := context.Background()
 
 	date := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
@@ -68,7 +70,7 @@ func TestRepositoryCRUD(t *testing.T) {
 			t.Fatalf("create second: %v", err)
 		}
 
-		list, err := repo.ListAfter(ctx, 10, nil, nil, nil, nil, nil, nil)
+		list, err := repo.ListAfter(ctx, 10, ListFilter{}, nil, nil)
 		if err != nil {
 			t.Fatalf("list: %v", err)
 		}
@@ -93,7 +95,7 @@ func TestRepositoryCRUD(t *testing.T) {
 	})
 
 	t.Run("read next page with cursor", func(t *testing.T) {
-		list, err := repo.ListAfter(ctx, 1, nil, nil, nil, nil, nil, nil)
+		list, err := repo.ListAfter(ctx, 1, ListFilter{}, nil, nil)
 		if err != nil {
 			t.Fatalf("list first page: %v", err)
 		}
@@ -103,7 +105,7 @@ func TestRepositoryCRUD(t *testing.T) {
 
 		cursorDate := list[0].TransactionDate
 		cursorID := list[0].ID
-		next, err := repo.ListAfter(ctx, 10, nil, nil, nil, nil, &cursorDate, &cursorID)
+		next, err := repo.ListAfter(ctx, 10, ListFilter{}, &cursorDate, &cursorID)
 		if err != nil {
 			t.Fatalf("list next page: %v", err)
 		}
@@ -114,7 +116,7 @@ func TestRepositoryCRUD(t *testing.T) {
 
 	t.Run("read from start date", func(t *testing.T) {
 		startDate := date.AddDate(0, 0, -1)
-		list, err := repo.ListAfter(ctx, 10, nil, &startDate, nil, nil, nil, nil)
+		list, err := repo.ListAfter(ctx, 10, ListFilter{ToDate: &startDate}, nil, nil)
 		if err != nil {
 			t.Fatalf("list from date: %v", err)
 		}
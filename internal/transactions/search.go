@@ -0,0 +1,99 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrEmptySearch is returned when a search text contains no word to look for.
+var ErrEmptySearch = errors.New("q must contain at least one letter or digit")
+
+// SearchHit is a transaction matching a full-text search, with its ts_rank
+// relevance. Higher ranks are better matches.
+type SearchHit struct {
+	Transaction
+	Rank float64
+}
+
+// prefixQuery turns free text into a to_tsquery expression that matches
+// descriptions containing every word, each as a prefix: "amaz ord" becomes
+// "amaz:* & ord:*". Punctuation is dropped so user input can never break the
+// tsquery syntax.
+func prefixQuery(q string) string {
+	words := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, w+":*")
+	}
+	return strings.Join(terms, " & ")
+}
+
+// searchCondition matches the transactions whose description contains every
+// word of the tsquery in placeholder param.
+func searchCondition(param int) string {
+	return fmt.Sprintf("transactions.search_vector @@ to_tsquery('simple', $%d)", param)
+}
+
+// Search lists the transactions whose description matches q and filter,
+// newest first, using the same (transaction_date, id) keyset cursor as
+// ListAfter so search results page the same way. Each hit carries its rank.
+func (r *Repository) Search(ctx context.Context, q string, limit int, filter ListFilter, afterDate *time.Time, afterID *int64) ([]SearchHit, error) {
+	tsquery := prefixQuery(q)
+	if tsquery == "" {
+		return nil, ErrEmptySearch
+	}
+
+	clauses, args := filter.clauses([]any{tsquery})
+	clauses = append(clauses, searchCondition(1))
+	if afterDate != nil && afterID != nil {
+		clauses = append(clauses, fmt.Sprintf("(transaction_date, id) < ($%d, $%d)", len(args)+1, len(args)+2))
+		args = append(args, *afterDate, *afterID)
+	}
+
+	query := `
+		SELECT ` + transactionColumns + `, ts_rank(search_vector, to_tsquery('simple', $1))
+		FROM transactions
+		WHERE ` + strings.Join(clauses, " AND ") +
+		fmt.Sprintf(" ORDER BY transaction_date DESC, id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]SearchHit, 0)
+	for rows.Next() {
+		var hit SearchHit
+		hit.Transaction, err = scanTransaction(rankScanner{row: rows, rank: &hit.Rank})
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// rankScanner appends the rank column to the destinations scanTransaction
+// reads.
+type rankScanner struct {
+	row  rowScanner
+	rank *float64
+}
+
+func (s rankScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.rank)...)
+}
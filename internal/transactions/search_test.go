@@ -0,0 +1,21 @@
+package transactions
+
+import "testing"
+
+func TestPrefixQuery(t *testing.T) {
+	tests := []struct {
+		q    string
+		want string
+	}{
+		{"amazon", "amazon:*"},
+		{"Amaz  ORD", "amaz:* & ord:*"},
+		{"AMZN*Mktp 12'34", "amzn:* & mktp:* & 12:* & 34:*"},
+		{"café & !(x|y)", "café:* & x:* & y:*"},
+		{"  ':*&|  ", ""},
+	}
+	for _, tt := range tests {
+		if got := prefixQuery(tt.q); got != tt.want {
+			t.Errorf("prefixQuery(%q) = %q, want %q", tt.q, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package transactions
+
+import "encoding/json"
+
+// splitLine is the JSON shape used to pass split lines to and from
+// PostgreSQL, so a transaction and its lines are written in one statement.
+type splitLine struct {
+	CategoryID  int64   `json:"category_id"`
+	AmountCents int64   `json:"amount_cents"`
+	Description *string `json:"description"`
+}
+
+// splitsColumn aggregates the split lines of each row of the transactions
+// relation as a JSON array, ordered as they were entered.
+const splitsColumn = `COALESCE((
+	SELECT jsonb_agg(jsonb_build_object(
+		'category_id', s.category_id,
+		'amount_cents', (s.amount * ` + transactionScale + `)::bigint,
+		'description', s.description
+	) ORDER BY s.id)
+	FROM transaction_splits s
+	WHERE s.transaction_id = transactions.id
+), '[]'::jsonb)`
+
+// insertSplitsFrom inserts the lines passed as JSON in placeholder param for
+// every row of the named CTE, in the minor units of its currency.
+func insertSplitsFrom(cte, param string) string {
+	return `
+		INSERT INTO transaction_splits (transaction_id, category_id, amount, description)
+		SELECT ` + cte + `.id, x.category_id, x.amount_cents::numeric / ` + ScaleOf(cte+".currency") + `, x.description
+		FROM ` + cte + `, jsonb_to_recordset(` + param + `::jsonb) AS x(category_id bigint, amount_cents bigint, description text)
+	`
+}
+
+func encodeSplits(splits []Split) ([]byte, error) {
+	lines := make([]splitLine, 0, len(splits))
+	for _, s := range splits {
+		lines = append(lines, splitLine(s))
+	}
+	return json.Marshal(lines)
+}
+
+func decodeSplits(raw []byte) ([]Split, error) {
+	var lines []splitLine
+	if err := json.Unmarshal(raw, &lines); err != nil {
+		return nil, err
+	}
+
+	splits := make([]Split, 0, len(lines))
+	for _, l := range lines {
+		splits = append(splits, Split(l))
+	}
+	return splits, nil
+}
+
+func splitsOrEmpty(splits []Split) []Split {
+	if splits == nil {
+		return []Split{}
+	}
+	return splits
+}
@@ -0,0 +1,34 @@
+package transactions
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateSplits(t *testing.T) {
+	if err := ValidateSplits(-1000, nil); err != nil {
+		t.Fatalf("unsplit transaction: %v", err)
+	}
+	if err := ValidateSplits(-1000, []Split{{CategoryID: 1, AmountCents: -700}, {CategoryID: 2, AmountCents: -300}}); err != nil {
+		t.Fatalf("matching splits: %v", err)
+	}
+	if err := ValidateSplits(-1000, []Split{{CategoryID: 1, AmountCents: -700}}); !errors.Is(err, ErrSplitSum) {
+		t.Fatalf("err = %v, want ErrSplitSum", err)
+	}
+}
+
+func TestSplitsRoundTrip(t *testing.T) {
+	description := "detergent"
+	raw, err := encodeSplits([]Split{{CategoryID: 3, AmountCents: -450, Description: &description}})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	splits, err := decodeSplits(raw)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(splits) != 1 || splits[0].CategoryID != 3 || splits[0].AmountCents != -450 || *splits[0].Description != description {
+		t.Fatalf("unexpected splits: %+v", splits)
+	}
+}
@@ -0,0 +1,137 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownTag is returned when a transaction is given a tag that does not
+// exist. The wrapping error names the tag.
+var ErrUnknownTag = errors.New("tag not found")
+
+// tagsColumn aggregates the tag names of each row of the transactions relation
+// as a JSON array, sorted case-insensitively.
+const tagsColumn = `COALESCE((
+	SELECT jsonb_agg(g.name ORDER BY lower(g.name))
+	FROM transaction_tags tt
+	JOIN tags g ON g.id = tt.tag_id
+	WHERE tt.transaction_id = transactions.id
+), '[]'::jsonb)`
+
+// insertTagsFrom tags every row of the named CTE with the tags whose
+// lower-cased names are in placeholder param. Tags already set are kept.
+func insertTagsFrom(cte, param string) string {
+	return `
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		SELECT ` + cte + `.id, g.id
+		FROM ` + cte + `, tags g
+		WHERE lower(g.name) = ANY(` + param + `::text[])
+		ON CONFLICT DO NOTHING
+	`
+}
+
+// deleteTagsFrom removes the tags of every row of the named CTE whose
+// lower-cased names are not in placeholder param. A NULL param keeps them all.
+func deleteTagsFrom(cte, param string) string {
+	return `
+		DELETE FROM transaction_tags
+		WHERE ` + param + `::text[] IS NOT NULL
+			AND transaction_id IN (SELECT id FROM ` + cte + `)
+			AND tag_id NOT IN (SELECT id FROM tags WHERE lower(name) = ANY(` + param + `::text[]))
+	`
+}
+
+func decodeTags(raw []byte) ([]string, error) {
+	tags := make([]string, 0)
+	if err := json.Unmarshal(raw, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// resolveTags checks that every name is an existing tag, ignoring case, and
+// returns the tags' stored names sorted as tagsColumn sorts them, together
+// with the lower-cased names used to match them in SQL. Nil names stay nil.
+func (r *Repository) resolveTags(ctx context.Context, names []string) ([]string, []string, error) {
+	if names == nil {
+		return nil, nil, nil
+	}
+
+	keys := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		key := strings.ToLower(strings.TrimSpace(n))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	const query = `SELECT name FROM tags WHERE lower(name) = ANY($1::text[]) ORDER BY lower(name)`
+
+	rows, err := r.db.QueryContext(ctx, query, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	stored := make([]string, 0, len(keys))
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, nil, err
+		}
+		stored = append(stored, name)
+		delete(seen, strings.ToLower(name))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, key := range keys {
+		if _, missing := seen[key]; missing {
+			return nil, nil, fmt.Errorf("%w: %s", ErrUnknownTag, key)
+		}
+	}
+
+	return stored, keys, nil
+}
+
+// tagClause returns the condition matching transactions tagged with any, or
+// with all, of the lower-cased names in placeholder param.
+func tagClause(mode TagMode, param int) string {
+	if mode == TagModeAll {
+		return fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM unnest($%d::text[]) AS wanted(name)
+			WHERE NOT EXISTS (
+				SELECT 1 FROM transaction_tags tt
+				JOIN tags g ON g.id = tt.tag_id
+				WHERE tt.transaction_id = transactions.id AND lower(g.name) = wanted.name
+			)
+		)`, param)
+	}
+	return fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM transaction_tags tt
+		JOIN tags g ON g.id = tt.tag_id
+		WHERE tt.transaction_id = transactions.id AND lower(g.name) = ANY($%d::text[])
+	)`, param)
+}
+
+func lowerAll(names []string) []string {
+	out := make([]string, 0, len(names))
+	for _, n := range names {
+		out = append(out, strings.ToLower(strings.TrimSpace(n)))
+	}
+	return out
+}
+
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
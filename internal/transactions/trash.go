@@ -0,0 +1,116 @@
+package transactions
+
+import (
+	"context"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/db"
+)
+
+// ListDeleted returns the transactions in the trash, most recently deleted
+// first.
+func (r *Repository) ListDeleted(ctx context.Context) ([]Transaction, error) {
+	const query = `
+		SELECT ` + transactionColumns + `
+		FROM transactions
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// DeleteByTransfer moves both legs of a transfer to the trash. The transfer
+// itself is kept so that restoring a leg brings the whole transfer back.
+func (r *Repository) DeleteByTransfer(ctx context.Context, transferID int64) error {
+	const query = `UPDATE transactions SET deleted_at = now() WHERE transfer_id = $1 AND deleted_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, transferID)
+	return err
+}
+
+// Restore takes a transaction out of the trash, together with the other leg
+// when it is part of a transfer. It returns sql.ErrNoRows when the
+// transaction is not in the trash.
+func (r *Repository) Restore(ctx context.Context, id int64) (Transaction, error) {
+	query := `
+		WITH target AS (
+			SELECT id, transfer_id
+			FROM transactions
+			WHERE id = $1 AND deleted_at IS NOT NULL
+		), restored AS (
+			UPDATE transactions
+			SET deleted_at = NULL
+			WHERE deleted_at IS NOT NULL
+				AND (id IN (SELECT id FROM target) OR transfer_id IN (SELECT transfer_id FROM target))
+			RETURNING *
+		)
+		SELECT ` + transactionColumns + `
+		FROM restored AS transactions
+		WHERE id = $1
+	`
+
+	var restored Transaction
+	err := db.Atomic(ctx, r.db, func(tx db.DBTX) error {
+		var err error
+		restored, err = scanTransaction(tx.QueryRowContext(ctx, query, id))
+		return err
+	})
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	return restored, nil
+}
+
+// Purge permanently deletes the transactions trashed before cutoff and
+// returns how many were removed. Transfer legs are trashed together, so their
+// transfer is deleted with them. It must be called on a repository bound with
+// WithTx.
+func (r *Repository) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	const purgeTransfers = `
+		DELETE FROM transfers
+		WHERE id IN (SELECT transfer_id FROM transactions WHERE deleted_at < $1)
+	`
+	const purgeTransactions = `DELETE FROM transactions WHERE deleted_at < $1`
+
+	res, err := r.db.ExecContext(ctx, purgeTransfers, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	transfers, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err = r.db.ExecContext(ctx, purgeTransactions, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	standalone, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	// Each transfer took its two legs with it.
+	return standalone + 2*transfers, nil
+}
@@ -0,0 +1,44 @@
+package transfers
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidAmount  = errors.New("transfer amount must not be zero")
+	ErrSameAccount    = errors.New("transfer accounts must differ")
+	ErrMissingAccount = errors.New("transfer legs must have an account")
+	ErrSplitLeg       = errors.New("transfer legs cannot be split")
+)
+
+// Transfer moves AmountCents (always positive, in minor units of Currency)
+// from one account to another. It is booked as two linked transactions in
+// that currency: a debit on FromAccountID and a credit on ToAccountID.
+type Transfer struct {
+	ID                  int64
+	FromAccountID       int64
+	ToAccountID         int64
+	TransferDate        time.Time
+	AmountCents         int64
+	Currency            string
+	CurrencyExponent    int
+	Description         *string
+	DebitTransactionID  int64
+	CreditTransactionID int64
+	CreatedAt           time.Time
+}
+
+// CreateInput is a new transfer. AmountCents is in minor units of Currency,
+// which applies to both legs: empty is the base currency on create and leaves
+// the transfer's currency unchanged on update.
+type CreateInput struct {
+	FromAccountID int64
+	ToAccountID   int64
+	TransferDate  time.Time
+	AmountCents   int64
+	Currency      string
+	Description   *string
+}
+
+type UpdateInput = CreateInput
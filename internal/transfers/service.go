@@ -0,0 +1,352 @@
+package transfers
+
+import (
+	"context"
+	"database/sql"
+
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Service books transfers and keeps both legs consistent. Transaction updates
+// and deletes go through it as well, so editing one leg of a transfer is
+// mirrored on the other.
+type Service struct {
+	db           *sql.DB
+	transactions *transactions.Repository
+}
+
+func NewService(db *sql.DB, transactions *transactions.Repository) *Service {
+	return &Service{db: db, transactions: transactions}
+}
+
+var transferColumns = `id, from_account_id, to_account_id, transfer_date, (amount * ` + transactions.ScaleOf("currency") + `)::bigint, currency, currency_exponent(currency), description, created_at`
+
+// transferLive matches the transfers whose legs are not in the trash.
+const transferLive = `EXISTS (SELECT 1 FROM transactions WHERE transfer_id = transfers.id AND deleted_at IS NULL)`
+
+// transferSelect reads transfers together with the ids of their two legs.
+var transferSelect = `
+	SELECT ` + transferColumns + `,
+		(SELECT MIN(id) FROM transactions WHERE transfer_id = transfers.id AND amount < 0),
+		(SELECT MIN(id) FROM transactions WHERE transfer_id = transfers.id AND amount > 0)
+	FROM transfers
+`
+
+func (s *Service) Create(ctx context.Context, in CreateInput) (Transfer, error) {
+	if err := validate(in); err != nil {
+		return Transfer{}, err
+	}
+
+	currency := in.Currency
+	if currency == "" {
+		currency = s.transactions.BaseCurrency()
+	}
+
+	var created Transfer
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO transfers (from_account_id, to_account_id, transfer_date, amount, currency, description)
+			VALUES ($1, $2, $3, $4::numeric / ` + transactions.ScaleOfParam(5) + `, $5, $6)
+			RETURNING id
+		`
+
+		var id int64
+		if err := tx.QueryRowContext(ctx, query, in.FromAccountID, in.ToAccountID, in.TransferDate, in.AmountCents, currency, in.Description).Scan(&id); err != nil {
+			return err
+		}
+
+		txRepo := s.transactions.WithTx(tx)
+		_, err := txRepo.Create(ctx, transactions.CreateInput{
+			TransactionDate: in.TransferDate,
+			AccountID:       &in.FromAccountID,
+			AmountCents:     -in.AmountCents,
+			Currency:        currency,
+			Description:     in.Description,
+			TransferID:      &id,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = txRepo.Create(ctx, transactions.CreateInput{
+			TransactionDate: in.TransferDate,
+			AccountID:       &in.ToAccountID,
+			AmountCents:     in.AmountCents,
+			Currency:        currency,
+			Description:     in.Description,
+			TransferID:      &id,
+		})
+		if err != nil {
+			return err
+		}
+
+		created, err = s.get(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return created, nil
+}
+
+func (s *Service) Get(ctx context.Context, id int64) (Transfer, error) {
+	return s.get(ctx, s.db, id)
+}
+
+func (s *Service) List(ctx context.Context) ([]Transfer, error) {
+	query := transferSelect + ` WHERE ` + transferLive + ` ORDER BY transfer_date DESC, id DESC`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]Transfer, 0)
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Update rewrites the transfer and both of its legs. Categories set on the
+// legs are kept.
+func (s *Service) Update(ctx context.Context, id int64, in UpdateInput) (Transfer, error) {
+	if err := validate(in); err != nil {
+		return Transfer{}, err
+	}
+
+	var updated Transfer
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := s.updateTransfer(ctx, tx, id, in); err != nil {
+			return err
+		}
+
+		t, err := s.get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		updated = t
+		return nil
+	})
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return updated, nil
+}
+
+// Delete moves both legs of the transfer to the trash. The transfer row is
+// kept and hidden while its legs are trashed, so that restoring either leg
+// brings the whole transfer back; the trash purge removes it with its legs.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := s.get(ctx, tx, id); err != nil {
+			return err
+		}
+
+		return s.transactions.WithTx(tx).DeleteByTransfer(ctx, id)
+	})
+}
+
+// UpdateTransaction updates a single transaction. When it is a transfer leg,
+// the amount keeps the leg's direction and the date, amount, currency and
+// description are copied to the other leg; changing the leg's account moves that side of
+// the transfer. When ifVersion is set, it returns
+// transactions.ErrVersionMismatch unless the transaction is still at that
+// version.
+func (s *Service) UpdateTransaction(ctx context.Context, id int64, ifVersion *int64, in transactions.UpdateInput) (transactions.Transaction, error) {
+	var updated transactions.Transaction
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		txRepo := s.transactions.WithTx(tx)
+		current, err := txRepo.GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := current.CheckVersion(ifVersion); err != nil {
+			return err
+		}
+
+		if current.TransferID == nil {
+			updated, err = txRepo.Update(ctx, id, in)
+			return err
+		}
+
+		t, err := s.get(ctx, tx, *current.TransferID)
+		if err != nil {
+			return err
+		}
+		if in.AccountID == nil {
+			return ErrMissingAccount
+		}
+		if len(in.Splits) > 0 {
+			return ErrSplitLeg
+		}
+
+		amount := in.AmountCents
+		if amount < 0 {
+			amount = -amount
+		}
+		transferIn := UpdateInput{
+			FromAccountID: t.FromAccountID,
+			ToAccountID:   t.ToAccountID,
+			TransferDate:  in.TransactionDate,
+			AmountCents:   amount,
+			Currency:      in.Currency,
+			Description:   in.Description,
+		}
+		if current.ID == t.DebitTransactionID {
+			transferIn.FromAccountID = *in.AccountID
+		} else {
+			transferIn.ToAccountID = *in.AccountID
+		}
+		if err := validate(transferIn); err != nil {
+			return err
+		}
+		if err := s.updateTransfer(ctx, tx, t.ID, transferIn); err != nil {
+			return err
+		}
+
+		// updateTransfer keeps leg categories, payees and tags; apply the ones
+		// sent for this leg.
+		leg, err := txRepo.GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		updated, err = txRepo.Update(ctx, id, transactions.UpdateInput{
+			TransactionDate: leg.TransactionDate,
+			AccountID:       leg.AccountID,
+			CategoryID:      in.CategoryID,
+			AmountCents:     leg.AmountCents,
+			Description:     leg.Description,
+			PayeeID:         in.PayeeID,
+			Tags:            in.Tags,
+		})
+		return err
+	})
+	if err != nil {
+		return transactions.Transaction{}, err
+	}
+
+	return updated, nil
+}
+
+// DeleteTransaction moves a transaction to the trash, together with the other
+// leg when it is a transfer leg so that no half of a transfer is left behind.
+// When ifVersion is set, it returns transactions.ErrVersionMismatch unless the
+// transaction is still at that version.
+func (s *Service) DeleteTransaction(ctx context.Context, id int64, ifVersion *int64) error {
+	return db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		txRepo := s.transactions.WithTx(tx)
+		current, err := txRepo.GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := current.CheckVersion(ifVersion); err != nil {
+			return err
+		}
+
+		if current.TransferID == nil {
+			return txRepo.Delete(ctx, id)
+		}
+
+		return txRepo.DeleteByTransfer(ctx, *current.TransferID)
+	})
+}
+
+// updateTransfer rewrites the transfer and its legs. An empty in.Currency
+// keeps the transfer's currency, in which in.AmountCents then is.
+func (s *Service) updateTransfer(ctx context.Context, tx *sql.Tx, id int64, in UpdateInput) error {
+	query := `
+		UPDATE transfers
+		SET from_account_id = $1,
+			to_account_id = $2,
+			transfer_date = $3,
+			amount = $4::numeric / ` + transactions.ScaleOf("COALESCE(NULLIF($7::text, ''), currency)") + `,
+			currency = COALESCE(NULLIF($7::text, ''), currency),
+			description = $5
+		WHERE id = $6
+		RETURNING id
+	`
+
+	if err := tx.QueryRowContext(ctx, query, in.FromAccountID, in.ToAccountID, in.TransferDate, in.AmountCents, in.Description, id, in.Currency).Scan(&id); err != nil {
+		return err
+	}
+
+	txRepo := s.transactions.WithTx(tx)
+	legs, err := txRepo.ListByTransfer(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, leg := range legs {
+		accountID, amount := in.ToAccountID, in.AmountCents
+		if leg.AmountCents < 0 {
+			accountID, amount = in.FromAccountID, -in.AmountCents
+		}
+		if _, err := txRepo.Update(ctx, leg.ID, transactions.UpdateInput{
+			TransactionDate: in.TransferDate,
+			AccountID:       &accountID,
+			CategoryID:      leg.CategoryID,
+			AmountCents:     amount,
+			Currency:        in.Currency,
+			Description:     in.Description,
+			PayeeID:         leg.PayeeID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) get(ctx context.Context, conn db.DBTX, id int64) (Transfer, error) {
+	query := transferSelect + ` WHERE id = $1 AND ` + transferLive
+
+	return scanTransfer(conn.QueryRowContext(ctx, query, id))
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTransfer(row rowScanner) (Transfer, error) {
+	var t Transfer
+	err := row.Scan(
+		&t.ID,
+		&t.FromAccountID,
+		&t.ToAccountID,
+		&t.TransferDate,
+		&t.AmountCents,
+		&t.Currency,
+		&t.CurrencyExponent,
+		&t.Description,
+		&t.CreatedAt,
+		&t.DebitTransactionID,
+		&t.CreditTransactionID,
+	)
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	return t, nil
+}
+
+func validate(in CreateInput) error {
+	if in.AmountCents <= 0 {
+		return ErrInvalidAmount
+	}
+	if in.FromAccountID == in.ToAccountID {
+		return ErrSameAccount
+	}
+	return nil
+}
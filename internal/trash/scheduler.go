@@ -0,0 +1,40 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/periodic"
+)
+
+// Scheduler runs Service.Purge once at startup and then every
+// cfg.PurgeInterval.
+type Scheduler struct {
+	service *Service
+	logger  *zap.Logger
+}
+
+func NewScheduler(cfg config.Config, service *Service, logger *zap.Logger, lc fx.Lifecycle) *Scheduler {
+	s := &Scheduler{service: service, logger: logger}
+	periodic.NewRunner("trash scheduler", cfg.PurgeInterval, s.runOnce, logger, lc)
+	return s
+}
+
+func (s *Scheduler) runOnce(ctx context.Context) error {
+	result, err := s.service.Purge(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if result.Transactions > 0 || result.Categories > 0 {
+		s.logger.Info(
+			"trash scheduler: purged",
+			zap.Int64("transactions", result.Transactions),
+			zap.Int64("categories", result.Categories),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package trash
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"zankowitch.com/go-db-app/internal/categories"
+	"zankowitch.com/go-db-app/internal/config"
+	"zankowitch.com/go-db-app/internal/db"
+	"zankowitch.com/go-db-app/internal/transactions"
+)
+
+// Contents lists what is in the trash, most recently deleted first.
+type Contents struct {
+	Transactions []transactions.Transaction
+	Categories   []categories.Category
+}
+
+// PurgeResult counts the rows a purge removed for good.
+type PurgeResult struct {
+	Transactions int64
+	Categories   int64
+}
+
+// Service lists the trash and permanently deletes what has been in it for
+// longer than the retention period.
+type Service struct {
+	db           *sql.DB
+	transactions *transactions.Repository
+	categories   *categories.Repository
+	retention    time.Duration
+}
+
+func NewService(cfg config.Config, db *sql.DB, transactions *transactions.Repository, categories *categories.Repository) *Service {
+	return &Service{db: db, transactions: transactions, categories: categories, retention: cfg.TrashRetention}
+}
+
+func (s *Service) List(ctx context.Context) (Contents, error) {
+	txList, err := s.transactions.ListDeleted(ctx)
+	if err != nil {
+		return Contents{}, err
+	}
+	catList, err := s.categories.ListDeleted(ctx)
+	if err != nil {
+		return Contents{}, err
+	}
+
+	return Contents{Transactions: txList, Categories: catList}, nil
+}
+
+// PurgeAt returns when an item deleted at deletedAt is purged.
+func (s *Service) PurgeAt(deletedAt time.Time) time.Time {
+	return deletedAt.Add(s.retention)
+}
+
+// Purge permanently deletes everything trashed more than the retention period
+// before now, in one database transaction. Transactions go first so that the
+// split lines they held no longer keep their categories.
+func (s *Service) Purge(ctx context.Context, now time.Time) (PurgeResult, error) {
+	cutoff := now.Add(-s.retention)
+
+	var result PurgeResult
+	err := db.InTx(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		result.Transactions, err = s.transactions.WithTx(tx).Purge(ctx, cutoff)
+		if err != nil {
+			return err
+		}
+		result.Categories, err = s.categories.WithTx(tx).Purge(ctx, cutoff)
+		return err
+	})
+	if err != nil {
+		return PurgeResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions
+  ADD COLUMN external_account TEXT NULL,
+  ADD COLUMN external_id      TEXT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_external_id
+  ON transactions (COALESCE(external_account, ''), external_id)
+  WHERE external_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_external_id;
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS external_id,
+  DROP COLUMN IF EXISTS external_account;
+-- +goose StatementEnd
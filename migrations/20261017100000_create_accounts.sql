@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS accounts (
+  id              BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  name            TEXT NOT NULL UNIQUE,
+  account_type    TEXT NOT NULL CHECK (account_type IN ('checking', 'savings', 'credit_card', 'cash')),
+  opening_balance NUMERIC(12,2) NOT NULL DEFAULT 0,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE transactions
+  ADD COLUMN account_id BIGINT NULL REFERENCES accounts(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account_date
+  ON transactions (account_id, transaction_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_account_date;
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS account_id;
+
+DROP TABLE IF EXISTS accounts;
+-- +goose StatementEnd
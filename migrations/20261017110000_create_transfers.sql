@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS transfers (
+  id              BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  from_account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE RESTRICT,
+  to_account_id   BIGINT NOT NULL REFERENCES accounts(id) ON DELETE RESTRICT,
+  transfer_date   DATE NOT NULL,
+  amount          NUMERIC(12,2) NOT NULL CHECK (amount > 0),
+  description     TEXT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (from_account_id <> to_account_id)
+);
+
+ALTER TABLE transactions
+  ADD COLUMN transfer_id BIGINT NULL REFERENCES transfers(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer
+  ON transactions (transfer_id)
+  WHERE transfer_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_transfer;
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS transfer_id;
+
+DROP TABLE IF EXISTS transfers;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS transaction_splits (
+  id             BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  category_id    BIGINT NOT NULL REFERENCES categories(id) ON DELETE RESTRICT,
+  amount         NUMERIC(12,2) NOT NULL,
+  description    TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction
+  ON transaction_splits (transaction_id);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category
+  ON transaction_splits (category_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transaction_splits;
+-- +goose StatementEnd
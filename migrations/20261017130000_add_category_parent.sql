@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE categories
+  ADD COLUMN parent_id BIGINT NULL REFERENCES categories(id) ON DELETE RESTRICT,
+  ADD CONSTRAINT categories_parent_not_self CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent
+  ON categories (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_categories_parent;
+
+ALTER TABLE categories
+  DROP CONSTRAINT IF EXISTS categories_parent_not_self,
+  DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE categories
+  ADD COLUMN archived_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE categories
+  DROP COLUMN IF EXISTS archived_at;
+-- +goose StatementEnd
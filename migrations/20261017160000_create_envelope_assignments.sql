@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS envelope_assignments (
+  id          BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  category_id BIGINT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+  month       DATE NOT NULL CHECK (EXTRACT(DAY FROM month) = 1),
+  amount      NUMERIC(12,2) NOT NULL CHECK (amount >= 0),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (category_id, month)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS envelope_assignments;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recurring_rules (
+  id          BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  frequency   TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+  every       INTEGER NOT NULL DEFAULT 1 CHECK (every > 0),
+  start_date  DATE NOT NULL,
+  end_date    DATE NULL CHECK (end_date IS NULL OR end_date >= start_date),
+  next_date   DATE NOT NULL,
+  amount      NUMERIC(12,2) NOT NULL,
+  account_id  BIGINT NULL REFERENCES accounts(id) ON DELETE RESTRICT,
+  category_id BIGINT NULL REFERENCES categories(id) ON DELETE SET NULL,
+  description TEXT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_rules_next_date
+  ON recurring_rules (next_date);
+
+ALTER TABLE transactions
+  ADD COLUMN recurring_rule_id BIGINT NULL REFERENCES recurring_rules(id) ON DELETE SET NULL,
+  ADD COLUMN occurrence_date DATE NULL;
+
+-- One transaction per rule and occurrence, so a catch-up run can never book
+-- the same occurrence twice.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_recurring_occurrence
+  ON transactions (recurring_rule_id, occurrence_date)
+  WHERE recurring_rule_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_recurring_occurrence;
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS occurrence_date,
+  DROP COLUMN IF EXISTS recurring_rule_id;
+
+DROP TABLE IF EXISTS recurring_rules;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS categorization_rules (
+  id                   BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  priority             INTEGER NOT NULL DEFAULT 0,
+  category_id          BIGINT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+  description_contains TEXT NULL,
+  description_pattern  TEXT NULL,
+  min_amount           NUMERIC(12,2) NULL,
+  max_amount           NUMERIC(12,2) NULL CHECK (min_amount IS NULL OR max_amount IS NULL OR max_amount >= min_amount),
+  account_id           BIGINT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  created_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (
+    description_contains IS NOT NULL
+    OR description_pattern IS NOT NULL
+    OR min_amount IS NOT NULL
+    OR max_amount IS NOT NULL
+    OR account_id IS NOT NULL
+  )
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rules_priority
+  ON categorization_rules (priority, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS categorization_rules;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dismissed_duplicates (
+  transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  other_id       BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (transaction_id, other_id),
+  CHECK (transaction_id < other_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dismissed_duplicates_other
+  ON dismissed_duplicates (other_id);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_amount_date
+  ON transactions (amount, transaction_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_amount_date;
+DROP TABLE IF EXISTS dismissed_duplicates;
+-- +goose StatementEnd
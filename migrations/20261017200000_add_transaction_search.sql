@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The 'simple' configuration neither stems nor drops stop words, which suits
+-- merchant names and bank references in any language.
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(description, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_search_vector
+  ON transactions USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_search_vector;
+ALTER TABLE transactions DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payees (
+  id         BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  name       TEXT NOT NULL CHECK (btrim(name) <> ''),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payees_name_lower
+  ON payees (lower(name));
+
+-- Normalization patterns are case-insensitive regular expressions matched
+-- against transaction descriptions, tried in position order.
+CREATE TABLE IF NOT EXISTS payee_patterns (
+  id       BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  payee_id BIGINT NOT NULL REFERENCES payees(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  pattern  TEXT NOT NULL CHECK (pattern <> '')
+);
+
+CREATE INDEX IF NOT EXISTS idx_payee_patterns_payee
+  ON payee_patterns (payee_id, position);
+
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS payee_id BIGINT NULL REFERENCES payees(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_payee_date
+  ON transactions (payee_id, transaction_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_payee_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS payee_id;
+DROP TABLE IF EXISTS payee_patterns;
+DROP TABLE IF EXISTS payees;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Existing rows predate currencies and were all entered in euros.
+ALTER TABLE transactions
+  ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'EUR'
+    CHECK (currency ~ '^[A-Z]{3}$');
+
+-- New rows always get an explicit currency from the application.
+ALTER TABLE transactions ALTER COLUMN currency DROP DEFAULT;
+
+-- Rates are quoted the way the ECB publishes them: units of currency per one
+-- euro, valid from rate_date until the next published date.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+  currency  CHAR(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$' AND currency <> 'EUR'),
+  rate_date DATE NOT NULL,
+  rate      NUMERIC(18,8) NOT NULL CHECK (rate > 0),
+  PRIMARY KEY (currency, rate_date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
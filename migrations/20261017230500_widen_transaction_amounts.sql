@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ISO 4217 minor unit exponents: the number of decimals of each currency.
+-- Currencies not listed have two. internal/transactions mirrors this list.
+CREATE OR REPLACE FUNCTION currency_exponent(code TEXT) RETURNS INT
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE AS $$
+  SELECT CASE
+    WHEN code IN ('BIF', 'CLP', 'DJF', 'GNF', 'ISK', 'JPY', 'KMF', 'KRW', 'PYG',
+                  'RWF', 'UGX', 'UYI', 'VND', 'VUV', 'XAF', 'XOF', 'XPF') THEN 0
+    WHEN code IN ('BHD', 'IQD', 'JOD', 'KWD', 'LYD', 'OMR', 'TND') THEN 3
+    WHEN code IN ('CLF', 'UYW') THEN 4
+    ELSE 2
+  END
+$$;
+
+-- Four decimals fit every currency; 14 integer digits keep the largest amount
+-- in minor units within a BIGINT.
+ALTER TABLE transactions ALTER COLUMN amount TYPE NUMERIC(18,4);
+ALTER TABLE transaction_splits ALTER COLUMN amount TYPE NUMERIC(18,4);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transaction_splits ALTER COLUMN amount TYPE NUMERIC(12,2) USING round(amount, 2);
+ALTER TABLE transactions ALTER COLUMN amount TYPE NUMERIC(12,2) USING round(amount, 2);
+DROP FUNCTION IF EXISTS currency_exponent(TEXT);
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions
+  ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+ALTER TABLE categories
+  ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+-- The trash is small next to the live rows; these serve its listing and the
+-- purge job.
+CREATE INDEX IF NOT EXISTS idx_transactions_deleted_at
+  ON transactions (deleted_at)
+  WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at
+  ON categories (deleted_at)
+  WHERE deleted_at IS NOT NULL;
+
+-- A trashed category must not keep its name from being reused.
+ALTER TABLE categories
+  DROP CONSTRAINT IF EXISTS categories_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name_live
+  ON categories (name)
+  WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM transfers
+WHERE id IN (SELECT transfer_id FROM transactions WHERE deleted_at IS NOT NULL);
+
+DELETE FROM transactions WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_categories_name_live;
+
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE categories
+  ADD CONSTRAINT categories_name_key UNIQUE (name);
+
+DROP INDEX IF EXISTS idx_categories_deleted_at;
+DROP INDEX IF EXISTS idx_transactions_deleted_at;
+
+ALTER TABLE categories
+  DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE transactions
+  DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,89 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS audit_log (
+  id         BIGSERIAL PRIMARY KEY,
+  entity     TEXT NOT NULL,
+  entity_id  BIGINT NOT NULL,
+  action     TEXT NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')),
+  before     JSONB NULL,
+  after      JSONB NULL,
+  request_id TEXT NULL,
+  changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity
+  ON audit_log (entity, entity_id, id);
+
+-- Records a row change of the table the trigger is on, named by the trigger's
+-- argument. Trashing and restoring a row are updates of deleted_at and are
+-- recorded as delete and restore; a hard delete is a purge. The request ID is
+-- the megabudget.request_id setting that db.InTx makes for each transaction.
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+  before_row JSONB;
+  after_row  JSONB;
+  change     TEXT;
+BEGIN
+  IF TG_OP <> 'INSERT' THEN
+    before_row := to_jsonb(OLD) - 'search_vector';
+  END IF;
+  IF TG_OP <> 'DELETE' THEN
+    after_row := to_jsonb(NEW) - 'search_vector';
+  END IF;
+
+  change := CASE
+    WHEN TG_OP = 'INSERT' THEN 'create'
+    WHEN TG_OP = 'DELETE' THEN 'purge'
+    WHEN before_row = after_row THEN NULL
+    WHEN before_row->>'deleted_at' IS NULL AND after_row->>'deleted_at' IS NOT NULL THEN 'delete'
+    WHEN before_row->>'deleted_at' IS NOT NULL AND after_row->>'deleted_at' IS NULL THEN 'restore'
+    ELSE 'update'
+  END;
+  -- Updates that rewrite a row unchanged, e.g. when only its split lines or
+  -- tags changed, are not recorded.
+  IF change IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO audit_log (entity, entity_id, action, before, after, request_id)
+  VALUES (
+    TG_ARGV[0],
+    (COALESCE(after_row, before_row)->>'id')::bigint,
+    change,
+    before_row,
+    after_row,
+    NULLIF(current_setting('megabudget.request_id', true), '')
+  );
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER transactions_audit
+  AFTER INSERT OR UPDATE OR DELETE ON transactions
+  FOR EACH ROW EXECUTE FUNCTION audit_row_change('transaction');
+
+CREATE TRIGGER categories_audit
+  AFTER INSERT OR UPDATE OR DELETE ON categories
+  FOR EACH ROW EXECUTE FUNCTION audit_row_change('category');
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_append_only
+  BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS categories_audit ON categories;
+DROP TRIGGER IF EXISTS transactions_audit ON transactions;
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+DROP FUNCTION IF EXISTS audit_row_change();
+-- +goose StatementEnd
@@ -0,0 +1,116 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions
+  ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE categories
+  ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- Every update moves the row to its next version, whichever code path makes
+-- it, so that an ETag read before the change no longer matches.
+CREATE OR REPLACE FUNCTION bump_row_version() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+  NEW.version := OLD.version + 1;
+  RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER transactions_version
+  BEFORE UPDATE ON transactions
+  FOR EACH ROW EXECUTE FUNCTION bump_row_version();
+
+CREATE TRIGGER categories_version
+  BEFORE UPDATE ON categories
+  FOR EACH ROW EXECUTE FUNCTION bump_row_version();
+
+-- A rewrite that only moved the version is still not a change worth
+-- auditing.
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+  before_row JSONB;
+  after_row  JSONB;
+  change     TEXT;
+BEGIN
+  IF TG_OP <> 'INSERT' THEN
+    before_row := to_jsonb(OLD) - 'search_vector';
+  END IF;
+  IF TG_OP <> 'DELETE' THEN
+    after_row := to_jsonb(NEW) - 'search_vector';
+  END IF;
+
+  change := CASE
+    WHEN TG_OP = 'INSERT' THEN 'create'
+    WHEN TG_OP = 'DELETE' THEN 'purge'
+    WHEN before_row - 'version' = after_row - 'version' THEN NULL
+    WHEN before_row->>'deleted_at' IS NULL AND after_row->>'deleted_at' IS NOT NULL THEN 'delete'
+    WHEN before_row->>'deleted_at' IS NOT NULL AND after_row->>'deleted_at' IS NULL THEN 'restore'
+    ELSE 'update'
+  END;
+  IF change IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO audit_log (entity, entity_id, action, before, after, request_id)
+  VALUES (
+    TG_ARGV[0],
+    (COALESCE(after_row, before_row)->>'id')::bigint,
+    change,
+    before_row,
+    after_row,
+    NULLIF(current_setting('megabudget.request_id', true), '')
+  );
+  RETURN NULL;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+  before_row JSONB;
+  after_row  JSONB;
+  change     TEXT;
+BEGIN
+  IF TG_OP <> 'INSERT' THEN
+    before_row := to_jsonb(OLD) - 'search_vector';
+  END IF;
+  IF TG_OP <> 'DELETE' THEN
+    after_row := to_jsonb(NEW) - 'search_vector';
+  END IF;
+
+  change := CASE
+    WHEN TG_OP = 'INSERT' THEN 'create'
+    WHEN TG_OP = 'DELETE' THEN 'purge'
+    WHEN before_row = after_row THEN NULL
+    WHEN before_row->>'deleted_at' IS NULL AND after_row->>'deleted_at' IS NOT NULL THEN 'delete'
+    WHEN before_row->>'deleted_at' IS NOT NULL AND after_row->>'deleted_at' IS NULL THEN 'restore'
+    ELSE 'update'
+  END;
+  IF change IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO audit_log (entity, entity_id, action, before, after, request_id)
+  VALUES (
+    TG_ARGV[0],
+    (COALESCE(after_row, before_row)->>'id')::bigint,
+    change,
+    before_row,
+    after_row,
+    NULLIF(current_setting('megabudget.request_id', true), '')
+  );
+  RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS categories_version ON categories;
+DROP TRIGGER IF EXISTS transactions_version ON transactions;
+DROP FUNCTION IF EXISTS bump_row_version();
+
+ALTER TABLE categories DROP COLUMN IF EXISTS version;
+ALTER TABLE transactions DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
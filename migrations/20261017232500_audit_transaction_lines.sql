@@ -0,0 +1,107 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Records a change of a transaction, of its split lines or of its tags. The
+-- trigger's argument names the column holding the transaction's id. The
+-- entry holds the whole transaction, with its lines and the names of its
+-- tags, as it stands at the end of the statement; the state before is the
+-- previous entry's, or the old row for transactions recorded before this
+-- migration. A statement that changes a transaction and its lines together
+-- is recorded once, by whichever trigger fires first.
+CREATE OR REPLACE FUNCTION audit_transaction_change() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+  changed_row JSONB;
+  txn_id      BIGINT;
+  before_row  JSONB;
+  after_row   JSONB;
+  change      TEXT;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    changed_row := to_jsonb(OLD);
+  ELSE
+    changed_row := to_jsonb(NEW);
+  END IF;
+  txn_id := (changed_row->>TG_ARGV[0])::bigint;
+
+  SELECT a.after INTO before_row
+  FROM audit_log a
+  WHERE a.entity = 'transaction' AND a.entity_id = txn_id
+  ORDER BY a.id DESC
+  LIMIT 1;
+  IF NOT FOUND AND TG_TABLE_NAME = 'transactions' AND TG_OP <> 'INSERT' THEN
+    before_row := to_jsonb(OLD) - 'search_vector';
+  END IF;
+
+  SELECT (to_jsonb(t) - 'search_vector') || jsonb_build_object(
+    'splits', COALESCE((
+      SELECT jsonb_agg(jsonb_build_object(
+        'category_id', s.category_id,
+        'amount', s.amount,
+        'description', s.description
+      ) ORDER BY s.id)
+      FROM transaction_splits s
+      WHERE s.transaction_id = t.id
+    ), '[]'::jsonb),
+    'tags', COALESCE((
+      SELECT jsonb_agg(g.name ORDER BY lower(g.name))
+      FROM transaction_tags tt
+      JOIN tags g ON g.id = tt.tag_id
+      WHERE tt.transaction_id = t.id
+    ), '[]'::jsonb)
+  ) INTO after_row
+  FROM transactions t
+  WHERE t.id = txn_id;
+
+  change := CASE
+    WHEN before_row IS NULL AND after_row IS NULL THEN NULL
+    WHEN before_row IS NULL AND TG_TABLE_NAME = 'transactions' AND TG_OP = 'INSERT' THEN 'create'
+    WHEN after_row IS NULL THEN 'purge'
+    WHEN before_row IS NOT NULL AND before_row - 'version' = after_row - 'version' THEN NULL
+    WHEN before_row->>'deleted_at' IS NULL AND after_row->>'deleted_at' IS NOT NULL THEN 'delete'
+    WHEN before_row->>'deleted_at' IS NOT NULL AND after_row->>'deleted_at' IS NULL THEN 'restore'
+    ELSE 'update'
+  END;
+  IF change IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO audit_log (entity, entity_id, action, before, after, request_id)
+  VALUES (
+    'transaction',
+    txn_id,
+    change,
+    before_row,
+    after_row,
+    NULLIF(current_setting('megabudget.request_id', true), '')
+  );
+  RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS transactions_audit ON transactions;
+
+CREATE TRIGGER transactions_audit
+  AFTER INSERT OR UPDATE OR DELETE ON transactions
+  FOR EACH ROW EXECUTE FUNCTION audit_transaction_change('id');
+
+CREATE TRIGGER transaction_splits_audit
+  AFTER INSERT OR UPDATE OR DELETE ON transaction_splits
+  FOR EACH ROW EXECUTE FUNCTION audit_transaction_change('transaction_id');
+
+CREATE TRIGGER transaction_tags_audit
+  AFTER INSERT OR UPDATE OR DELETE ON transaction_tags
+  FOR EACH ROW EXECUTE FUNCTION audit_transaction_change('transaction_id');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS transaction_tags_audit ON transaction_tags;
+DROP TRIGGER IF EXISTS transaction_splits_audit ON transaction_splits;
+DROP TRIGGER IF EXISTS transactions_audit ON transactions;
+
+CREATE TRIGGER transactions_audit
+  AFTER INSERT OR UPDATE OR DELETE ON transactions
+  FOR EACH ROW EXECUTE FUNCTION audit_row_change('transaction');
+
+DROP FUNCTION IF EXISTS audit_transaction_change();
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The last error a scheduler run hit while booking the rule, e.g. because its
+-- category has been archived since. Cleared by the next successful run or an
+-- update of the rule.
+ALTER TABLE recurring_rules
+  ADD COLUMN last_error TEXT NULL,
+  ADD COLUMN last_error_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE recurring_rules
+  DROP COLUMN IF EXISTS last_error_at,
+  DROP COLUMN IF EXISTS last_error;
+-- +goose StatementEnd
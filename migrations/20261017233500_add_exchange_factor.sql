@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The rate of code on on_date in units per euro: the latest one published on
+-- or before it, or NULL when there is none.
+CREATE OR REPLACE FUNCTION exchange_rate(code TEXT, on_date DATE) RETURNS NUMERIC
+LANGUAGE sql STABLE AS $$
+  SELECT CASE WHEN code = 'EUR' THEN 1 ELSE (
+    SELECT er.rate
+    FROM exchange_rates er
+    WHERE er.currency = code AND er.rate_date <= on_date
+    ORDER BY er.rate_date DESC
+    LIMIT 1
+  ) END
+$$;
+
+-- The factor converting an amount in from_code into to_code at the rates of
+-- on_date, or NULL when one of them is missing.
+CREATE OR REPLACE FUNCTION exchange_factor(from_code TEXT, to_code TEXT, on_date DATE) RETURNS NUMERIC
+LANGUAGE sql STABLE AS $$
+  SELECT CASE WHEN from_code = to_code THEN 1
+    ELSE exchange_rate(to_code, on_date) / exchange_rate(from_code, on_date)
+  END
+$$;
+
+-- Opening balances are in the base currency, like account balances.
+ALTER TABLE accounts
+  ALTER COLUMN opening_balance TYPE NUMERIC(18,4);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE accounts
+  ALTER COLUMN opening_balance TYPE NUMERIC(12,2);
+
+DROP FUNCTION IF EXISTS exchange_factor(TEXT, TEXT, DATE);
+DROP FUNCTION IF EXISTS exchange_rate(TEXT, DATE);
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Transfers held their amount in hundredths whatever the currency of their
+-- legs; they now hold it in that currency, like the legs themselves.
+ALTER TABLE transfers
+  ADD COLUMN currency CHAR(3) NULL,
+  ALTER COLUMN amount TYPE NUMERIC(18,4);
+
+UPDATE transfers
+SET currency = leg.currency,
+    amount = leg.amount
+FROM (
+  SELECT DISTINCT ON (transfer_id) transfer_id, currency, amount
+  FROM transactions
+  WHERE transfer_id IS NOT NULL AND amount > 0
+  ORDER BY transfer_id, id
+) leg
+WHERE leg.transfer_id = transfers.id;
+
+-- A transfer without a credit leg is never shown; keep its amount as is.
+UPDATE transfers SET currency = 'EUR' WHERE currency IS NULL;
+
+ALTER TABLE transfers
+  ALTER COLUMN currency SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE transfers
+SET amount = round(amount * 10::numeric ^ currency_exponent(currency) / 100, 2);
+
+ALTER TABLE transfers
+  ALTER COLUMN amount TYPE NUMERIC(12,2),
+  DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- These amounts are in the base currency, scaled by its exponent like
+-- transaction amounts; four decimals fit every currency.
+ALTER TABLE budgets ALTER COLUMN amount TYPE NUMERIC(18,4);
+ALTER TABLE envelope_assignments ALTER COLUMN amount TYPE NUMERIC(18,4);
+ALTER TABLE recurring_rules ALTER COLUMN amount TYPE NUMERIC(18,4);
+ALTER TABLE categorization_rules
+  ALTER COLUMN min_amount TYPE NUMERIC(18,4),
+  ALTER COLUMN max_amount TYPE NUMERIC(18,4);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE categorization_rules
+  ALTER COLUMN min_amount TYPE NUMERIC(12,2) USING round(min_amount, 2),
+  ALTER COLUMN max_amount TYPE NUMERIC(12,2) USING round(max_amount, 2);
+ALTER TABLE recurring_rules ALTER COLUMN amount TYPE NUMERIC(12,2) USING round(amount, 2);
+ALTER TABLE envelope_assignments ALTER COLUMN amount TYPE NUMERIC(12,2) USING round(amount, 2);
+ALTER TABLE budgets ALTER COLUMN amount TYPE NUMERIC(12,2) USING round(amount, 2);
+-- +goose StatementEnd